@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	"github.com/clause-cli/clause/internal/config"
+	"github.com/clause-cli/clause/internal/generator"
 	"github.com/clause-cli/clause/internal/governance"
 	"github.com/clause-cli/clause/pkg/output"
 	"github.com/spf13/cobra"
@@ -21,16 +22,22 @@ Component types:
   frontend    Add frontend components
   backend     Add backend components
   governance  Add governance rules and AI context
+  feature     Enable a feature and generate the files it needs
 
 Frontend subtypes: component (default), page, hook, utility, context
 Backend subtypes: service (default), route, model, schema, utility, middleware
 
+Files a feature changes are backed up first by default; use --existing
+skip to leave them alone or --existing overwrite to replace them.
+
 Examples:
   clause add frontend component Button
   clause add frontend page Home
   clause add backend route users
   clause add backend model User
-  clause add governance rule no-any-type`,
+  clause add governance rule no-any-type
+  clause add feature metrics --dry-run
+  clause add feature logging --existing skip`,
 	Args: cobra.MinimumNArgs(2),
 	RunE: runAdd,
 }
@@ -40,6 +47,8 @@ var (
 	addPath        string
 	addDeps        []string
 	addTags        []string
+	addDryRun      bool
+	addExisting    string
 )
 
 func init() {
@@ -49,6 +58,8 @@ func init() {
 	addCmd.Flags().StringVarP(&addPath, "path", "p", "", "component path")
 	addCmd.Flags().StringSliceVar(&addDeps, "deps", []string{}, "component dependencies")
 	addCmd.Flags().StringSliceVar(&addTags, "tags", []string{}, "component tags")
+	addCmd.Flags().BoolVar(&addDryRun, "dry-run", false, "show the files and settings a feature changes without writing them")
+	addCmd.Flags().StringVar(&addExisting, "existing", string(generator.Backup), "how to handle files a feature changes (overwrite, skip, backup)")
 }
 
 func runAdd(cmd *cobra.Command, args []string) error {
@@ -77,6 +88,10 @@ func runAdd(cmd *cobra.Command, args []string) error {
 		name = args[2]
 	}
 
+	if componentType == "feature" {
+		return addFeature(cmd, projectPath, args[1])
+	}
+
 	// Check if governance is enabled
 	cfg, err := loadProjectConfig(projectPath)
 	if err != nil {
@@ -258,6 +273,30 @@ func addGenericComponent(gov *governance.Governance, name, compType string, prin
 	return nil
 }
 
+// addFeature enables a feature and reports the files and settings it
+// changed, or with --dry-run the ones it would change.
+func addFeature(cmd *cobra.Command, projectPath, feature string) error {
+	changes, err := generator.AddFeature(projectPath, feature, addDryRun, generator.OverwritePolicy(addExisting))
+	if err != nil {
+		return err
+	}
+
+	if err := outputRenderer().RenderChangeSet(cmd.OutOrStdout(), changes); err != nil {
+		return err
+	}
+
+	if outputFormat == FormatText {
+		printer := output.NewPrinter(nil, os.Stderr)
+		printer.Println()
+		if addDryRun {
+			printer.PrintInfo("Dry run: run without --dry-run to enable %s", feature)
+		} else {
+			printer.PrintSuccess("Enabled %s", feature)
+		}
+	}
+	return nil
+}
+
 func findProjectRoot() (string, error) {
 	// Start from current directory
 	dir, err := os.Getwd()
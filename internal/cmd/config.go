@@ -3,8 +3,10 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/clause-cli/clause/internal/config"
 	"github.com/clause-cli/clause/pkg/styles"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -26,12 +28,19 @@ Examples:
   clause config list              # Show all configuration
   clause config get <key>         # Get a specific value
   clause config set <key> <value> # Set a value
-  clause config init              # Initialize configuration`,
+  clause config init              # Initialize configuration
+  clause config explain [key]     # Describe project config fields
+  clause config show              # Print the resolved project config
+  clause config profiles          # List the project's profiles
+  clause config promote <profile> # Fold a profile into the base config
+  clause config share             # Print a token to share the config`,
 }
 
 var (
-	configGlobal bool
-	configLocal  bool
+	configGlobal  bool
+	configLocal   bool
+	configProfile string
+	promoteDelete bool
 )
 
 func init() {
@@ -39,41 +48,50 @@ func init() {
 
 	configCmd.PersistentFlags().BoolVar(&configGlobal, "global", false, "operate on global config")
 	configCmd.PersistentFlags().BoolVar(&configLocal, "local", false, "operate on project config")
+	configCmd.PersistentFlags().StringVar(&configProfile, "profile", "", "profile to apply (default is $"+config.ProfileEnv+")")
 
 	// Add subcommands
 	configCmd.AddCommand(configListCmd)
 	configCmd.AddCommand(configGetCmd)
 	configCmd.AddCommand(configSetCmd)
 	configCmd.AddCommand(configInitCmd)
+	configCmd.AddCommand(configExplainCmd)
+	configCmd.AddCommand(configDiffCmd)
+	configCmd.AddCommand(configUnsetCmd)
+	configCmd.AddCommand(configSchemaCmd)
+	configCmd.AddCommand(configShowCmd)
+	configCmd.AddCommand(configProfilesCmd)
+	configCmd.AddCommand(configPromoteCmd)
+	configCmd.AddCommand(configShareCmd)
+
+	configPromoteCmd.Flags().BoolVar(&promoteDelete, "delete", false, "remove the profile file after promoting it")
+}
+
+// activeProfile returns the profile selected with --profile, falling back
+// to the one selected by the environment.
+func activeProfile() string {
+	if configProfile != "" {
+		return configProfile
+	}
+	return config.ActiveProfile()
 }
 
 // configListCmd lists all configuration.
 var configListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all configuration values",
-	Run:   runConfigList,
+	RunE:  runConfigList,
 }
 
-func runConfigList(cmd *cobra.Command, args []string) {
-	theme := styles.GetTheme()
-
-	titleStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color(theme.Colors.Primary))
-
-	keyStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color(theme.Colors.TextMuted)).
-		Width(25)
-
-	valueStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color(theme.Colors.Text))
-
-	fmt.Println()
-	fmt.Println(titleStyle.Render("Clause Configuration"))
-	fmt.Println()
+// Setting is a global setting and its current value.
+type Setting struct {
+	Key   string
+	Value string
+}
 
+func runConfigList(cmd *cobra.Command, args []string) error {
 	// Show all settings
-	settings := []struct {
+	defaults := []struct {
 		key, defaultValue string
 	}{
 		{"verbose", "false"},
@@ -89,20 +107,16 @@ func runConfigList(cmd *cobra.Command, args []string) {
 		{"updates.channel", "stable"},
 	}
 
-	for _, s := range settings {
+	settings := make([]Setting, len(defaults))
+	for i, s := range defaults {
 		value := viper.GetString(s.key)
 		if value == "" {
 			value = s.defaultValue
 		}
-		fmt.Printf("  %s%s\n",
-			keyStyle.Render(s.key),
-			valueStyle.Render(value),
-		)
+		settings[i] = Setting{Key: s.key, Value: value}
 	}
 
-	fmt.Println()
-	fmt.Println("Config file:", viper.ConfigFileUsed())
-	fmt.Println()
+	return outputRenderer().RenderSettings(cmd.OutOrStdout(), settings, viper.ConfigFileUsed())
 }
 
 // configGetCmd gets a configuration value.
@@ -195,3 +209,267 @@ func homeDir() (string, error) {
 	}
 	return home, nil
 }
+
+// configExplainCmd documents project configuration fields.
+var configExplainCmd = &cobra.Command{
+	Use:   "explain [key]",
+	Short: "Describe project configuration fields",
+	Long: `Describe project configuration fields with their type and default value.
+
+Pass a field path to describe a single field, or a section such as
+"frontend" to describe every field in it.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runConfigExplain,
+}
+
+func runConfigExplain(cmd *cobra.Command, args []string) error {
+	prefix := ""
+	if len(args) > 0 {
+		prefix = args[0]
+	}
+
+	var docs []config.FieldDoc
+	for _, doc := range config.FieldDocs() {
+		if prefix == "" || doc.Path == prefix || strings.HasPrefix(doc.Path, prefix+".") {
+			docs = append(docs, doc)
+		}
+	}
+
+	if len(docs) == 0 {
+		return fmt.Errorf("unknown configuration field: %s", prefix)
+	}
+
+	return outputRenderer().RenderFieldDocs(cmd.OutOrStdout(), docs)
+}
+
+// configDiffCmd compares two configuration profiles.
+var configDiffCmd = &cobra.Command{
+	Use:   "diff <profile-a> <profile-b>",
+	Short: "Compare two configuration profiles",
+	Long: `Compare the project configuration with two profile overlays applied.
+
+Profiles are stored as .clause/config.<profile>.yaml. Use "base" for the
+configuration without a profile.`,
+	Example: `  clause config diff staging prod
+  clause config diff base dev`,
+	Args: cobra.ExactArgs(2),
+	RunE: runConfigDiff,
+}
+
+func runConfigDiff(cmd *cobra.Command, args []string) error {
+	projectDir, err := findProjectRoot()
+	if err != nil {
+		return fmt.Errorf("not in a Clause project: %w", err)
+	}
+
+	profile := func(name string) string {
+		if name == "base" {
+			return ""
+		}
+		return name
+	}
+
+	changes, err := config.ProfileDiff(projectDir, profile(args[0]), profile(args[1]))
+	if err != nil {
+		return err
+	}
+
+	return outputRenderer().RenderDiff(cmd.OutOrStdout(), args[0], args[1], changes)
+}
+
+// configUnsetCmd removes a key from the project configuration file.
+var configUnsetCmd = &cobra.Command{
+	Use:   "unset <key>",
+	Short: "Remove a key from the project configuration",
+	Long: `Remove a key from the project configuration file.
+
+The file is edited in place, so comments and the order of the remaining
+keys are kept. The removed setting falls back to its default.`,
+	Example: `  clause config unset backend.database.redis_version`,
+	Args:    cobra.ExactArgs(1),
+	RunE:    runConfigUnset,
+}
+
+func runConfigUnset(cmd *cobra.Command, args []string) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	configPath, err := config.FindProjectConfig(cwd)
+	if err != nil {
+		return err
+	}
+
+	// Bring an older file up to date before editing it
+	if _, err := config.MigrateConfigFile(configPath); err != nil {
+		return fmt.Errorf("failed to migrate config: %w", err)
+	}
+
+	if err := config.DeleteConfigKeyInPlace(configPath, args[0]); err != nil {
+		return err
+	}
+
+	return outputRenderer().RenderUnset(cmd.OutOrStdout(), args[0], configPath)
+}
+
+// configSchemaCmd prints the JSON Schema for project configuration files.
+var configSchemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Print the JSON Schema for project configuration",
+	Long: `Print a JSON Schema (Draft-07) describing .clause/config.yaml.
+
+Save the output and point your editor's YAML language server at it for
+completion and validation of config files.`,
+	Example: `  clause config schema > clause.schema.json`,
+	Hidden:  true,
+	Args:    cobra.NoArgs,
+	RunE:    runConfigSchema,
+}
+
+func runConfigSchema(cmd *cobra.Command, args []string) error {
+	schema, err := config.ExportJSONSchema()
+	if err != nil {
+		return fmt.Errorf("failed to build schema: %w", err)
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), string(schema))
+	return nil
+}
+
+// configShowCmd prints the resolved project configuration.
+var configShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Print the resolved project configuration",
+	Long: `Print the project configuration with defaults, the global config,
+the active profile and environment overrides applied.
+
+The configuration is printed as YAML, or as JSON with --format json.`,
+	Example: `  clause config show
+  clause config show --format json
+  clause config show --profile staging`,
+	Args: cobra.NoArgs,
+	RunE: runConfigShow,
+}
+
+func runConfigShow(cmd *cobra.Command, args []string) error {
+	projectDir, err := findProjectRoot()
+	if err != nil {
+		return fmt.Errorf("not in a Clause project: %w", err)
+	}
+
+	cfg, err := config.NewLoader(
+		config.WithProjectDir(projectDir),
+		config.WithProfile(activeProfile()),
+	).LoadContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	return outputRenderer().RenderConfig(cmd.OutOrStdout(), cfg)
+}
+
+// configProfilesCmd lists the project's configuration profiles.
+var configProfilesCmd = &cobra.Command{
+	Use:   "profiles",
+	Short: "List the project's configuration profiles",
+	Long: `List the profiles stored as .clause/config.<profile>.yaml and mark the
+active one.
+
+The active profile is chosen with --profile or the ` + config.ProfileEnv + `
+environment variable.`,
+	Example: `  clause config profiles
+  ` + config.ProfileEnv + `=staging clause config profiles --format json`,
+	Args: cobra.NoArgs,
+	RunE: runConfigProfiles,
+}
+
+func runConfigProfiles(cmd *cobra.Command, args []string) error {
+	projectDir, err := findProjectRoot()
+	if err != nil {
+		return fmt.Errorf("not in a Clause project: %w", err)
+	}
+
+	profiles, err := config.ListProfiles(projectDir)
+	if err != nil {
+		return err
+	}
+
+	return outputRenderer().RenderProfiles(cmd.OutOrStdout(), profiles, activeProfile())
+}
+
+// configPromoteCmd folds a profile into the base configuration.
+var configPromoteCmd = &cobra.Command{
+	Use:   "promote <profile>",
+	Short: "Fold a profile into the base configuration",
+	Long: `Write the settings a profile changes into .clause/config.yaml, so they
+apply without the profile.
+
+Use --delete to remove .clause/config.<profile>.yaml afterwards.`,
+	Example: `  clause config promote staging
+  clause config promote staging --delete`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfigPromote,
+}
+
+func runConfigPromote(cmd *cobra.Command, args []string) error {
+	projectDir, err := findProjectRoot()
+	if err != nil {
+		return fmt.Errorf("not in a Clause project: %w", err)
+	}
+
+	changes, err := config.ProfileDiff(projectDir, "", args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := config.PromoteProfile(projectDir, args[0], promoteDelete); err != nil {
+		return err
+	}
+
+	if err := outputRenderer().RenderDiff(cmd.OutOrStdout(), "base", args[0], changes); err != nil {
+		return err
+	}
+	if promoteDelete {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Removed %s\n", config.ProfileConfigPath(projectDir, args[0]))
+	}
+	return nil
+}
+
+// configShareCmd prints the project configuration as a share token.
+var configShareCmd = &cobra.Command{
+	Use:   "share",
+	Short: "Print a token that recreates the project configuration",
+	Long: `Print the project configuration as a compact token that can be pasted
+into a chat message or issue.
+
+Running 'clause init --from-token <token>' creates a project with the same
+configuration.`,
+	Example: `  clause config share
+  clause init --from-token "$(clause config share)"`,
+	Args: cobra.NoArgs,
+	RunE: runConfigShare,
+}
+
+func runConfigShare(cmd *cobra.Command, args []string) error {
+	projectDir, err := findProjectRoot()
+	if err != nil {
+		return fmt.Errorf("not in a Clause project: %w", err)
+	}
+
+	cfg, err := config.NewLoader(
+		config.WithProjectDir(projectDir),
+		config.WithProfile(activeProfile()),
+	).LoadContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	token, err := config.EncodeConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), token)
+	return nil
+}
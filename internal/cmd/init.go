@@ -11,8 +11,11 @@ import (
 	"github.com/clause-cli/clause/internal/generator"
 	"github.com/clause-cli/clause/internal/governance"
 	"github.com/clause-cli/clause/internal/wizard"
+	"github.com/clause-cli/clause/internal/wizard/components"
 	"github.com/clause-cli/clause/pkg/output"
 	"github.com/clause-cli/clause/pkg/styles"
+	"github.com/clause-cli/clause/pkg/tui"
+	"github.com/clause-cli/clause/pkg/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -35,7 +38,13 @@ through all configuration options.
 Examples:
   clause init                    # Launch interactive wizard
   clause init my-project         # Create project with default settings
-  clause init my-project --preset saas  # Use a preset`,
+  clause init my-project --preset saas  # Use a preset
+  clause init --accessible       # Plain text wizard for screen readers
+  clause init --from-token <token>  # Recreate a shared configuration
+
+The wizard also runs as plain text questions when CLAUSE_ACCESSIBLE=1 is set.
+If the wizard is cancelled partway, its progress is saved and the next
+'clause init' offers to resume it; pass --no-resume to start afresh.`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runInit,
 }
@@ -45,6 +54,15 @@ var (
 	initPreset         string
 	initDryRun         bool
 	initPath           string
+	initTaskRunner     string
+	initOnly           []string
+	initSkip           []string
+	initExisting       string
+	initKeepOnError    bool
+	initAccessible     bool
+	initNoResume       bool
+	initFromToken      string
+	initForce          bool
 )
 
 func init() {
@@ -54,6 +72,15 @@ func init() {
 	initCmd.Flags().StringVarP(&initPreset, "preset", "p", "", "use a preset configuration (minimal, standard, saas, api-only, frontend-only, enterprise)")
 	initCmd.Flags().BoolVar(&initDryRun, "dry-run", false, "show what would be created without creating files")
 	initCmd.Flags().StringVar(&initPath, "path", "", "project creation path (default: current directory)")
+	initCmd.Flags().StringVar(&initTaskRunner, "task-runner", generator.TaskRunnerMake, "task runner file to generate (make, just)")
+	initCmd.Flags().StringSliceVar(&initOnly, "only", nil, "generate only these sections (config, common, frontend, backend, infrastructure, governance, git)")
+	initCmd.Flags().StringSliceVar(&initSkip, "skip", nil, "skip these sections")
+	initCmd.Flags().StringVar(&initExisting, "existing", string(generator.Overwrite), "how to handle existing files (overwrite, skip, backup)")
+	initCmd.Flags().BoolVar(&initKeepOnError, "keep-on-error", false, "keep the staged files when generation fails")
+	initCmd.Flags().BoolVar(&initNoResume, "no-resume", false, "start the wizard afresh instead of offering to resume a cancelled one")
+	initCmd.Flags().BoolVar(&initAccessible, "accessible", false, "run the wizard as plain text questions (also CLAUSE_ACCESSIBLE=1)")
+	initCmd.Flags().StringVar(&initFromToken, "from-token", "", "use the configuration from a 'clause config share' token")
+	initCmd.Flags().BoolVar(&initForce, "force", false, "overwrite .clause/context.yaml instead of keeping entries added by hand, and replace assistant files not written by Clause (keeping a backup)")
 }
 
 func runInit(cmd *cobra.Command, args []string) error {
@@ -64,10 +91,14 @@ func runInit(cmd *cobra.Command, args []string) error {
 	}
 
 	// Determine mode
-	if initNonInteractive || initPreset != "" {
+	if initNonInteractive || initPreset != "" || initFromToken != "" {
 		return runNonInteractiveInit(projectName)
 	}
 
+	if initAccessible || wizard.AccessibleRequested() {
+		return runAccessibleInit(projectName)
+	}
+
 	return runInteractiveInit(projectName)
 }
 
@@ -78,8 +109,9 @@ func runInteractiveInit(projectName string) error {
 	// Print welcome banner
 	printBanner(printer, theme)
 
-	// Create and run the wizard
+	// Create and run the wizard, resuming a cancelled one if wanted
 	w := wizard.New(
+		wizard.WithState(resumeState(printer)),
 		wizard.WithProjectName(projectName),
 		wizard.WithTheme(theme),
 	)
@@ -97,8 +129,16 @@ func runInteractiveInit(projectName string) error {
 		return fmt.Errorf("unexpected model type")
 	}
 
-	// Check if user cancelled
+	// Check if user cancelled, keeping the answers for the next run
 	if wiz.IsQuitting() {
+		if state := wiz.State(); state.CurrentScreen > 0 {
+			if err := wizard.SaveState(state); err != nil {
+				printer.PrintWarning("Could not save wizard progress: %v", err)
+			} else {
+				printer.PrintInfo("Project creation cancelled; run 'clause init' again to resume")
+				return nil
+			}
+		}
 		printer.PrintInfo("Project creation cancelled")
 		return nil
 	}
@@ -120,6 +160,82 @@ func runInteractiveInit(projectName string) error {
 	}
 
 	// Generate the project
+	if err := generateProject(cfg, projectPath, printer); err != nil {
+		return err
+	}
+
+	// The saved progress is no longer needed once the project exists
+	if !initDryRun {
+		if err := wizard.ClearState(); err != nil {
+			printer.PrintWarning("%v", err)
+		}
+	}
+	return nil
+}
+
+// resumeState returns the progress saved by a cancelled wizard if the user
+// chooses to resume it, or nil to start afresh. Declining deletes the saved
+// progress.
+func resumeState(printer *output.Printer) *wizard.WizardState {
+	if initNoResume {
+		return nil
+	}
+
+	state, err := wizard.LoadState()
+	if err != nil {
+		printer.PrintWarning("Ignoring saved wizard progress: %v", err)
+		return nil
+	}
+	if state == nil {
+		return nil
+	}
+
+	name := state.Config.Metadata.Name
+	if name == "" {
+		name = "unnamed project"
+	}
+	question := fmt.Sprintf("Resume setting up %s (step %d of %d, saved %s)?",
+		name, state.CurrentScreen+1, state.TotalScreens, state.SavedAt.Format("2006-01-02 15:04"))
+
+	resume, err := components.NewPrompter(os.Stdin, os.Stderr).Confirm(question, true)
+	if err != nil {
+		return nil
+	}
+	if !resume {
+		// Declining discards the progress so the question is not asked again
+		if err := wizard.ClearState(); err != nil {
+			printer.PrintWarning("%v", err)
+		}
+		return nil
+	}
+	return state
+}
+
+// runAccessibleInit runs the wizard as plain text questions on stdin and
+// stdout, without the banner, styling or alternate screen.
+func runAccessibleInit(projectName string) error {
+	printer := output.NewPrinter(nil, os.Stderr)
+
+	w := wizard.New(wizard.WithProjectName(projectName))
+	if err := w.RunAccessible(os.Stdin, os.Stdout); err != nil {
+		return err
+	}
+	if w.IsQuitting() {
+		return nil
+	}
+
+	cfg := w.Config()
+
+	// Determine project path
+	projectPath := initPath
+	if projectPath == "" {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return fmt.Errorf("failed to get current directory: %w", err)
+		}
+		projectPath = filepath.Join(cwd, cfg.Metadata.Name)
+	}
+
 	return generateProject(cfg, projectPath, printer)
 }
 
@@ -130,7 +246,12 @@ func runNonInteractiveInit(projectName string) error {
 	var cfg *config.ProjectConfig
 	var err error
 
-	if initPreset != "" {
+	if initFromToken != "" {
+		cfg, err = config.DecodeConfig(initFromToken)
+		if err != nil {
+			return fmt.Errorf("failed to read --from-token: %w", err)
+		}
+	} else if initPreset != "" {
 		cfg, err = config.LoadPreset(initPreset)
 		if err != nil {
 			return fmt.Errorf("failed to load preset: %w", err)
@@ -171,26 +292,44 @@ func generateProject(cfg *config.ProjectConfig, projectPath string, printer *out
 		printer.PrintWarning("Dry run mode - no files will be created")
 	}
 
+	// Show live progress in interactive terminals, plain lines otherwise
+	liveProgress := utils.DetectCapabilities().Interactive && !IsQuiet() && !IsVerbose() && !initDryRun
+
 	// Create the generator
-	gen := generator.NewGenerator(cfg,
+	opts := []generator.GeneratorOption{
 		generator.WithDryRun(initDryRun),
 		generator.WithVerbose(IsVerbose()),
 		generator.WithLogger(output.DefaultLogger),
-		generator.WithProgress(func(message string) {
+		generator.WithTaskRunner(initTaskRunner),
+		generator.WithSections(initOnly...),
+		generator.WithSkipSections(initSkip...),
+		generator.WithOverwritePolicy(generator.OverwritePolicy(initExisting)),
+		generator.WithKeepOnError(initKeepOnError),
+	}
+	if !liveProgress {
+		opts = append(opts, generator.WithProgress(func(message string) {
 			if !IsQuiet() {
 				printer.PrintDim("  %s", message)
 			}
-		}),
-	)
+		}))
+	}
+	gen := generator.NewGenerator(cfg, opts...)
 
 	// Generate project files
-	if err := gen.Generate(projectPath); err != nil {
+	generate := gen.Generate
+	if liveProgress {
+		generate = func(path string) error { return generateWithProgressScreen(gen, path) }
+	}
+	if err := generate(projectPath); err != nil {
 		return fmt.Errorf("failed to generate project: %w", err)
 	}
+	if err := outputRenderer().RenderSummary(os.Stdout, gen.Summary()); err != nil {
+		return err
+	}
 
 	// Initialize governance
 	if cfg.Governance.Enabled && !initDryRun {
-		gov := governance.New(projectPath, governance.WithConfig(cfg))
+		gov := governance.New(projectPath, governance.WithConfig(cfg), governance.WithForce(initForce))
 		if err := gov.Initialize(); err != nil {
 			printer.PrintWarning("Failed to initialize governance: %v", err)
 		}
@@ -201,12 +340,56 @@ func generateProject(cfg *config.ProjectConfig, projectPath string, printer *out
 	printer.PrintSuccess("Project created successfully!")
 	printer.Println()
 
+	// Let the user know if they switched away during a long generation
+	if !initDryRun {
+		utils.Bell()
+		utils.Notify("Clause", fmt.Sprintf("Project %s created", cfg.Metadata.Name))
+	}
+
 	// Print next steps
 	printNextSteps(printer, cfg, projectPath)
 
 	return nil
 }
 
+// generateWithProgressScreen runs the generator in the background and shows
+// its progress events in a GenerationScreen.
+func generateWithProgressScreen(gen *generator.Generator, projectPath string) error {
+	events := make(chan generator.Event)
+	result := make(chan error, 1)
+	go func() {
+		result <- gen.GenerateWithEvents(projectPath, events)
+	}()
+
+	screenEvents := make(chan tui.GenerationEvent)
+	go func() {
+		defer close(screenEvents)
+		for event := range events {
+			screenEvents <- generationEvent(event)
+		}
+	}()
+
+	_, _ = tea.NewProgram(tui.NewGenerationScreen(screenEvents)).Run()
+
+	// The screen may exit early (e.g. ctrl+c); keep draining so the
+	// generator can finish instead of blocking on a send.
+	for range screenEvents {
+	}
+
+	return <-result
+}
+
+// generationEvent converts a generator event for the GenerationScreen.
+func generationEvent(event generator.Event) tui.GenerationEvent {
+	types := map[generator.EventType]tui.GenerationEventType{
+		generator.EventPhaseStarted: tui.GenerationPhaseStarted,
+		generator.EventFileWritten:  tui.GenerationFileWritten,
+		generator.EventPhaseDone:    tui.GenerationPhaseDone,
+		generator.EventError:        tui.GenerationError,
+	}
+	return tui.GenerationEvent{Type: types[event.Type], Phase: event.Phase, Path: event.Path, Err: event.Err}
+}
+
 func printBanner(printer *output.Printer, theme *styles.Theme) {
 	width, _, err := styles.GetTerminalSize()
 	if err != nil || width <= 0 {
@@ -230,86 +413,13 @@ func printBanner(printer *output.Printer, theme *styles.Theme) {
 }
 
 func printNextSteps(printer *output.Printer, cfg *config.ProjectConfig, projectPath string) {
+	renderer := tui.NewRenderer(styles.GetTheme(), 80, 24)
+
 	printer.PrintInfo("Next steps:")
 	printer.Println()
-
-	// Change directory instruction
-	printer.PrintMuted("  1. Navigate to your project:")
-	printer.Printf("     cd %s\n", projectPath)
+	printer.Println(renderer.Padding(renderer.NextSteps(cfg, projectPath), 0, 0, 0, 2))
 	printer.Println()
 
-	// Install dependencies based on configuration
-	step := 2
-	if cfg.Frontend.Enabled {
-		printer.PrintMuted("  %d. Install frontend dependencies:", step)
-		pm := cfg.Frontend.PackageManager
-		if pm == "" {
-			pm = "npm"
-		}
-		switch pm {
-		case "npm":
-			printer.Printf("     npm install\n")
-		case "yarn":
-			printer.Printf("     yarn install\n")
-		case "pnpm":
-			printer.Printf("     pnpm install\n")
-		case "bun":
-			printer.Printf("     bun install\n")
-		}
-		step++
-		printer.Println()
-	}
-
-	if cfg.Backend.Enabled {
-		lang := cfg.Backend.Language
-		if lang == "" {
-			lang = "node"
-		}
-		printer.PrintMuted("  %d. Install backend dependencies:", step)
-		switch lang {
-		case "python":
-			printer.Printf("     pip install -r backend/requirements.txt\n")
-		case "node", "typescript":
-			printer.Printf("     cd backend && npm install\n")
-		case "go":
-			printer.Printf("     cd backend && go mod download\n")
-		}
-		step++
-		printer.Println()
-	}
-
-	// Start development
-	printer.PrintMuted("  %d. Start developing:", step)
-	if cfg.Frontend.Enabled {
-		printer.Printf("     npm run dev")
-		if cfg.Backend.Enabled {
-			printer.Printf("  (frontend)")
-		}
-		printer.Println()
-	}
-	if cfg.Backend.Enabled {
-		lang := cfg.Backend.Language
-		if lang == "" {
-			lang = "node"
-		}
-		switch lang {
-		case "python":
-			printer.Printf("     python backend/main.py")
-		case "node", "typescript":
-			printer.Printf("     npm run dev --prefix backend")
-		case "go":
-			printer.Printf("     go run backend/main.go")
-		}
-		printer.Println()
-	}
-	printer.Println()
-
-	// Governance reminder
-	if cfg.Governance.Enabled {
-		printer.PrintMuted("  Governance files are in .clause/")
-		printer.Println()
-	}
-
 	// Happy coding
 	printer.PrintSuccess("Happy coding!")
 }
@@ -0,0 +1,529 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/clause-cli/clause/internal/config"
+	"github.com/clause-cli/clause/internal/generator"
+	"github.com/clause-cli/clause/pkg/output"
+	"github.com/clause-cli/clause/pkg/styles"
+	"github.com/clause-cli/clause/pkg/tui"
+	"github.com/spf13/cobra"
+)
+
+// Output formats accepted by the --format flag.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// OutputRenderer presents command results. Commands compute their results
+// and hand them to the renderer selected by --format, so every command
+// supports both human and machine-readable output.
+type OutputRenderer interface {
+	// RenderConfig writes a project configuration
+	RenderConfig(w io.Writer, cfg *config.ProjectConfig) error
+
+	// RenderValidation writes the issues found in a configuration
+	RenderValidation(w io.Writer, report *config.AnalysisReport) error
+
+	// RenderDiff writes the changes between two configurations
+	RenderDiff(w io.Writer, from, to string, changes config.ConfigChanges) error
+
+	// RenderSummary writes what a generation run did with each file
+	RenderSummary(w io.Writer, summary generator.Summary) error
+
+	// RenderChangeSet writes the files and settings a feature changes
+	RenderChangeSet(w io.Writer, changes *generator.ChangeSet) error
+
+	// RenderProfiles writes the configuration profiles and the active one
+	RenderProfiles(w io.Writer, profiles []string, active string) error
+
+	// RenderChecks writes the outcome of the project checks run by validate
+	RenderChecks(w io.Writer, checks []ProjectCheck) error
+
+	// RenderVersion writes the version and build information
+	RenderVersion(w io.Writer, info VersionInfo) error
+
+	// RenderSettings writes the global settings and the file they came from
+	RenderSettings(w io.Writer, settings []Setting, configFile string) error
+
+	// RenderFieldDocs writes the documentation of configuration fields
+	RenderFieldDocs(w io.Writer, docs []config.FieldDoc) error
+
+	// RenderUnset writes the key removed from a configuration file
+	RenderUnset(w io.Writer, key, configFile string) error
+
+	// RenderError writes the error a command failed with and a suggested next step
+	RenderError(w io.Writer, err error, suggestion string) error
+}
+
+// newOutputRenderer returns the renderer for a --format value.
+func newOutputRenderer(format string) (OutputRenderer, error) {
+	switch format {
+	case "", FormatText:
+		return &TextRenderer{Quiet: IsQuiet()}, nil
+	case FormatJSON:
+		return &JSONRenderer{}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q (supported: text, json)", format)
+	}
+}
+
+// outputRenderer returns the renderer selected by the global --format flag.
+func outputRenderer() OutputRenderer {
+	renderer, err := newOutputRenderer(outputFormat)
+	if err != nil {
+		// preRun rejects unknown formats before any command runs
+		return &TextRenderer{Quiet: IsQuiet()}
+	}
+	return renderer
+}
+
+// TextRenderer renders styled output for terminals.
+type TextRenderer struct {
+	// Theme styles the output; nil uses the current theme
+	Theme *styles.Theme
+
+	// Quiet omits informational output such as the generation summary
+	Quiet bool
+
+	// ErrorsOnly limits validation output to error-level issues
+	ErrorsOnly bool
+}
+
+func (r *TextRenderer) theme() *styles.Theme {
+	if r.Theme != nil {
+		return r.Theme
+	}
+	return styles.GetTheme()
+}
+
+// RenderConfig writes the configuration as YAML.
+func (r *TextRenderer) RenderConfig(w io.Writer, cfg *config.ProjectConfig) error {
+	data, err := cfg.MarshalCanonical()
+	if err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// RenderValidation writes one line per issue with its suggested fix.
+func (r *TextRenderer) RenderValidation(w io.Writer, report *config.AnalysisReport) error {
+	printer := output.NewPrinter(r.theme(), w)
+
+	if len(report.Issues) == 0 {
+		printer.PrintSuccess("Configuration is valid")
+		return nil
+	}
+
+	for _, issue := range report.Issues {
+		if r.ErrorsOnly && issue.Severity != "error" {
+			continue
+		}
+		if issue.Severity == "error" {
+			printer.PrintError("%s: %s", issue.Field, issue.Message)
+		} else {
+			printer.PrintWarning("%s: %s", issue.Field, issue.Message)
+		}
+		if issue.Suggestion != "" {
+			printer.PrintDim("    %s", issue.Suggestion)
+		}
+	}
+	return nil
+}
+
+// RenderDiff writes a header and the changed fields with their old and new
+// values.
+func (r *TextRenderer) RenderDiff(w io.Writer, from, to string, changes config.ConfigChanges) error {
+	renderer := tui.NewRenderer(r.theme(), 80, 24)
+	_, err := fmt.Fprintf(w, "\n%s\n\n%s\n\n", renderer.Header(fmt.Sprintf("%s → %s", from, to)), renderer.DiffView(diffChanges(changes)))
+	return err
+}
+
+// RenderSummary reports existing files that were skipped or overwritten.
+// Nothing is written when every file was new.
+func (r *TextRenderer) RenderSummary(w io.Writer, summary generator.Summary) error {
+	if r.Quiet || len(summary.Skipped)+len(summary.Overwritten) == 0 {
+		return nil
+	}
+
+	printer := output.NewPrinter(r.theme(), w)
+	printer.Println()
+	printer.PrintInfo("%d created, %d skipped, %d overwritten",
+		len(summary.Created), len(summary.Skipped), len(summary.Overwritten))
+	for _, path := range summary.Skipped {
+		printer.PrintDim("  skipped: %s", path)
+	}
+	for _, path := range summary.Overwritten {
+		printer.PrintDim("  overwritten: %s", path)
+	}
+	for _, path := range summary.Backups {
+		printer.PrintDim("  backup: %s", path)
+	}
+	return nil
+}
+
+// RenderChangeSet writes the configuration changes, the new files and a
+// diff of each modified file.
+func (r *TextRenderer) RenderChangeSet(w io.Writer, changes *generator.ChangeSet) error {
+	renderer := tui.NewRenderer(r.theme(), 80, 24)
+	printer := output.NewPrinter(r.theme(), w)
+
+	printer.Println()
+	printer.PrintSubheader("Configuration")
+	printer.Println(renderer.DiffView(diffChanges(changes.ConfigChanges)))
+
+	if len(changes.NewFiles) > 0 {
+		printer.Println()
+		printer.PrintSubheader("New files")
+		for _, path := range changes.NewFiles {
+			printer.PrintDim("  + %s", path)
+		}
+	}
+
+	for _, file := range changes.ModifiedFiles {
+		printer.Println()
+		printer.PrintSubheader("Modified: " + file.Path)
+		printer.Print(file.Diff)
+	}
+
+	if len(changes.Skipped) > 0 {
+		printer.Println()
+		printer.PrintSubheader("Skipped")
+		for _, path := range changes.Skipped {
+			printer.PrintDim("  %s", path)
+		}
+	}
+	if len(changes.Backups) > 0 {
+		printer.Println()
+		printer.PrintSubheader("Backups")
+		for _, path := range changes.Backups {
+			printer.PrintDim("  %s", path)
+		}
+	}
+	return nil
+}
+
+// RenderProfiles writes one line per profile, marking the active one.
+func (r *TextRenderer) RenderProfiles(w io.Writer, profiles []string, active string) error {
+	printer := output.NewPrinter(r.theme(), w)
+
+	if len(profiles) == 0 {
+		printer.PrintInfo("No profiles found; add one as .clause/config.<profile>.yaml")
+		return nil
+	}
+
+	for _, profile := range profiles {
+		if profile == active {
+			printer.PrintSuccess("%s (active)", profile)
+		} else {
+			printer.Println("  " + profile)
+		}
+	}
+	if active != "" && !slices.Contains(profiles, active) {
+		printer.PrintWarning("Active profile %q has no config file", active)
+	}
+	return nil
+}
+
+// RenderChecks writes one line per check with its status and details,
+// followed by a summary. With ErrorsOnly only failed checks are listed.
+func (r *TextRenderer) RenderChecks(w io.Writer, checks []ProjectCheck) error {
+	theme := r.theme()
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(theme.Colors.Primary))
+	statusStyles := map[string]lipgloss.Style{
+		CheckPass: lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Colors.Success)),
+		CheckWarn: lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Colors.Warning)),
+		CheckFail: lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Colors.Error)),
+	}
+	labels := map[string]string{CheckPass: "✓ PASS", CheckWarn: "⚠ WARN", CheckFail: "✗ FAIL"}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, titleStyle.Render("Validating project..."))
+	fmt.Fprintln(w)
+
+	for _, check := range checks {
+		if r.ErrorsOnly && check.Status != CheckFail {
+			continue
+		}
+		fmt.Fprintf(w, "  %-25s %s\n", check.Name, statusStyles[check.Status].Render(labels[check.Status]))
+		for _, detail := range check.Details {
+			fmt.Fprintf(w, "      %s\n", detail)
+		}
+	}
+	fmt.Fprintln(w)
+
+	summary := countChecks(checks)
+	fmt.Fprintf(w, "Summary: %d/%d checks passed", summary.Passed, len(checks))
+	if summary.Warnings > 0 {
+		fmt.Fprintf(w, ", %d warnings", summary.Warnings)
+	}
+	if summary.Failures > 0 {
+		fmt.Fprintf(w, ", %d failures", summary.Failures)
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+// RenderVersion writes the version details in a styled list.
+func (r *TextRenderer) RenderVersion(w io.Writer, info VersionInfo) error {
+	theme := r.theme()
+
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color(theme.Colors.Primary)).
+		Padding(1, 2).
+		Margin(1, 0)
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color(theme.Colors.Primary)).
+		MarginBottom(1)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(theme.Colors.TextMuted)).
+		Width(12)
+
+	valueStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(theme.Colors.Text))
+
+	tipStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(theme.Colors.TextMuted)).
+		Italic(true)
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, titleStyle.Render("Clause - AI-Native Project Scaffolding"))
+	fmt.Fprintln(w)
+
+	details := []struct {
+		label, value string
+	}{
+		{"Version:", info.Version},
+		{"Build Time:", info.BuildTime},
+		{"Commit:", info.Commit},
+		{"Go Version:", info.GoVersion},
+		{"Platform:", info.Platform},
+	}
+	for _, d := range details {
+		fmt.Fprintf(w, "  %s%s\n", labelStyle.Render(d.label), valueStyle.Render(d.value))
+	}
+
+	fmt.Fprintln(w)
+
+	// Check for updates (in a real implementation)
+	// updateAvailable := checkForUpdates()
+	// if updateAvailable {
+	//     fmt.Println(styles.Warning("  A new version is available! Run 'clause update' to upgrade."))
+	// }
+
+	// Show quick tip
+	fmt.Fprintln(w, boxStyle.Render(tipStyle.Render("Run 'clause init' to create a new project")))
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+// RenderSettings writes one line per setting and the config file path.
+func (r *TextRenderer) RenderSettings(w io.Writer, settings []Setting, configFile string) error {
+	theme := r.theme()
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(theme.Colors.Primary))
+	keyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Colors.TextMuted)).Width(25)
+	valueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Colors.Text))
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, titleStyle.Render("Clause Configuration"))
+	fmt.Fprintln(w)
+	for _, setting := range settings {
+		fmt.Fprintf(w, "  %s%s\n", keyStyle.Render(setting.Key), valueStyle.Render(setting.Value))
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Config file:", configFile)
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+// RenderFieldDocs writes each field path with its type, description and
+// default value.
+func (r *TextRenderer) RenderFieldDocs(w io.Writer, docs []config.FieldDoc) error {
+	theme := r.theme()
+	keyStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(theme.Colors.Primary))
+	mutedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Colors.TextMuted))
+
+	for _, doc := range docs {
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "%s %s\n", keyStyle.Render(doc.Path), mutedStyle.Render("("+doc.Type+")"))
+		if doc.Description != "" {
+			fmt.Fprintf(w, "  %s\n", doc.Description)
+		}
+		if doc.Default != nil {
+			fmt.Fprintf(w, "  %s\n", mutedStyle.Render(fmt.Sprintf("default: %v", doc.Default)))
+		}
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+// RenderUnset confirms the removed key.
+func (r *TextRenderer) RenderUnset(w io.Writer, key, configFile string) error {
+	_, err := fmt.Fprintf(w, "Unset %s\n", key)
+	return err
+}
+
+// RenderError writes the error in a bordered panel sized to the terminal.
+func (r *TextRenderer) RenderError(w io.Writer, err error, suggestion string) error {
+	renderer := tui.NewRenderer(r.theme(), 80, 24)
+	if width, _, sizeErr := styles.GetTerminalSize(); sizeErr == nil {
+		renderer.SetSize(width, 0)
+	}
+	_, writeErr := fmt.Fprintln(w, renderer.ErrorPanel(err, suggestion))
+	return writeErr
+}
+
+// JSONRenderer renders indented JSON for scripts and other tools.
+type JSONRenderer struct{}
+
+// RenderConfig writes the configuration as a JSON object.
+func (r *JSONRenderer) RenderConfig(w io.Writer, cfg *config.ProjectConfig) error {
+	return writeJSON(w, cfg)
+}
+
+// RenderValidation writes the report with its issues and counts.
+func (r *JSONRenderer) RenderValidation(w io.Writer, report *config.AnalysisReport) error {
+	return writeJSON(w, report)
+}
+
+// RenderDiff writes the compared names and the list of changes.
+func (r *JSONRenderer) RenderDiff(w io.Writer, from, to string, changes config.ConfigChanges) error {
+	if changes == nil {
+		changes = config.ConfigChanges{}
+	}
+	return writeJSON(w, struct {
+		From    string               `json:"from"`
+		To      string               `json:"to"`
+		Changes config.ConfigChanges `json:"changes"`
+	}{from, to, changes})
+}
+
+// RenderSummary writes the created, skipped and overwritten paths.
+func (r *JSONRenderer) RenderSummary(w io.Writer, summary generator.Summary) error {
+	return writeJSON(w, summary)
+}
+
+// RenderChangeSet writes the new files, modified files and config changes.
+func (r *JSONRenderer) RenderChangeSet(w io.Writer, changes *generator.ChangeSet) error {
+	return writeJSON(w, changes)
+}
+
+// RenderProfiles writes the profile names and the active profile.
+func (r *JSONRenderer) RenderProfiles(w io.Writer, profiles []string, active string) error {
+	if profiles == nil {
+		profiles = []string{}
+	}
+	return writeJSON(w, struct {
+		Profiles []string `json:"profiles"`
+		Active   string   `json:"active"`
+	}{profiles, active})
+}
+
+// RenderChecks writes the checks with their status and details and the
+// number of passed, warning and failed checks.
+func (r *JSONRenderer) RenderChecks(w io.Writer, checks []ProjectCheck) error {
+	if checks == nil {
+		checks = []ProjectCheck{}
+	}
+	return writeJSON(w, struct {
+		Checks []ProjectCheck `json:"checks"`
+		checkSummary
+	}{checks, countChecks(checks)})
+}
+
+// RenderVersion writes the version and build information.
+func (r *JSONRenderer) RenderVersion(w io.Writer, info VersionInfo) error {
+	return writeJSON(w, info)
+}
+
+// RenderSettings writes the settings as an object and the config file path.
+func (r *JSONRenderer) RenderSettings(w io.Writer, settings []Setting, configFile string) error {
+	values := make(map[string]string, len(settings))
+	for _, setting := range settings {
+		values[setting.Key] = setting.Value
+	}
+	return writeJSON(w, struct {
+		Settings   map[string]string `json:"settings"`
+		ConfigFile string            `json:"config_file"`
+	}{values, configFile})
+}
+
+// RenderFieldDocs writes the field documentation as a list.
+func (r *JSONRenderer) RenderFieldDocs(w io.Writer, docs []config.FieldDoc) error {
+	if docs == nil {
+		docs = []config.FieldDoc{}
+	}
+	return writeJSON(w, struct {
+		Fields []config.FieldDoc `json:"fields"`
+	}{docs})
+}
+
+// RenderUnset writes the removed key and the file it was removed from.
+func (r *JSONRenderer) RenderUnset(w io.Writer, key, configFile string) error {
+	return writeJSON(w, struct {
+		Unset      string `json:"unset"`
+		ConfigFile string `json:"config_file"`
+	}{key, configFile})
+}
+
+// RenderError writes the error message, the field it refers to and the
+// suggested next step.
+func (r *JSONRenderer) RenderError(w io.Writer, err error, suggestion string) error {
+	return writeJSON(w, struct {
+		Error      string `json:"error"`
+		Field      string `json:"field,omitempty"`
+		Suggestion string `json:"suggestion,omitempty"`
+	}{err.Error(), errorField(err), suggestion})
+}
+
+// errorField returns the config field path an error refers to, if any.
+func errorField(err error) string {
+	var fieldErr interface{ FieldPath() string }
+	if errors.As(err, &fieldErr) {
+		return fieldErr.FieldPath()
+	}
+	return ""
+}
+
+// errorSuggestion returns the next step shown with a command's error:
+// explaining the field for config errors, and the command's help otherwise.
+func errorSuggestion(cmd *cobra.Command, err error) string {
+	if field := errorField(err); field != "" {
+		return fmt.Sprintf("run 'clause config explain %s' to see the accepted values", field)
+	}
+	if cmd == nil {
+		cmd = rootCmd
+	}
+	return fmt.Sprintf("run '%s --help' for usage", cmd.CommandPath())
+}
+
+// writeJSON writes v as indented JSON followed by a newline.
+func writeJSON(w io.Writer, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON: %w", err)
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+// diffChanges converts configuration changes for tui.Renderer.DiffView.
+func diffChanges(changes config.ConfigChanges) []tui.DiffChange {
+	diff := make([]tui.DiffChange, len(changes))
+	for i, change := range changes {
+		diff[i] = tui.DiffChange{Path: change.Path, Old: change.Old, New: change.New}
+	}
+	return diff
+}
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/clause-cli/clause/internal/config"
+)
+
+func testReports() map[string]*config.AnalysisReport {
+	return map[string]*config.AnalysisReport{
+		"valid": {Issues: []config.AnalysisIssue{}},
+		"issues": {
+			Issues: []config.AnalysisIssue{
+				{
+					Field:      "backend.framework",
+					Message:    `unsupported backend framework: "rails"`,
+					Severity:   "error",
+					Category:   "validation",
+					Suggestion: "run 'clause config explain backend.framework'",
+				},
+				{
+					Field:    "backend.auth.session_duration",
+					Message:  "session duration of 10000 hours is longer than 30 days",
+					Severity: "warning",
+					Category: "validation",
+				},
+			},
+			Errors:   1,
+			Warnings: 1,
+		},
+	}
+}
+
+func TestJSONRendererValidation(t *testing.T) {
+	for name, report := range testReports() {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := (&JSONRenderer{}).RenderValidation(&buf, report); err != nil {
+				t.Fatal(err)
+			}
+			if !json.Valid(buf.Bytes()) {
+				t.Fatalf("output is not valid JSON:\n%s", buf.String())
+			}
+
+			var got config.AnalysisReport
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(&got, report) {
+				t.Errorf("decoded report = %+v, want %+v", got, *report)
+			}
+		})
+	}
+}
+
+func TestTextRendererValidation(t *testing.T) {
+	reports := testReports()
+
+	tests := []struct {
+		name     string
+		renderer *TextRenderer
+		report   *config.AnalysisReport
+		want     []string
+		notWant  []string
+	}{
+		{
+			name:     "valid",
+			renderer: &TextRenderer{},
+			report:   reports["valid"],
+			want:     []string{"✓ Configuration is valid"},
+		},
+		{
+			name:     "issues",
+			renderer: &TextRenderer{},
+			report:   reports["issues"],
+			want: []string{
+				`✗ backend.framework: unsupported backend framework: "rails"`,
+				"    run 'clause config explain backend.framework'",
+				"⚠ backend.auth.session_duration: session duration",
+			},
+		},
+		{
+			name:     "errors only",
+			renderer: &TextRenderer{ErrorsOnly: true},
+			report:   reports["issues"],
+			want:     []string{"✗ backend.framework"},
+			notWant:  []string{"⚠", "session_duration"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.renderer.RenderValidation(&buf, tt.report); err != nil {
+				t.Fatal(err)
+			}
+			got := buf.String()
+			if json.Valid(buf.Bytes()) {
+				t.Errorf("text output is JSON:\n%s", got)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("output does not contain %q:\n%s", want, got)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(got, notWant) {
+					t.Errorf("output contains %q:\n%s", notWant, got)
+				}
+			}
+		})
+	}
+}
+
+func TestJSONRendererEmptyLists(t *testing.T) {
+	renderer := &JSONRenderer{}
+
+	tests := []struct {
+		name   string
+		render func(buf *bytes.Buffer) error
+		key    string
+	}{
+		{"diff", func(buf *bytes.Buffer) error { return renderer.RenderDiff(buf, "staging", "prod", nil) }, "changes"},
+		{"profiles", func(buf *bytes.Buffer) error { return renderer.RenderProfiles(buf, nil, "") }, "profiles"},
+		{"checks", func(buf *bytes.Buffer) error { return renderer.RenderChecks(buf, nil) }, "checks"},
+		{"field docs", func(buf *bytes.Buffer) error { return renderer.RenderFieldDocs(buf, nil) }, "fields"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.render(&buf); err != nil {
+				t.Fatal(err)
+			}
+
+			var got map[string]json.RawMessage
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("output is not a JSON object: %v\n%s", err, buf.String())
+			}
+			if string(got[tt.key]) != "[]" {
+				t.Errorf("%s = %s, want []", tt.key, got[tt.key])
+			}
+		})
+	}
+}
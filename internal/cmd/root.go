@@ -21,10 +21,12 @@ var (
 
 // Global flags.
 var (
-	cfgFile string
-	verbose bool
-	quiet   bool
-	noColor bool
+	cfgFile      string
+	verbose      bool
+	quiet        bool
+	noColor      bool
+	outputFormat string
+	themeFile    string
 )
 
 // rootCmd represents the base command when called without any subcommands.
@@ -66,11 +68,14 @@ func init() {
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "verbose output")
 	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "suppress non-essential output")
 	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "disable colored output")
+	rootCmd.PersistentFlags().StringVar(&outputFormat, "format", FormatText, "output format (text, json)")
+	rootCmd.PersistentFlags().StringVar(&themeFile, "theme", "", "YAML or JSON theme file (default is $"+styles.ThemeEnv+")")
 
 	// Bind flags to viper
 	viper.BindPFlag("verbose", rootCmd.PersistentFlags().Lookup("verbose"))
 	viper.BindPFlag("quiet", rootCmd.PersistentFlags().Lookup("quiet"))
 	viper.BindPFlag("no_color", rootCmd.PersistentFlags().Lookup("no-color"))
+	viper.BindPFlag("theme", rootCmd.PersistentFlags().Lookup("theme"))
 }
 
 func preRun(cmd *cobra.Command, args []string) error {
@@ -80,6 +85,21 @@ func preRun(cmd *cobra.Command, args []string) error {
 		os.Setenv("NO_COLOR", "1")
 	}
 
+	if _, err := newOutputRenderer(outputFormat); err != nil {
+		// validate --format used to take the format of the config on stdin
+		if cmd == validateCmd && (outputFormat == "yaml" || outputFormat == "yml") {
+			return fmt.Errorf("%w; use --input-format %s for a config read from stdin", err, outputFormat)
+		}
+		return err
+	}
+
+	// Load a custom theme from --theme or CLAUSE_THEME
+	if path := viper.GetString("theme"); path != "" {
+		if err := styles.SetThemeFromFile(path); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -140,8 +160,10 @@ func IsQuiet() bool {
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 // Returns the exit code (0 for success, non-zero for error).
 func ExecuteWithError() int {
-	if err := rootCmd.Execute(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	if cmd, err := rootCmd.ExecuteC(); err != nil {
+		if renderErr := outputRenderer().RenderError(os.Stderr, err, errorSuggestion(cmd, err)); renderErr != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
 		return 1
 	}
 	return 0
@@ -1,10 +1,19 @@
 package cmd
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/clause-cli/clause/internal/config"
 	"github.com/clause-cli/clause/pkg/styles"
+	"github.com/clause-cli/clause/pkg/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -20,6 +29,7 @@ The update command:
 3. Downloads the new binary if an update is available
 4. Verifies the checksum
 5. Performs an atomic replacement
+6. Migrates the current project's configuration to the latest schema
 
 Examples:
   clause update              # Check and install updates
@@ -40,47 +50,139 @@ func init() {
 	updateCmd.Flags().StringVarP(&updateChannel, "channel", "c", "stable", "update channel (stable, beta, nightly)")
 }
 
+// releasesURL is the GitHub API endpoint listing Clause releases.
+const releasesURL = "https://api.github.com/repos/clause-cli/clause/releases"
+
+// ReleaseSource returns the versions of Clause that have been released.
+type ReleaseSource func(ctx context.Context) ([]string, error)
+
+// releaseSource is where the update command looks up releases. Tests
+// replace it to avoid the network.
+var releaseSource ReleaseSource = func(ctx context.Context) ([]string, error) {
+	return fetchReleases(ctx, http.DefaultClient, releasesURL)
+}
+
+// fetchReleases returns the versions of the published releases listed at
+// url in the GitHub releases API format, without their leading "v". Drafts
+// are skipped.
+func fetchReleases(ctx context.Context, client *http.Client, url string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned %s", url, resp.Status)
+	}
+
+	var releases []struct {
+		TagName string `json:"tag_name"`
+		Draft   bool   `json:"draft"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
+		return nil, fmt.Errorf("failed to decode releases: %w", err)
+	}
+
+	var versions []string
+	for _, release := range releases {
+		if release.Draft || release.TagName == "" {
+			continue
+		}
+		versions = append(versions, strings.TrimPrefix(release.TagName, "v"))
+	}
+	return versions, nil
+}
+
 func runUpdate(cmd *cobra.Command, args []string) error {
 	theme := styles.GetTheme()
-
-	// TODO: Implement actual update checking
-	// For now, show a placeholder message
+	out := cmd.OutOrStdout()
 
 	currentVersion := GetVersion()
-	latestVersion := "0.1.0" // Placeholder
+	releases, err := releaseSource(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("failed to check for updates: %w", err)
+	}
+
+	latestVersion, available, err := utils.CheckForUpdate(currentVersion, releases)
+	if err != nil {
+		// Development builds have no release version to compare, so any
+		// release counts as an update
+		latestVersion, _ = utils.FindLatest(releases)
+		available = latestVersion != ""
+	} else if !available {
+		latestVersion = currentVersion
+	}
 
-	fmt.Println()
+	fmt.Fprintln(out)
 
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
 		Foreground(lipgloss.Color(theme.Colors.Primary))
 
-	fmt.Println(titleStyle.Render("Checking for updates..."))
-	fmt.Println()
-	fmt.Printf("  Current version: %s\n", currentVersion)
-	fmt.Printf("  Latest version:  %s\n", latestVersion)
-	fmt.Printf("  Channel:         %s\n", updateChannel)
-	fmt.Println()
+	fmt.Fprintln(out, titleStyle.Render("Checking for updates..."))
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "  Current version: %s\n", currentVersion)
+	fmt.Fprintf(out, "  Latest version:  %s\n", latestVersion)
+	fmt.Fprintf(out, "  Channel:         %s\n", updateChannel)
+	fmt.Fprintln(out)
 
 	if updateCheckOnly {
-		if currentVersion == latestVersion {
+		if !available {
 			successStyle := lipgloss.NewStyle().
 				Foreground(lipgloss.Color(theme.Colors.Success))
-			fmt.Println(successStyle.Render("✓ You're already on the latest version!"))
+			fmt.Fprintln(out, successStyle.Render("✓ You're already on the latest version!"))
 		} else {
 			infoStyle := lipgloss.NewStyle().
 				Foreground(lipgloss.Color(theme.Colors.Info))
-			fmt.Println(infoStyle.Render("ℹ An update is available. Run 'clause update' to install."))
+			fmt.Fprintln(out, infoStyle.Render("ℹ An update is available. Run 'clause update' to install."))
 		}
 		return nil
 	}
 
-	fmt.Println("Automatic updates are not yet implemented.")
-	fmt.Println()
-	fmt.Println("To update manually:")
-	fmt.Println("  brew upgrade clause-cli/tap/clause     # Homebrew")
-	fmt.Println("  winget upgrade Clause.ClauseCLI        # Windows")
-	fmt.Println()
+	if err := migrateProjectConfig(out); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out, "Automatic updates are not yet implemented.")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "To update manually:")
+	fmt.Fprintln(out, "  brew upgrade clause-cli/tap/clause     # Homebrew")
+	fmt.Fprintln(out, "  winget upgrade Clause.ClauseCLI        # Windows")
+	fmt.Fprintln(out)
 
 	return nil
 }
+
+// migrateProjectConfig upgrades the configuration of the project in the
+// current directory, if any, to the latest schema version.
+func migrateProjectConfig(out io.Writer) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	configPath, err := config.FindProjectConfig(cwd)
+	if err != nil {
+		// Not in a project, nothing to migrate
+		return nil
+	}
+
+	migrated, err := config.MigrateConfigFile(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to migrate %s: %w", configPath, err)
+	}
+	if migrated {
+		fmt.Fprintf(out, "Migrated %s to configuration schema %s\n\n", configPath, config.ConfigVersion)
+	}
+	return nil
+}
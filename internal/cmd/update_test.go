@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestFetchReleases(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:   "published releases",
+			status: http.StatusOK,
+			body: `[
+				{"tag_name": "v2.0.0", "draft": true},
+				{"tag_name": "v1.3.0-beta.1", "prerelease": true},
+				{"tag_name": "v1.2.0"},
+				{"tag_name": "1.1.0"}
+			]`,
+			want: []string{"1.3.0-beta.1", "1.2.0", "1.1.0"},
+		},
+		{name: "no releases", status: http.StatusOK, body: `[]`},
+		{name: "rate limited", status: http.StatusForbidden, body: `{"message": "rate limited"}`, wantErr: true},
+		{name: "invalid body", status: http.StatusOK, body: `<html>`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			got, err := fetchReleases(context.Background(), server.Client(), server.URL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("fetchReleases error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("fetchReleases() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunUpdateCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  string
+		releases []string
+		fetchErr error
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name:     "update available",
+			current:  "1.0.0",
+			releases: []string{"1.2.0", "1.3.0-beta.1", "0.9.0"},
+			want:     []string{"Latest version:  1.2.0", "An update is available"},
+		},
+		{
+			name:     "up to date",
+			current:  "1.2.0",
+			releases: []string{"1.2.0", "1.3.0-beta.1"},
+			want:     []string{"Latest version:  1.2.0", "already on the latest version"},
+		},
+		{
+			name:     "development build",
+			current:  "dev",
+			releases: []string{"1.2.0"},
+			want:     []string{"Latest version:  1.2.0", "An update is available"},
+		},
+		{
+			name:     "fetch fails",
+			current:  "1.0.0",
+			fetchErr: errors.New("network unreachable"),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			savedSource, savedVersion, savedCheck := releaseSource, version, updateCheckOnly
+			t.Cleanup(func() {
+				releaseSource, version, updateCheckOnly = savedSource, savedVersion, savedCheck
+			})
+			releaseSource = func(context.Context) ([]string, error) { return tt.releases, tt.fetchErr }
+			version = tt.current
+			updateCheckOnly = true
+
+			var out bytes.Buffer
+			cmd := &cobra.Command{}
+			cmd.SetContext(context.Background())
+			cmd.SetOut(&out)
+
+			err := runUpdate(cmd, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("runUpdate error = %v, wantErr %v", err, tt.wantErr)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("output does not contain %q:\n%s", want, out.String())
+				}
+			}
+		})
+	}
+}
@@ -1,116 +1,302 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 
-	"github.com/charmbracelet/lipgloss"
-	"github.com/clause-cli/clause/pkg/styles"
+	"github.com/clause-cli/clause/internal/config"
+	"github.com/clause-cli/clause/internal/governance"
 	"github.com/spf13/cobra"
 )
 
 // validateCmd represents the validate command.
 var validateCmd = &cobra.Command{
-	Use:   "validate",
+	Use:   "validate [config-file]",
 	Short: "Validate project governance compliance",
 	Long: `Validate that the current project complies with Clause governance rules.
 
 This command checks:
 - AI context files are present and valid
-- Component registry is up to date
+- Component registry is up to date with the components in the source tree
 - Governance rules are being followed
 - Documentation standards are met
 
+Governance rules are configured under governance.rules. Each rule can be
+given a severity (error, warning, info) or turned off by name, paths matching
+exclude_patterns are skipped, and strict_mode turns warnings into errors.
+The command exits non-zero when a rule reports an error.
+
+When a config file is given, only that configuration is checked. Use - to
+read the configuration from stdin.
+
 Examples:
   clause validate              # Run all validation checks
-  clause validate --fix        # Attempt to fix issues
-  clause validate --json       # Output results as JSON`,
+  clause validate --fix        # Attempt to fix issues, such as rescanning the registry
+  clause validate --format json       # Output results as JSON
+  clause validate config.yaml         # Check a configuration file
+  cat config.json | clause validate - --input-format json`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: runValidate,
 }
 
 var (
-	validateFix   bool
-	validateJSON  bool
-	validateQuiet bool
+	validateFix    bool
+	validateJSON   bool
+	validateQuiet  bool
+	validateFormat string
 )
 
 func init() {
 	rootCmd.AddCommand(validateCmd)
 
 	validateCmd.Flags().BoolVar(&validateFix, "fix", false, "attempt to fix found issues")
-	validateCmd.Flags().BoolVar(&validateJSON, "json", false, "output results as JSON")
+	validateCmd.Flags().BoolVar(&validateJSON, "json", false, "output results as JSON (same as --format json)")
 	validateCmd.Flags().BoolVarP(&validateQuiet, "quiet", "q", false, "only show errors")
+	validateCmd.Flags().StringVar(&validateFormat, "input-format", "yaml", "format of a config read from stdin (yaml, json)")
+}
+
+// Project check statuses.
+const (
+	CheckPass = "pass"
+	CheckWarn = "warn"
+	CheckFail = "fail"
+)
+
+// ProjectCheck is the outcome of one of the project checks run by validate.
+type ProjectCheck struct {
+	// Name describes what was checked
+	Name string `json:"name"`
+
+	// Status is pass, warn or fail
+	Status string `json:"status"`
+
+	// Details explains warnings and failures, one line each
+	Details []string `json:"details,omitempty"`
+}
+
+// checkSummary counts project checks by status.
+type checkSummary struct {
+	Passed   int `json:"passed"`
+	Warnings int `json:"warnings"`
+	Failures int `json:"failures"`
+}
+
+// countChecks counts the checks with each status.
+func countChecks(checks []ProjectCheck) checkSummary {
+	var summary checkSummary
+	for _, check := range checks {
+		switch check.Status {
+		case CheckPass:
+			summary.Passed++
+		case CheckWarn:
+			summary.Warnings++
+		case CheckFail:
+			summary.Failures++
+		}
+	}
+	return summary
 }
 
 func runValidate(cmd *cobra.Command, args []string) error {
-	theme := styles.GetTheme()
+	if len(args) == 1 {
+		return validateConfigFile(cmd, args[0])
+	}
 
-	// TODO: Implement actual validation
-	// For now, show placeholder results
+	registryStatus, registryDetails := checkComponentRegistry()
+	contextStatus, contextDetails := checkContextFreshness()
+	rulesStatus, rulesDetails, docsStatus, docsDetails := checkGovernanceRules()
 
-	titleStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color(theme.Colors.Primary))
+	checks := []ProjectCheck{
+		{"AI context files", contextStatus, contextDetails},
+		{"Component registry", registryStatus, registryDetails},
+		{"Governance rules", rulesStatus, rulesDetails},
+		{"Documentation standards", docsStatus, docsDetails},
+		{"Code patterns", CheckPass, nil},
+	}
+
+	if err := validateRenderer().RenderChecks(cmd.OutOrStdout(), checks); err != nil {
+		return err
+	}
+
+	if summary := countChecks(checks); summary.Failures > 0 {
+		return fmt.Errorf("validation failed with %d errors", summary.Failures)
+	}
+	return nil
+}
 
-	passStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color(theme.Colors.Success))
+// validateRenderer returns the renderer selected by --format, or JSON with
+// --json, limited to errors with --quiet.
+func validateRenderer() OutputRenderer {
+	renderer := outputRenderer()
+	if validateJSON {
+		renderer = &JSONRenderer{}
+	}
+	if text, ok := renderer.(*TextRenderer); ok {
+		text.ErrorsOnly = validateQuiet
+	}
+	return renderer
+}
 
-	failStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color(theme.Colors.Error))
+// validateConfigFile checks a single configuration file, or stdin when path
+// is "-", and prints the issues found.
+func validateConfigFile(cmd *cobra.Command, path string) error {
+	loader := config.NewLoader()
 
-	warnStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color(theme.Colors.Warning))
+	var cfg *config.ProjectConfig
+	var err error
+	if path == "-" {
+		cfg, err = loader.LoadFromReader(cmd.InOrStdin(), validateFormat)
+	} else {
+		cfg, err = loader.LoadFromPath(path)
+	}
+	if err != nil {
+		return err
+	}
 
-	fmt.Println()
-	fmt.Println(titleStyle.Render("Validating project..."))
-	fmt.Println()
+	report := config.Analyze(cfg)
 
-	// Placeholder validation results
-	checks := []struct {
-		name   string
-		status string // "pass", "fail", "warn"
-	}{
-		{"AI context files", "pass"},
-		{"Component registry", "pass"},
-		{"Governance rules", "warn"},
-		{"Documentation standards", "pass"},
-		{"Code patterns", "pass"},
+	if err := validateRenderer().RenderValidation(cmd.OutOrStdout(), report); err != nil {
+		return err
 	}
 
-	var passCount, failCount, warnCount int
+	if report.HasErrors() {
+		return fmt.Errorf("configuration has %d errors", report.Errors)
+	}
+	return nil
+}
 
-	for _, check := range checks {
-		var status string
-		switch check.status {
-		case "pass":
-			status = passStyle.Render("✓ PASS")
-			passCount++
-		case "fail":
-			status = failStyle.Render("✗ FAIL")
-			failCount++
-		case "warn":
-			status = warnStyle.Render("⚠ WARN")
-			warnCount++
+// checkComponentRegistry validates .clause/registry.yaml in the current
+// project, returning the check status and one line per invalid entry or
+// component out of step with the source tree. With --fix the registry is
+// rescanned first.
+func checkComponentRegistry() (string, []string) {
+	projectPath, err := findProjectRoot()
+	if err != nil {
+		return CheckWarn, []string{"no .clause directory found"}
+	}
+
+	cfg, err := config.NewLoader(config.WithProjectDir(projectPath)).Load()
+	if err != nil {
+		return CheckWarn, []string{fmt.Sprintf("failed to load config: %v", err)}
+	}
+	generator := governance.NewGenerator(projectPath, cfg)
+
+	if validateFix {
+		if _, err := generator.UpdateRegistry(); err != nil {
+			return CheckFail, []string{err.Error()}
 		}
+	}
 
-		fmt.Printf("  %-25s %s\n", check.name, status)
+	registryPath := filepath.Join(projectPath, ".clause", "registry.yaml")
+	if _, err := os.Stat(registryPath); os.IsNotExist(err) {
+		return CheckWarn, []string{"registry.yaml not found"}
 	}
 
-	fmt.Println()
+	registry := governance.NewComponentRegistry()
+	err = registry.Load(registryPath)
+	if err == nil {
+		drift, err := generator.RegistryDrift()
+		if err != nil {
+			return CheckWarn, []string{err.Error()}
+		}
+		if len(drift) > 0 {
+			return CheckWarn, append(drift, "run clause validate --fix to rescan the source tree")
+		}
+		return CheckPass, nil
+	}
 
-	// Summary
-	total := len(checks)
-	fmt.Printf("Summary: %d/%d checks passed", passCount, total)
-	if warnCount > 0 {
-		fmt.Printf(", %d warnings", warnCount)
+	var entryErrs governance.RegistryErrors
+	if errors.As(err, &entryErrs) {
+		details := make([]string, len(entryErrs))
+		for i, e := range entryErrs {
+			details[i] = e.Error()
+		}
+		return CheckFail, details
 	}
-	if failCount > 0 {
-		fmt.Printf(", %d failures", failCount)
+
+	return CheckFail, []string{err.Error()}
+}
+
+// documentationRules are the governance rules reported under documentation
+// standards rather than governance rules.
+var documentationRules = map[string]bool{
+	"readme":       true,
+	"contributing": true,
+	"changelog":    true,
+}
+
+// checkGovernanceRules runs the governance rules of the current project and
+// returns the status and violations of the governance rules and of the
+// documentation standards. Error violations fail the check, which makes
+// validate exit non-zero.
+func checkGovernanceRules() (string, []string, string, []string) {
+	projectPath, err := findProjectRoot()
+	if err != nil {
+		details := []string{"no .clause directory found"}
+		return CheckWarn, details, CheckWarn, details
 	}
-	fmt.Println()
 
-	if failCount > 0 {
-		return fmt.Errorf("validation failed with %d errors", failCount)
+	cfg, err := config.NewLoader(config.WithProjectDir(projectPath)).Load()
+	if err != nil {
+		details := []string{fmt.Sprintf("failed to load config: %v", err)}
+		return CheckWarn, details, CheckWarn, details
 	}
 
-	return nil
+	var rules, docs []governance.RuleViolation
+	for _, violation := range governance.NewValidator(cfg).Validate(projectPath) {
+		if documentationRules[violation.Rule] {
+			docs = append(docs, violation)
+		} else {
+			rules = append(rules, violation)
+		}
+	}
+
+	rulesStatus, rulesDetails := violationStatus(rules)
+	docsStatus, docsDetails := violationStatus(docs)
+	return rulesStatus, rulesDetails, docsStatus, docsDetails
+}
+
+// violationStatus returns the check status for a set of rule violations and
+// one line per violation.
+func violationStatus(violations []governance.RuleViolation) (string, []string) {
+	status := CheckPass
+	details := make([]string, len(violations))
+	for i, violation := range violations {
+		details[i] = violation.String()
+		if violation.Severity == governance.SeverityWarning && status == CheckPass {
+			status = CheckWarn
+		}
+	}
+	if governance.HasErrors(violations) {
+		status = CheckFail
+	}
+	return status, details
+}
+
+// checkContextFreshness reports whether .clause/context.yaml still matches
+// the project configuration.
+func checkContextFreshness() (string, []string) {
+	projectPath, err := findProjectRoot()
+	if err != nil {
+		return CheckWarn, []string{"no .clause directory found"}
+	}
+
+	cfg, err := config.NewLoader(config.WithProjectDir(projectPath)).Load()
+	if err != nil {
+		return CheckWarn, []string{fmt.Sprintf("failed to load config: %v", err)}
+	}
+	if !cfg.Governance.Enabled {
+		return CheckPass, nil
+	}
+
+	stale, reasons, err := governance.NewGenerator(projectPath, cfg).IsContextStale()
+	if err != nil {
+		return CheckWarn, []string{err.Error()}
+	}
+	if stale {
+		return CheckWarn, append(reasons, "regenerate the governance files to update context.yaml")
+	}
+	return CheckPass, nil
 }
@@ -0,0 +1,261 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/clause-cli/clause/internal/config/enum"
+	"github.com/clause-cli/clause/pkg/utils"
+)
+
+// Issue categories reported by Analyze.
+const (
+	IssueValidation  = "validation"
+	IssueDeprecation = "deprecation"
+	IssueCoherence   = "coherence"
+)
+
+// AnalysisIssue is a single finding with a suggested remediation.
+type AnalysisIssue struct {
+	// Field is the field path the issue relates to
+	Field string `json:"field"`
+
+	// Message describes the issue
+	Message string `json:"message"`
+
+	// Severity indicates the issue severity (error, warning)
+	Severity string `json:"severity"`
+
+	// Category is one of validation, deprecation or coherence
+	Category string `json:"category"`
+
+	// Suggestion explains how to resolve the issue (optional)
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// AnalysisReport combines validation, deprecation and coherence findings.
+type AnalysisReport struct {
+	// Issues contains all findings, errors first
+	Issues []AnalysisIssue `json:"issues"`
+
+	// Errors is the number of error-level issues
+	Errors int `json:"errors"`
+
+	// Warnings is the number of warning-level issues
+	Warnings int `json:"warnings"`
+}
+
+// HasErrors returns true if the report contains error-level issues.
+func (r *AnalysisReport) HasErrors() bool {
+	return r.Errors > 0
+}
+
+// ByCategory returns the issues in the given category.
+func (r *AnalysisReport) ByCategory(category string) []AnalysisIssue {
+	var issues []AnalysisIssue
+	for _, issue := range r.Issues {
+		if issue.Category == category {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// add appends an issue and updates the severity counts.
+func (r *AnalysisReport) add(issue AnalysisIssue) {
+	if issue.Suggestion == "" {
+		issue.Suggestion = issueHelp[issue.Field]
+	}
+	switch issue.Severity {
+	case "error":
+		r.Errors++
+	case "warning":
+		r.Warnings++
+	}
+	r.Issues = append(r.Issues, issue)
+}
+
+// issueHelp maps field paths to remediation hints shown alongside issues.
+var issueHelp = map[string]string{
+	"config":                            "enable the frontend or the backend: clause config set frontend.enabled true",
+	"metadata.name":                     "use a lowercase name with letters, numbers and hyphens, e.g. my-app",
+	"metadata.version":                  "use a semantic version such as 0.1.0",
+	"metadata.repository":               "use https://github.com/org/repo, git@github.com:org/repo.git or org/repo",
+	"metadata.keywords":                 fmt.Sprintf("keep at most %d short, lowercase kebab-case keywords", MaxKeywords),
+	"frontend.framework":                "pick a supported framework, e.g. clause config set frontend.framework react",
+	"frontend.styling":                  "pick a supported styling approach such as tailwind or css-modules",
+	"frontend.package_manager":          "use one of npm, yarn, pnpm or bun",
+	"frontend.build_tool":               "use one of " + enum.BuildTools.String(),
+	"frontend.node_version":             "use a Node.js major version such as 20",
+	"frontend.directory":                "set a directory, e.g. clause config set frontend.directory src",
+	"frontend.features.ssr":             "switch to an SSR framework such as nextjs or nuxt, or disable SSR",
+	"backend.framework":                 "pick a supported framework, e.g. clause config set backend.framework fastapi",
+	"backend.language":                  "set the backend language, e.g. clause config set backend.language python",
+	"backend.directory":                 "use separate directories, e.g. clause config set backend.directory backend",
+	"backend.database.primary":          "use a supported database such as postgresql, mysql or sqlite",
+	"backend.database.orm":              "choose an ORM for the backend framework that supports the selected database",
+	"backend.database.migrations":       "select an ORM, or disable migrations: clause config set backend.database.migrations false",
+	"backend.auth.provider":             "use a supported provider such as jwt, oauth or clerk",
+	"backend.auth.session_duration":     "set a duration in hours between 1 and 720, e.g. 24",
+	"backend.api.style":                 "use one of rest, graphql, grpc or trpc",
+	"backend.api.versioning":            "use one of url, header, query or none",
+	"backend.api.cors.enabled":          "disable CORS or add the frontend origin to backend.api.cors.origins",
+	"backend.features.background_jobs":  "enable Redis: clause config set backend.database.redis true",
+	"backend.features.rate_limiting":    "enable Redis: clause config set backend.database.redis true",
+	"backend.features.email":            "set a provider, e.g. clause config set backend.features.email_provider resend",
+	"infrastructure.ci":                 "use a supported CI platform such as github-actions or gitlab-ci",
+	"infrastructure.hosting":            "use a supported hosting platform such as vercel, aws or fly",
+	"infrastructure.kubernetes":         "enable Docker: clause config set infrastructure.docker true",
+	"infrastructure.docker_compose":     "enable it with: clause config set infrastructure.docker_compose true",
+	"infrastructure.monitoring.enabled": "enable monitoring with a provider such as sentry or datadog",
+	"infrastructure.monitoring":         "set infrastructure.monitoring.provider or enable error tracking",
+	"governance.context_level":          "use one of minimal, standard or comprehensive",
+	"governance.assistant_targets":      "use cursor, copilot or claude",
+	"governance":                        "enable governance or turn off its individual features",
+	"version":                           "run 'clause update' to migrate the configuration",
+}
+
+// deprecatedOption describes a configuration value that is still accepted
+// but should be replaced.
+type deprecatedOption struct {
+	field       string
+	value       string
+	replacement string
+	get         func(c *ProjectConfig) string
+}
+
+// deprecatedOptions lists values that are accepted but scheduled for removal.
+var deprecatedOptions = []deprecatedOption{
+	{
+		field:       "frontend.build_tool",
+		value:       "turboPack",
+		replacement: "turbopack",
+		get:         func(c *ProjectConfig) string { return c.Frontend.BuildTool },
+	},
+	{
+		field:       "infrastructure.ci",
+		value:       "travis",
+		replacement: "github-actions",
+		get:         func(c *ProjectConfig) string { return c.Infrastructure.CI },
+	},
+}
+
+// deprecatedValues returns the deprecated values of the field.
+func deprecatedValues(field string) []string {
+	var values []string
+	for _, opt := range deprecatedOptions {
+		if opt.field == field {
+			values = append(values, opt.value)
+		}
+	}
+	return values
+}
+
+// isDeprecatedValue reports whether value is a deprecated value of the field.
+func isDeprecatedValue(field, value string) bool {
+	for _, opt := range deprecatedOptions {
+		if opt.field == field && opt.value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Analyze validates a configuration and explains the results. It combines
+// validation errors, deprecation warnings and coherence checks into a single
+// report with per-issue suggestions.
+func Analyze(cfg *ProjectConfig) *AnalysisReport {
+	report := &AnalysisReport{}
+
+	for _, err := range Validate(cfg) {
+		report.add(AnalysisIssue{
+			Field:    err.Field,
+			Message:  err.Message,
+			Severity: err.Severity,
+			Category: IssueValidation,
+		})
+	}
+
+	for _, issue := range analyzeDeprecations(cfg) {
+		report.add(issue)
+	}
+
+	for _, issue := range analyzeCoherence(cfg) {
+		report.add(issue)
+	}
+
+	// Errors first, keeping the discovery order within each severity
+	sort.SliceStable(report.Issues, func(i, j int) bool {
+		return report.Issues[i].Severity == "error" && report.Issues[j].Severity != "error"
+	})
+
+	return report
+}
+
+// analyzeDeprecations reports deprecated values and outdated schema versions.
+func analyzeDeprecations(cfg *ProjectConfig) []AnalysisIssue {
+	var issues []AnalysisIssue
+
+	for _, opt := range deprecatedOptions {
+		if opt.get(cfg) != opt.value {
+			continue
+		}
+		issues = append(issues, AnalysisIssue{
+			Field:      opt.field,
+			Message:    fmt.Sprintf("%q is deprecated", opt.value),
+			Severity:   "warning",
+			Category:   IssueDeprecation,
+			Suggestion: fmt.Sprintf("use %q instead: clause config set %s %s", opt.replacement, opt.field, opt.replacement),
+		})
+	}
+
+	if cfg.Version != "" {
+		if cmp, err := utils.CompareVersions(cfg.Version, ConfigVersion); err == nil && cmp < 0 {
+			issues = append(issues, AnalysisIssue{
+				Field:    "version",
+				Message:  fmt.Sprintf("configuration schema %s is older than %s", cfg.Version, ConfigVersion),
+				Severity: "warning",
+				Category: IssueDeprecation,
+			})
+		}
+	}
+
+	return issues
+}
+
+// analyzeCoherence reports settings that are individually valid but do not
+// make sense together.
+func analyzeCoherence(cfg *ProjectConfig) []AnalysisIssue {
+	var issues []AnalysisIssue
+
+	if cfg.Backend.Enabled && cfg.Backend.API.CORS.Enabled && !cfg.Frontend.Enabled && len(cfg.Backend.API.CORS.Origins) == 0 {
+		issues = append(issues, AnalysisIssue{
+			Field:    "backend.api.cors.enabled",
+			Message:  "CORS is enabled but there is no frontend and no allowed origins",
+			Severity: "warning",
+			Category: IssueCoherence,
+		})
+	}
+
+	monitoring := cfg.Infrastructure.Monitoring
+	if monitoring.Enabled && monitoring.Provider == "" && !monitoring.ErrorTracking {
+		issues = append(issues, AnalysisIssue{
+			Field:    "infrastructure.monitoring",
+			Message:  "monitoring is enabled but no provider or error tracking is configured",
+			Severity: "warning",
+			Category: IssueCoherence,
+		})
+	}
+
+	gov := cfg.Governance
+	if !gov.Enabled && (gov.ComponentRegistry || gov.BrainstormMd || gov.PromptGuidelines) {
+		issues = append(issues, AnalysisIssue{
+			Field:    "governance",
+			Message:  "governance features are configured but governance is disabled",
+			Severity: "warning",
+			Category: IssueCoherence,
+		})
+	}
+
+	return issues
+}
@@ -0,0 +1,92 @@
+package config
+
+import "testing"
+
+// analyzeConfig returns the standard preset with a valid name.
+func analyzeConfig(t *testing.T) *ProjectConfig {
+	t.Helper()
+
+	cfg, err := LoadPreset("standard")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.Metadata.Name = "demo"
+	return cfg
+}
+
+func TestAnalyzeAggregatesCategories(t *testing.T) {
+	cfg := analyzeConfig(t)
+	cfg.Metadata.Name = "Not Valid"
+	cfg.Infrastructure.CI = "travis"
+	cfg.Infrastructure.Monitoring.Enabled = true
+	cfg.Infrastructure.Monitoring.Provider = ""
+	cfg.Infrastructure.Monitoring.ErrorTracking = false
+
+	report := Analyze(cfg)
+	if report.Errors != 1 || report.Warnings != 2 {
+		t.Fatalf("got %d errors and %d warnings, want 1 and 2: %+v", report.Errors, report.Warnings, report.Issues)
+	}
+	if !report.HasErrors() {
+		t.Error("HasErrors() = false with an error in the report")
+	}
+
+	tests := []struct {
+		category string
+		field    string
+		severity string
+	}{
+		{IssueValidation, "metadata.name", "error"},
+		{IssueDeprecation, "infrastructure.ci", "warning"},
+		{IssueCoherence, "infrastructure.monitoring", "warning"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.category, func(t *testing.T) {
+			issues := report.ByCategory(tt.category)
+			if len(issues) != 1 {
+				t.Fatalf("got %d %s issues, want 1: %+v", len(issues), tt.category, issues)
+			}
+			issue := issues[0]
+			if issue.Field != tt.field || issue.Severity != tt.severity {
+				t.Errorf("got %s %s, want %s %s", issue.Field, issue.Severity, tt.field, tt.severity)
+			}
+			if issue.Suggestion == "" {
+				t.Errorf("%s issue has no suggestion", issue.Field)
+			}
+		})
+	}
+
+	if report.Issues[0].Severity != "error" {
+		t.Errorf("first issue is a %s, want errors first", report.Issues[0].Severity)
+	}
+}
+
+func TestAnalyzeCleanConfig(t *testing.T) {
+	report := Analyze(analyzeConfig(t))
+	if len(report.Issues) != 0 || report.HasErrors() {
+		t.Errorf("got issues for a clean config: %+v", report.Issues)
+	}
+}
+
+func TestAnalyzeSchemaVersion(t *testing.T) {
+	tests := []struct {
+		version  string
+		outdated bool
+	}{
+		{"0.1.0", true},
+		{ConfigVersion, false},
+		{"9.0.0", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			cfg := analyzeConfig(t)
+			cfg.Version = tt.version
+
+			issues := Analyze(cfg).ByCategory(IssueDeprecation)
+			if got := len(issues) == 1 && issues[0].Field == "version"; got != tt.outdated {
+				t.Errorf("got %+v, want outdated = %v", issues, tt.outdated)
+			}
+		})
+	}
+}
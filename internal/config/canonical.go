@@ -0,0 +1,20 @@
+package config
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MarshalCanonical encodes the config as YAML in a stable key order: struct
+// fields keep their declaration order and yaml.v3 sorts map keys, such as
+// development.scripts and unrecognized top-level keys. Marshaling the same
+// config always gives the same bytes, so saved files only change where the
+// config does.
+func (c *ProjectConfig) MarshalCanonical() ([]byte, error) {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode config: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,125 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// canonicalConfig returns a config whose maps are filled in the given key
+// order.
+func canonicalConfig(order []string) *ProjectConfig {
+	cfg := newDefaultConfig()
+	cfg.Metadata.Name = "demo"
+	cfg.Metadata.CreatedAt = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	cfg.Metadata.UpdatedAt = time.Date(2024, 6, 7, 8, 9, 10, 0, time.UTC)
+	cfg.Development.Scripts = make(map[string]string)
+	cfg.Governance.Rules.Rules = make(map[string]RuleConfig)
+	cfg.Extra = make(map[string]interface{})
+	for _, key := range order {
+		cfg.Development.Scripts[key] = "make " + key
+		cfg.Governance.Rules.Rules["rule-"+key] = RuleConfig{Severity: "warning"}
+		cfg.Extra["x_"+key] = map[string]interface{}{"b": 2, "a": 1}
+	}
+	return cfg
+}
+
+func TestMarshalCanonical(t *testing.T) {
+	keys := []string{"test", "dev", "build", "lint", "format", "migrate"}
+	reversed := []string{"migrate", "format", "lint", "build", "dev", "test"}
+
+	tests := []struct {
+		name string
+		a, b func() *ProjectConfig
+	}{
+		{
+			name: "same config twice",
+			a:    func() *ProjectConfig { return canonicalConfig(keys) },
+		},
+		{
+			name: "maps filled in another order",
+			a:    func() *ProjectConfig { return canonicalConfig(keys) },
+			b:    func() *ProjectConfig { return canonicalConfig(reversed) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.a()
+			b := a
+			if tt.b != nil {
+				b = tt.b()
+			}
+
+			first, err := a.MarshalCanonical()
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Repeat to catch map iteration order leaking into the output
+			for i := 0; i < 10; i++ {
+				second, err := b.MarshalCanonical()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(first, second) {
+					t.Fatalf("output differs:\n%s\n---\n%s", first, second)
+				}
+			}
+
+			out := string(first)
+			last := -1
+			for _, key := range []string{"build", "dev", "format", "lint", "migrate", "test"} {
+				i := strings.Index(out, key+": make "+key)
+				if i < 0 {
+					t.Fatalf("script %s missing from output", key)
+				}
+				if i < last {
+					t.Errorf("scripts are not sorted: %s out of order", key)
+				}
+				last = i
+			}
+			for _, want := range []string{"created_at: 2024-01-02T03:04:05Z", "updated_at: 2024-06-07T08:09:10Z"} {
+				if !strings.Contains(out, want) {
+					t.Errorf("output is missing %q", want)
+				}
+			}
+		})
+	}
+}
+
+func TestSaverWritesCanonicalYAML(t *testing.T) {
+	dir := t.TempDir()
+	saver := NewSaver(WithBackup(false))
+
+	var outputs [][]byte
+	for i, order := range [][]string{{"test", "dev", "build"}, {"build", "dev", "test"}} {
+		path := filepath.Join(dir, "config"+string(rune('a'+i))+".yaml")
+		if err := saver.Save(canonicalConfig(order), path); err != nil {
+			t.Fatal(err)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Save stamps updated_at, the one line allowed to differ
+		outputs = append(outputs, withoutLine(data, "updated_at:"))
+	}
+
+	if !bytes.Equal(outputs[0], outputs[1]) {
+		t.Errorf("saved files differ:\n%s\n---\n%s", outputs[0], outputs[1])
+	}
+}
+
+// withoutLine returns data without the lines containing substr.
+func withoutLine(data []byte, substr string) []byte {
+	var kept [][]byte
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		if !bytes.Contains(line, []byte(substr)) {
+			kept = append(kept, line)
+		}
+	}
+	return bytes.Join(kept, []byte("\n"))
+}
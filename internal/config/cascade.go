@@ -0,0 +1,26 @@
+package config
+
+// ApplyEnablementCascade clears the settings of disabled sections so stale
+// values, such as a database left over from before the backend was turned
+// off, do not reach validation or generation. The loader runs it after all
+// sources are merged.
+func (c *ProjectConfig) ApplyEnablementCascade() {
+	if !c.Frontend.Enabled {
+		c.Frontend = FrontendConfig{}
+	}
+
+	if !c.Backend.Enabled {
+		c.Backend = BackendConfig{}
+	}
+
+	if cors := &c.Backend.API.CORS; !cors.Enabled {
+		*cors = CORSConfig{}
+	}
+
+	// Logging is configured independently of the monitoring provider
+	if m := &c.Infrastructure.Monitoring; !m.Enabled {
+		m.Provider = ""
+		m.ErrorTracking = false
+		m.ErrorTrackingProvider = ""
+	}
+}
@@ -0,0 +1,101 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestApplyEnablementCascade(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(cfg *ProjectConfig)
+		check  func(t *testing.T, cfg *ProjectConfig)
+	}{
+		{
+			name:   "disabled backend clears database and auth",
+			mutate: func(cfg *ProjectConfig) { cfg.Backend.Enabled = false },
+			check: func(t *testing.T, cfg *ProjectConfig) {
+				if !reflect.DeepEqual(cfg.Backend, BackendConfig{}) {
+					t.Errorf("backend = %+v, want zero value", cfg.Backend)
+				}
+			},
+		},
+		{
+			name:   "enabled backend keeps database and auth",
+			mutate: func(cfg *ProjectConfig) {},
+			check: func(t *testing.T, cfg *ProjectConfig) {
+				if cfg.Backend.Database.Primary != "postgresql" || cfg.Backend.Auth.Provider != "jwt" {
+					t.Errorf("database %q, auth %q, want postgresql and jwt", cfg.Backend.Database.Primary, cfg.Backend.Auth.Provider)
+				}
+			},
+		},
+		{
+			name:   "disabled frontend",
+			mutate: func(cfg *ProjectConfig) { cfg.Frontend.Enabled = false },
+			check: func(t *testing.T, cfg *ProjectConfig) {
+				if !reflect.DeepEqual(cfg.Frontend, FrontendConfig{}) {
+					t.Errorf("frontend = %+v, want zero value", cfg.Frontend)
+				}
+				if cfg.Backend.Database.Primary != "postgresql" {
+					t.Error("disabling the frontend changed the backend")
+				}
+			},
+		},
+		{
+			name:   "disabled cors",
+			mutate: func(cfg *ProjectConfig) { cfg.Backend.API.CORS.Enabled = false },
+			check: func(t *testing.T, cfg *ProjectConfig) {
+				if !reflect.DeepEqual(cfg.Backend.API.CORS, CORSConfig{}) {
+					t.Errorf("cors = %+v, want zero value", cfg.Backend.API.CORS)
+				}
+			},
+		},
+		{
+			name:   "disabled monitoring keeps logging",
+			mutate: func(cfg *ProjectConfig) { cfg.Infrastructure.Monitoring.Enabled = false },
+			check: func(t *testing.T, cfg *ProjectConfig) {
+				m := cfg.Infrastructure.Monitoring
+				if m.Provider != "" || m.ErrorTracking || m.ErrorTrackingProvider != "" {
+					t.Errorf("monitoring = %+v, want provider and error tracking cleared", m)
+				}
+				if m.Logging.Level != "debug" {
+					t.Errorf("logging level = %q, want debug", m.Logging.Level)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newDefaultConfig()
+			cfg.Frontend.Enabled = true
+			cfg.Frontend.Framework = "react"
+			cfg.Backend.Enabled = true
+			cfg.Backend.Database.Primary = "postgresql"
+			cfg.Backend.Auth.Provider = "jwt"
+			cfg.Backend.API.CORS = CORSConfig{Enabled: true, Origins: []string{"https://example.com"}}
+			cfg.Infrastructure.Monitoring = MonitoringConfig{
+				Enabled: true, Provider: "sentry", ErrorTracking: true, ErrorTrackingProvider: "sentry",
+				Logging: LoggingConfig{Level: "debug"},
+			}
+
+			tt.mutate(cfg)
+			cfg.ApplyEnablementCascade()
+			tt.check(t, cfg)
+		})
+	}
+}
+
+func TestLoadAppliesEnablementCascade(t *testing.T) {
+	dir := writeProject(t, map[string]string{
+		".clause/config.yaml": "metadata:\n  name: demo\nbackend:\n  enabled: false\n  database:\n    primary: postgresql\n",
+	})
+
+	cfg, err := loadProject(t, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Backend.Database.Primary != "" {
+		t.Errorf("database = %q for a disabled backend, want it cleared", cfg.Backend.Database.Primary)
+	}
+}
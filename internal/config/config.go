@@ -4,417 +4,459 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
+
+	"gopkg.in/yaml.v3"
 )
 
 // ProjectConfig represents the complete configuration for a Clause project.
 // It contains all settings for frontend, backend, infrastructure, and governance.
 type ProjectConfig struct {
 	// Metadata contains project identification information
-	Metadata ProjectMetadata `yaml:"metadata" json:"metadata"`
+	Metadata ProjectMetadata `yaml:"metadata" json:"metadata" toml:"metadata"`
 
 	// Frontend contains frontend framework and tooling configuration
-	Frontend FrontendConfig `yaml:"frontend" json:"frontend"`
+	Frontend FrontendConfig `yaml:"frontend" json:"frontend" toml:"frontend"`
 
 	// Backend contains backend framework and service configuration
-	Backend BackendConfig `yaml:"backend" json:"backend"`
+	Backend BackendConfig `yaml:"backend" json:"backend" toml:"backend"`
 
 	// Infrastructure contains deployment and infrastructure configuration
-	Infrastructure InfrastructureConfig `yaml:"infrastructure" json:"infrastructure"`
+	Infrastructure InfrastructureConfig `yaml:"infrastructure" json:"infrastructure" toml:"infrastructure"`
 
 	// Governance contains AI governance and compliance settings
-	Governance GovernanceConfig `yaml:"governance" json:"governance"`
+	Governance GovernanceConfig `yaml:"governance" json:"governance" toml:"governance"`
 
 	// Development contains development workflow settings
-	Development DevelopmentConfig `yaml:"development" json:"development"`
+	Development DevelopmentConfig `yaml:"development" json:"development" toml:"development"`
+
+	// Variables are custom values available to templates as .Vars
+	Variables map[string]string `yaml:"variables,omitempty" json:"variables,omitempty" toml:"variables,omitempty"`
 
 	// Version is the configuration schema version
-	Version string `yaml:"version" json:"version"`
+	Version string `yaml:"version" json:"version" toml:"version"`
+
+	// Extra holds unrecognized top-level keys, such as sections written by
+	// a newer version, so they are preserved when the config is saved
+	Extra map[string]interface{} `yaml:"-" json:"-" toml:"-"`
 }
 
 // ProjectMetadata contains basic project identification information.
 type ProjectMetadata struct {
 	// Name is the project name
-	Name string `yaml:"name" json:"name"`
+	Name string `yaml:"name" json:"name" toml:"name"`
 
 	// Description is a brief project description
-	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty" toml:"description,omitempty"`
 
 	// Version is the current project version
-	Version string `yaml:"version" json:"version"`
+	Version string `yaml:"version" json:"version" toml:"version"`
 
 	// Author is the project author or team
-	Author string `yaml:"author,omitempty" json:"author,omitempty"`
+	Author string `yaml:"author,omitempty" json:"author,omitempty" toml:"author,omitempty"`
 
 	// License is the project license
-	License string `yaml:"license,omitempty" json:"license,omitempty"`
+	License string `yaml:"license,omitempty" json:"license,omitempty" toml:"license,omitempty"`
 
 	// Repository is the git repository URL
-	Repository string `yaml:"repository,omitempty" json:"repository,omitempty"`
+	Repository string `yaml:"repository,omitempty" json:"repository,omitempty" toml:"repository,omitempty"`
 
 	// Keywords are searchable project keywords
-	Keywords []string `yaml:"keywords,omitempty" json:"keywords,omitempty"`
+	Keywords []string `yaml:"keywords,omitempty" json:"keywords,omitempty" toml:"keywords,omitempty"`
 
 	// CreatedAt is when the project was created
-	CreatedAt time.Time `yaml:"created_at" json:"created_at"`
+	CreatedAt time.Time `yaml:"created_at" json:"created_at" toml:"created_at"`
 
 	// UpdatedAt is when the configuration was last modified
-	UpdatedAt time.Time `yaml:"updated_at" json:"updated_at"`
+	UpdatedAt time.Time `yaml:"updated_at" json:"updated_at" toml:"updated_at"`
 
 	// ClauseVersion is the version of Clause used to create the project
-	ClauseVersion string `yaml:"clause_version" json:"clause_version"`
+	ClauseVersion string `yaml:"clause_version" json:"clause_version" toml:"clause_version"`
 }
 
 // FrontendConfig contains frontend framework and tooling configuration.
 type FrontendConfig struct {
 	// Enabled indicates if the project has a frontend
-	Enabled bool `yaml:"enabled" json:"enabled"`
+	Enabled bool `yaml:"enabled" json:"enabled" toml:"enabled"`
 
 	// Framework is the frontend framework (react, vue, svelte, angular, nextjs)
-	Framework string `yaml:"framework" json:"framework"`
+	Framework string `yaml:"framework" json:"framework" toml:"framework"`
 
 	// FrameworkVersion is the framework version
-	FrameworkVersion string `yaml:"framework_version,omitempty" json:"framework_version,omitempty"`
+	FrameworkVersion string `yaml:"framework_version,omitempty" json:"framework_version,omitempty" toml:"framework_version,omitempty"`
+
+	// NodeVersion is the Node.js major version used by Docker, CI and engines
+	NodeVersion string `yaml:"node_version,omitempty" json:"node_version,omitempty" toml:"node_version,omitempty"`
 
 	// TypeScript indicates if TypeScript is used
-	TypeScript bool `yaml:"typescript" json:"typescript"`
+	TypeScript bool `yaml:"typescript" json:"typescript" toml:"typescript"`
 
 	// Styling is the styling approach (tailwind, css-modules, styled-components, scss)
-	Styling string `yaml:"styling" json:"styling"`
+	Styling string `yaml:"styling" json:"styling" toml:"styling"`
 
 	// PackageManager is the package manager (npm, yarn, pnpm, bun)
-	PackageManager string `yaml:"package_manager" json:"package_manager"`
+	PackageManager string `yaml:"package_manager" json:"package_manager" toml:"package_manager"`
 
 	// BuildTool is the build tool (vite, webpack, esbuild, rollup)
-	BuildTool string `yaml:"build_tool" json:"build_tool"`
+	BuildTool string `yaml:"build_tool" json:"build_tool" toml:"build_tool"`
 
 	// TestFramework is the testing framework (jest, vitest, playwright, cypress)
-	TestFramework string `yaml:"test_framework,omitempty" json:"test_framework,omitempty"`
+	TestFramework string `yaml:"test_framework,omitempty" json:"test_framework,omitempty" toml:"test_framework,omitempty"`
 
 	// Linter is the linting tool (eslint, biome)
-	Linter string `yaml:"linter,omitempty" json:"linter,omitempty"`
+	Linter string `yaml:"linter,omitempty" json:"linter,omitempty" toml:"linter,omitempty"`
 
 	// Formatter is the code formatter (prettier, biome)
-	Formatter string `yaml:"formatter,omitempty" json:"formatter,omitempty"`
+	Formatter string `yaml:"formatter,omitempty" json:"formatter,omitempty" toml:"formatter,omitempty"`
 
 	// Features contains optional frontend features
-	Features FrontendFeatures `yaml:"features" json:"features"`
+	Features FrontendFeatures `yaml:"features" json:"features" toml:"features"`
 
 	// Directory is the frontend source directory
-	Directory string `yaml:"directory" json:"directory"`
+	Directory string `yaml:"directory" json:"directory" toml:"directory"`
 }
 
 // FrontendFeatures contains optional frontend feature flags.
 type FrontendFeatures struct {
 	// SSR enables server-side rendering
-	SSR bool `yaml:"ssr" json:"ssr"`
+	SSR bool `yaml:"ssr" json:"ssr" toml:"ssr"`
 
 	// SSG enables static site generation
-	SSG bool `yaml:"ssg" json:"ssg"`
+	SSG bool `yaml:"ssg" json:"ssg" toml:"ssg"`
 
 	// PWA enables progressive web app features
-	PWA bool `yaml:"pwa" json:"pwa"`
+	PWA bool `yaml:"pwa" json:"pwa" toml:"pwa"`
 
 	// I18n enables internationalization
-	I18n bool `yaml:"i18n" json:"i18n"`
+	I18n bool `yaml:"i18n" json:"i18n" toml:"i18n"`
 
 	// DarkMode enables dark mode support
-	DarkMode bool `yaml:"dark_mode" json:"dark_mode"`
+	DarkMode bool `yaml:"dark_mode" json:"dark_mode" toml:"dark_mode"`
 
 	// Storybook enables Storybook for component development
-	Storybook bool `yaml:"storybook" json:"storybook"`
+	Storybook bool `yaml:"storybook" json:"storybook" toml:"storybook"`
 }
 
 // BackendConfig contains backend framework and service configuration.
 type BackendConfig struct {
 	// Enabled indicates if the project has a backend
-	Enabled bool `yaml:"enabled" json:"enabled"`
+	Enabled bool `yaml:"enabled" json:"enabled" toml:"enabled"`
 
 	// Framework is the backend framework (fastapi, express, nestjs, go-gin, rust-axum)
-	Framework string `yaml:"framework" json:"framework"`
+	Framework string `yaml:"framework" json:"framework" toml:"framework"`
 
 	// FrameworkVersion is the framework version
-	FrameworkVersion string `yaml:"framework_version,omitempty" json:"framework_version,omitempty"`
+	FrameworkVersion string `yaml:"framework_version,omitempty" json:"framework_version,omitempty" toml:"framework_version,omitempty"`
 
 	// Language is the backend programming language
-	Language string `yaml:"language" json:"language"`
+	Language string `yaml:"language" json:"language" toml:"language"`
 
 	// LanguageVersion is the language version
-	LanguageVersion string `yaml:"language_version,omitempty" json:"language_version,omitempty"`
+	LanguageVersion string `yaml:"language_version,omitempty" json:"language_version,omitempty" toml:"language_version,omitempty"`
 
 	// Database contains database configuration
-	Database DatabaseConfig `yaml:"database" json:"database"`
+	Database DatabaseConfig `yaml:"database" json:"database" toml:"database"`
 
 	// Auth contains authentication configuration
-	Auth AuthConfig `yaml:"auth" json:"auth"`
+	Auth AuthConfig `yaml:"auth" json:"auth" toml:"auth"`
 
 	// API contains API configuration
-	API APIConfig `yaml:"api" json:"api"`
+	API APIConfig `yaml:"api" json:"api" toml:"api"`
 
 	// Features contains optional backend features
-	Features BackendFeatures `yaml:"features" json:"features"`
+	Features BackendFeatures `yaml:"features" json:"features" toml:"features"`
 
 	// Directory is the backend source directory
-	Directory string `yaml:"directory" json:"directory"`
+	Directory string `yaml:"directory" json:"directory" toml:"directory"`
 }
 
 // DatabaseConfig contains database configuration.
 type DatabaseConfig struct {
 	// Primary is the primary database type (postgresql, mysql, sqlite, mongodb)
-	Primary string `yaml:"primary" json:"primary"`
+	Primary string `yaml:"primary" json:"primary" toml:"primary"`
 
 	// PrimaryVersion is the database version
-	PrimaryVersion string `yaml:"primary_version,omitempty" json:"primary_version,omitempty"`
+	PrimaryVersion string `yaml:"primary_version,omitempty" json:"primary_version,omitempty" toml:"primary_version,omitempty"`
 
 	// ORM is the ORM/tool to use (prisma, sqlalchemy, gorm, mongoose)
-	ORM string `yaml:"orm" json:"orm"`
+	ORM string `yaml:"orm" json:"orm" toml:"orm"`
 
 	// Migrations indicates if database migrations are enabled
-	Migrations bool `yaml:"migrations" json:"migrations"`
+	Migrations bool `yaml:"migrations" json:"migrations" toml:"migrations"`
 
 	// Redis indicates if Redis is used for caching
-	Redis bool `yaml:"redis" json:"redis"`
+	Redis bool `yaml:"redis" json:"redis" toml:"redis"`
 
 	// RedisVersion is the Redis version
-	RedisVersion string `yaml:"redis_version,omitempty" json:"redis_version,omitempty"`
+	RedisVersion string `yaml:"redis_version,omitempty" json:"redis_version,omitempty" toml:"redis_version,omitempty"`
 }
 
 // AuthConfig contains authentication configuration.
 type AuthConfig struct {
 	// Provider is the authentication provider (jwt, oauth, clerk, auth0, firebase)
-	Provider string `yaml:"provider" json:"provider"`
+	Provider string `yaml:"provider" json:"provider" toml:"provider"`
 
 	// Methods contains enabled authentication methods
-	Methods []string `yaml:"methods,omitempty" json:"methods,omitempty"`
+	Methods []string `yaml:"methods,omitempty" json:"methods,omitempty" toml:"methods,omitempty"`
 
 	// SessionDuration is the session duration in hours
-	SessionDuration int `yaml:"session_duration" json:"session_duration"`
+	SessionDuration int `yaml:"session_duration" json:"session_duration" toml:"session_duration"`
 }
 
 // APIConfig contains API configuration.
 type APIConfig struct {
 	// Style is the API style (rest, graphql, grpc, trpc)
-	Style string `yaml:"style" json:"style"`
+	Style string `yaml:"style" json:"style" toml:"style"`
 
 	// Versioning is the API versioning strategy (url, header, none)
-	Versioning string `yaml:"versioning" json:"versioning"`
+	Versioning string `yaml:"versioning" json:"versioning" toml:"versioning"`
 
 	// Documentation indicates if API documentation is generated
-	Documentation bool `yaml:"documentation" json:"documentation"`
+	Documentation bool `yaml:"documentation" json:"documentation" toml:"documentation"`
 
 	// CORS contains CORS configuration
-	CORS CORSConfig `yaml:"cors" json:"cors"`
+	CORS CORSConfig `yaml:"cors" json:"cors" toml:"cors"`
 }
 
 // CORSConfig contains CORS configuration.
 type CORSConfig struct {
 	// Enabled indicates if CORS is enabled
-	Enabled bool `yaml:"enabled" json:"enabled"`
+	Enabled bool `yaml:"enabled" json:"enabled" toml:"enabled"`
 
 	// Origins contains allowed origins
-	Origins []string `yaml:"origins,omitempty" json:"origins,omitempty"`
+	Origins []string `yaml:"origins,omitempty" json:"origins,omitempty" toml:"origins,omitempty"`
 
 	// Methods contains allowed HTTP methods
-	Methods []string `yaml:"methods,omitempty" json:"methods,omitempty"`
+	Methods []string `yaml:"methods,omitempty" json:"methods,omitempty" toml:"methods,omitempty"`
 
 	// Credentials indicates if credentials are allowed
-	Credentials bool `yaml:"credentials" json:"credentials"`
+	Credentials bool `yaml:"credentials" json:"credentials" toml:"credentials"`
 }
 
 // BackendFeatures contains optional backend feature flags.
 type BackendFeatures struct {
 	// WebSocket enables WebSocket support
-	WebSocket bool `yaml:"websocket" json:"websocket"`
+	WebSocket bool `yaml:"websocket" json:"websocket" toml:"websocket"`
 
 	// BackgroundJobs enables background job processing
-	BackgroundJobs bool `yaml:"background_jobs" json:"background_jobs"`
+	BackgroundJobs bool `yaml:"background_jobs" json:"background_jobs" toml:"background_jobs"`
 
 	// FileUpload enables file upload handling
-	FileUpload bool `yaml:"file_upload" json:"file_upload"`
+	FileUpload bool `yaml:"file_upload" json:"file_upload" toml:"file_upload"`
 
 	// Email enables email sending capabilities
-	Email bool `yaml:"email" json:"email"`
+	Email bool `yaml:"email" json:"email" toml:"email"`
+
+	// EmailProvider is the email delivery service (smtp, sendgrid, ses, resend, postmark)
+	EmailProvider string `yaml:"email_provider,omitempty" json:"email_provider,omitempty" toml:"email_provider,omitempty"`
 
 	// RateLimiting enables API rate limiting
-	RateLimiting bool `yaml:"rate_limiting" json:"rate_limiting"`
+	RateLimiting bool `yaml:"rate_limiting" json:"rate_limiting" toml:"rate_limiting"`
 
 	// Logging enables structured logging
-	Logging bool `yaml:"logging" json:"logging"`
+	Logging bool `yaml:"logging" json:"logging" toml:"logging"`
 
 	// Metrics enables metrics collection
-	Metrics bool `yaml:"metrics" json:"metrics"`
+	Metrics bool `yaml:"metrics" json:"metrics" toml:"metrics"`
 }
 
 // InfrastructureConfig contains deployment and infrastructure configuration.
 type InfrastructureConfig struct {
 	// Docker indicates if Docker is used
-	Docker bool `yaml:"docker" json:"docker"`
+	Docker bool `yaml:"docker" json:"docker" toml:"docker"`
 
 	// DockerCompose indicates if Docker Compose is used for local development
-	DockerCompose bool `yaml:"docker_compose" json:"docker_compose"`
+	DockerCompose bool `yaml:"docker_compose" json:"docker_compose" toml:"docker_compose"`
 
 	// Kubernetes indicates if Kubernetes manifests are generated
-	Kubernetes bool `yaml:"kubernetes" json:"kubernetes"`
+	Kubernetes bool `yaml:"kubernetes" json:"kubernetes" toml:"kubernetes"`
 
 	// CI is the CI/CD platform (github-actions, gitlab-ci, circleci, jenkins)
-	CI string `yaml:"ci,omitempty" json:"ci,omitempty"`
+	CI string `yaml:"ci,omitempty" json:"ci,omitempty" toml:"ci,omitempty"`
 
 	// Hosting is the hosting platform (vercel, netlify, aws, gcp, azure, self-hosted)
-	Hosting string `yaml:"hosting,omitempty" json:"hosting,omitempty"`
+	Hosting string `yaml:"hosting,omitempty" json:"hosting,omitempty" toml:"hosting,omitempty"`
 
 	// CDN indicates if a CDN is used
-	CDN bool `yaml:"cdn" json:"cdn"`
+	CDN bool `yaml:"cdn" json:"cdn" toml:"cdn"`
 
 	// Monitoring contains monitoring configuration
-	Monitoring MonitoringConfig `yaml:"monitoring" json:"monitoring"`
+	Monitoring MonitoringConfig `yaml:"monitoring" json:"monitoring" toml:"monitoring"`
 }
 
 // MonitoringConfig contains monitoring and observability configuration.
 type MonitoringConfig struct {
 	// Enabled indicates if monitoring is enabled
-	Enabled bool `yaml:"enabled" json:"enabled"`
+	Enabled bool `yaml:"enabled" json:"enabled" toml:"enabled"`
 
 	// Provider is the monitoring provider (datadog, newrelic, prometheus, grafana)
-	Provider string `yaml:"provider,omitempty" json:"provider,omitempty"`
+	Provider string `yaml:"provider,omitempty" json:"provider,omitempty" toml:"provider,omitempty"`
 
 	// ErrorTracking indicates if error tracking is enabled
-	ErrorTracking bool `yaml:"error_tracking" json:"error_tracking"`
+	ErrorTracking bool `yaml:"error_tracking" json:"error_tracking" toml:"error_tracking"`
 
 	// ErrorTrackingProvider is the error tracking provider (sentry, rollbar)
-	ErrorTrackingProvider string `yaml:"error_tracking_provider,omitempty" json:"error_tracking_provider,omitempty"`
+	ErrorTrackingProvider string `yaml:"error_tracking_provider,omitempty" json:"error_tracking_provider,omitempty" toml:"error_tracking_provider,omitempty"`
 
 	// Logging contains logging configuration
-	Logging LoggingConfig `yaml:"logging" json:"logging"`
+	Logging LoggingConfig `yaml:"logging" json:"logging" toml:"logging"`
 }
 
 // LoggingConfig contains logging configuration.
 type LoggingConfig struct {
 	// Level is the log level (debug, info, warn, error)
-	Level string `yaml:"level" json:"level"`
+	Level string `yaml:"level" json:"level" toml:"level"`
 
 	// Format is the log format (json, text)
-	Format string `yaml:"format" json:"format"`
+	Format string `yaml:"format" json:"format" toml:"format"`
 
 	// Provider is the logging provider (none, datadog, cloudwatch, stackdriver)
-	Provider string `yaml:"provider,omitempty" json:"provider,omitempty"`
+	Provider string `yaml:"provider,omitempty" json:"provider,omitempty" toml:"provider,omitempty"`
 }
 
 // GovernanceConfig contains AI governance and compliance settings.
 type GovernanceConfig struct {
 	// Enabled indicates if governance features are enabled
-	Enabled bool `yaml:"enabled" json:"enabled"`
+	Enabled bool `yaml:"enabled" json:"enabled" toml:"enabled"`
 
 	// ContextLevel is the AI context detail level (minimal, standard, comprehensive)
-	ContextLevel string `yaml:"context_level" json:"context_level"`
+	ContextLevel string `yaml:"context_level" json:"context_level" toml:"context_level"`
 
 	// ComponentRegistry indicates if component registry is maintained
-	ComponentRegistry bool `yaml:"component_registry" json:"component_registry"`
+	ComponentRegistry bool `yaml:"component_registry" json:"component_registry" toml:"component_registry"`
 
 	// BrainstormMd indicates if Brainstorm.md is generated
-	BrainstormMd bool `yaml:"brainstorm_md" json:"brainstorm_md"`
+	BrainstormMd bool `yaml:"brainstorm_md" json:"brainstorm_md" toml:"brainstorm_md"`
 
 	// PromptGuidelines indicates if AI prompt guidelines are generated
-	PromptGuidelines bool `yaml:"prompt_guidelines" json:"prompt_guidelines"`
+	PromptGuidelines bool `yaml:"prompt_guidelines" json:"prompt_guidelines" toml:"prompt_guidelines"`
+
+	// AssistantTargets lists the AI assistants to write instructions for (cursor, copilot, claude)
+	AssistantTargets []string `yaml:"assistant_targets,omitempty" json:"assistant_targets,omitempty" toml:"assistant_targets,omitempty"`
 
 	// Rules contains governance rules configuration
-	Rules GovernanceRules `yaml:"rules" json:"rules"`
+	Rules GovernanceRules `yaml:"rules" json:"rules" toml:"rules"`
 
 	// Documentation contains documentation standards
-	Documentation DocumentationConfig `yaml:"documentation" json:"documentation"`
+	Documentation DocumentationConfig `yaml:"documentation" json:"documentation" toml:"documentation"`
 }
 
 // GovernanceRules contains governance rule configuration.
 type GovernanceRules struct {
 	// Enabled indicates if rules enforcement is enabled
-	Enabled bool `yaml:"enabled" json:"enabled"`
+	Enabled bool `yaml:"enabled" json:"enabled" toml:"enabled"`
 
 	// StrictMode enables strict rule enforcement (fails on warnings)
-	StrictMode bool `yaml:"strict_mode" json:"strict_mode"`
+	StrictMode bool `yaml:"strict_mode" json:"strict_mode" toml:"strict_mode"`
 
-	// CustomRulesPath is the path to custom rules file
-	CustomRulesPath string `yaml:"custom_rules_path,omitempty" json:"custom_rules_path,omitempty"`
+	// CustomRulesPath is the path to custom rules file, relative to the config file
+	CustomRulesPath string `yaml:"custom_rules_path,omitempty" json:"custom_rules_path,omitempty" toml:"custom_rules_path,omitempty"`
 
 	// ExcludePatterns contains glob patterns for files to exclude from governance
-	ExcludePatterns []string `yaml:"exclude_patterns,omitempty" json:"exclude_patterns,omitempty"`
+	ExcludePatterns []string `yaml:"exclude_patterns,omitempty" json:"exclude_patterns,omitempty" toml:"exclude_patterns,omitempty"`
 
 	// Rules contains specific rule configurations
-	Rules map[string]RuleConfig `yaml:"rules,omitempty" json:"rules,omitempty"`
+	Rules map[string]RuleConfig `yaml:"rules,omitempty" json:"rules,omitempty" toml:"rules,omitempty"`
 }
 
 // RuleConfig contains configuration for a specific rule.
 type RuleConfig struct {
 	// Enabled indicates if the rule is enabled
-	Enabled bool `yaml:"enabled" json:"enabled"`
+	Enabled bool `yaml:"enabled" json:"enabled" toml:"enabled"`
 
 	// Severity is the rule severity (error, warning, info)
-	Severity string `yaml:"severity" json:"severity"`
+	Severity string `yaml:"severity" json:"severity" toml:"severity"`
 
 	// Options contains rule-specific options
-	Options map[string]interface{} `yaml:"options,omitempty" json:"options,omitempty"`
+	Options map[string]interface{} `yaml:"options,omitempty" json:"options,omitempty" toml:"options,omitempty"`
+}
+
+// UnmarshalYAML decodes a rule, treating a missing enabled key as enabled.
+func (r *RuleConfig) UnmarshalYAML(value *yaml.Node) error {
+	type plain RuleConfig
+	rule := plain{Enabled: true}
+	if err := value.Decode(&rule); err != nil {
+		return err
+	}
+	*r = RuleConfig(rule)
+	return nil
+}
+
+// UnmarshalJSON decodes a rule, treating a missing enabled key as enabled.
+func (r *RuleConfig) UnmarshalJSON(data []byte) error {
+	type plain RuleConfig
+	rule := plain{Enabled: true}
+	if err := json.Unmarshal(data, &rule); err != nil {
+		return err
+	}
+	*r = RuleConfig(rule)
+	return nil
 }
 
 // DocumentationConfig contains documentation standards configuration.
 type DocumentationConfig struct {
 	// README indicates if README.md is generated
-	README bool `yaml:"readme" json:"readme"`
+	README bool `yaml:"readme" json:"readme" toml:"readme"`
 
 	// Contributing indicates if CONTRIBUTING.md is generated
-	Contributing bool `yaml:"contributing" json:"contributing"`
+	Contributing bool `yaml:"contributing" json:"contributing" toml:"contributing"`
 
 	// Changelog indicates if CHANGELOG.md is generated
-	Changelog bool `yaml:"changelog" json:"changelog"`
+	Changelog bool `yaml:"changelog" json:"changelog" toml:"changelog"`
 
 	// API indicates if API documentation is generated
-	API bool `yaml:"api" json:"api"`
+	API bool `yaml:"api" json:"api" toml:"api"`
 
 	// Inline indicates if inline code documentation is enforced
-	Inline bool `yaml:"inline" json:"inline"`
+	Inline bool `yaml:"inline" json:"inline" toml:"inline"`
 
 	// Format is the documentation format (markdown, restructuredtext)
-	Format string `yaml:"format" json:"format"`
+	Format string `yaml:"format" json:"format" toml:"format"`
 }
 
 // DevelopmentConfig contains development workflow settings.
 type DevelopmentConfig struct {
 	// Git indicates if git is initialized
-	Git bool `yaml:"git" json:"git"`
+	Git bool `yaml:"git" json:"git" toml:"git"`
 
 	// Hooks contains git hooks configuration
-	Hooks GitHooksConfig `yaml:"hooks" json:"hooks"`
+	Hooks GitHooksConfig `yaml:"hooks" json:"hooks" toml:"hooks"`
 
 	// Editor contains editor configuration
-	Editor EditorConfig `yaml:"editor" json:"editor"`
+	Editor EditorConfig `yaml:"editor" json:"editor" toml:"editor"`
 
 	// Scripts contains custom npm/make scripts
-	Scripts map[string]string `yaml:"scripts,omitempty" json:"scripts,omitempty"`
+	Scripts map[string]string `yaml:"scripts,omitempty" json:"scripts,omitempty" toml:"scripts,omitempty"`
 }
 
 // GitHooksConfig contains git hooks configuration.
 type GitHooksConfig struct {
 	// PreCommit enables pre-commit hooks
-	PreCommit bool `yaml:"pre_commit" json:"pre_commit"`
+	PreCommit bool `yaml:"pre_commit" json:"pre_commit" toml:"pre_commit"`
 
 	// CommitMsg enables commit message validation
-	CommitMsg bool `yaml:"commit_msg" json:"commit_msg"`
+	CommitMsg bool `yaml:"commit_msg" json:"commit_msg" toml:"commit_msg"`
 
 	// PrePush enables pre-push hooks
-	PrePush bool `yaml:"pre_push" json:"pre_push"`
+	PrePush bool `yaml:"pre_push" json:"pre_push" toml:"pre_push"`
 
 	// LintStaged enables linting of staged files
-	LintStaged bool `yaml:"lint_staged" json:"lint_staged"`
+	LintStaged bool `yaml:"lint_staged" json:"lint_staged" toml:"lint_staged"`
 }
 
 // EditorConfig contains editor configuration.
 type EditorConfig struct {
 	// Config indicates if .editorconfig is generated
-	Config bool `yaml:"config" json:"config"`
+	Config bool `yaml:"config" json:"config" toml:"config"`
 
 	// VSCode indicates if VS Code settings are generated
-	VSCode bool `yaml:"vscode" json:"vscode"`
+	VSCode bool `yaml:"vscode" json:"vscode" toml:"vscode"`
 
 	// Extensions contains recommended VS Code extensions
-	Extensions []string `yaml:"extensions,omitempty" json:"extensions,omitempty"`
+	Extensions []string `yaml:"extensions,omitempty" json:"extensions,omitempty" toml:"extensions,omitempty"`
 }
 
 // ConfigVersion is the current configuration schema version.
@@ -553,6 +595,11 @@ func (c *ProjectConfig) Clone() *ProjectConfig {
 		copy(cloned.Backend.API.CORS.Methods, c.Backend.API.CORS.Methods)
 	}
 
+	if c.Governance.AssistantTargets != nil {
+		cloned.Governance.AssistantTargets = make([]string, len(c.Governance.AssistantTargets))
+		copy(cloned.Governance.AssistantTargets, c.Governance.AssistantTargets)
+	}
+
 	if c.Governance.Rules.ExcludePatterns != nil {
 		cloned.Governance.Rules.ExcludePatterns = make([]string, len(c.Governance.Rules.ExcludePatterns))
 		copy(cloned.Governance.Rules.ExcludePatterns, c.Governance.Rules.ExcludePatterns)
@@ -561,6 +608,13 @@ func (c *ProjectConfig) Clone() *ProjectConfig {
 	if c.Governance.Rules.Rules != nil {
 		cloned.Governance.Rules.Rules = make(map[string]RuleConfig)
 		for k, v := range c.Governance.Rules.Rules {
+			if v.Options != nil {
+				options := make(map[string]interface{}, len(v.Options))
+				for name, value := range v.Options {
+					options[name] = cloneExtraValue(value)
+				}
+				v.Options = options
+			}
 			cloned.Governance.Rules.Rules[k] = v
 		}
 	}
@@ -577,6 +631,17 @@ func (c *ProjectConfig) Clone() *ProjectConfig {
 		copy(cloned.Development.Editor.Extensions, c.Development.Editor.Extensions)
 	}
 
+	if c.Variables != nil {
+		cloned.Variables = make(map[string]string)
+		for k, v := range c.Variables {
+			cloned.Variables[k] = v
+		}
+	}
+
+	if c.Extra != nil {
+		cloned.Extra = cloneExtraValue(c.Extra).(map[string]interface{})
+	}
+
 	return &cloned
 }
 
@@ -588,3 +653,107 @@ func (c *ProjectConfig) String() string {
 		c.Backend.Framework,
 	)
 }
+
+// stackDisplayNames maps configuration identifiers to their conventional names.
+var stackDisplayNames = map[string]string{
+	// Frontend frameworks
+	"react":     "React",
+	"vue":       "Vue",
+	"svelte":    "Svelte",
+	"angular":   "Angular",
+	"nextjs":    "Next.js",
+	"nuxt":      "Nuxt",
+	"sveltekit": "SvelteKit",
+	"remix":     "Remix",
+	"astro":     "Astro",
+	"solid":     "Solid",
+
+	// Backend frameworks
+	"fastapi":     "FastAPI",
+	"express":     "Express",
+	"nestjs":      "NestJS",
+	"django":      "Django",
+	"go-gin":      "Gin",
+	"go-fiber":    "Fiber",
+	"go-echo":     "Echo",
+	"rust-axum":   "Axum",
+	"rust-actix":  "Actix",
+	"rust-rocket": "Rocket",
+
+	// Databases
+	"postgresql":  "PostgreSQL",
+	"mysql":       "MySQL",
+	"sqlite":      "SQLite",
+	"mongodb":     "MongoDB",
+	"mariadb":     "MariaDB",
+	"cockroachdb": "CockroachDB",
+	"planetscale": "PlanetScale",
+
+	// CI providers
+	"github-actions":      "GitHub Actions",
+	"gitlab-ci":           "GitLab CI",
+	"circleci":            "CircleCI",
+	"jenkins":             "Jenkins",
+	"azure-pipelines":     "Azure Pipelines",
+	"travis":              "Travis CI",
+	"bitbucket-pipelines": "Bitbucket Pipelines",
+	"buildkite":           "Buildkite",
+}
+
+// stackDisplayName returns the conventional name for a configuration identifier.
+func stackDisplayName(id string) string {
+	if name, ok := stackDisplayNames[strings.ToLower(id)]; ok {
+		return name
+	}
+	return id
+}
+
+// ResolvedNodeVersion returns the configured Node.js version, or the
+// default LTS version if none is set.
+func (c *ProjectConfig) ResolvedNodeVersion() string {
+	if c.Frontend.NodeVersion != "" {
+		return c.Frontend.NodeVersion
+	}
+	return DefaultValues.Frontend.NodeVersion
+}
+
+// StackString returns a concise descriptor of the effective stack, such as
+// "Next.js + FastAPI + PostgreSQL (Docker, GitHub Actions)". Disabled
+// sections and empty values are omitted.
+func (c *ProjectConfig) StackString() string {
+	var parts []string
+
+	if c.Frontend.Enabled && c.Frontend.Framework != "" {
+		parts = append(parts, stackDisplayName(c.Frontend.Framework))
+	}
+
+	if c.Backend.Enabled {
+		if c.Backend.Framework != "" {
+			parts = append(parts, stackDisplayName(c.Backend.Framework))
+		}
+		if c.Backend.Database.Primary != "" && c.Backend.Database.Primary != "none" {
+			parts = append(parts, stackDisplayName(c.Backend.Database.Primary))
+		}
+	}
+
+	var infra []string
+	if c.Infrastructure.Docker {
+		infra = append(infra, "Docker")
+	}
+	if c.Infrastructure.Kubernetes {
+		infra = append(infra, "Kubernetes")
+	}
+	if c.Infrastructure.CI != "" && c.Infrastructure.CI != "none" {
+		infra = append(infra, stackDisplayName(c.Infrastructure.CI))
+	}
+
+	stack := strings.Join(parts, " + ")
+	if len(infra) > 0 {
+		if stack == "" {
+			return strings.Join(infra, ", ")
+		}
+		stack += " (" + strings.Join(infra, ", ") + ")"
+	}
+
+	return stack
+}
@@ -0,0 +1,67 @@
+package config
+
+import "testing"
+
+func TestStackString(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(c *ProjectConfig)
+		want  string
+	}{
+		{
+			name: "full stack",
+			setup: func(c *ProjectConfig) {
+				c.Frontend.Enabled, c.Frontend.Framework = true, "nextjs"
+				c.Backend.Enabled, c.Backend.Framework = true, "fastapi"
+				c.Backend.Database.Primary = "postgresql"
+				c.Infrastructure.Docker = true
+				c.Infrastructure.CI = "github-actions"
+			},
+			want: "Next.js + FastAPI + PostgreSQL (Docker, GitHub Actions)",
+		},
+		{
+			name: "frontend only",
+			setup: func(c *ProjectConfig) {
+				c.Frontend.Enabled, c.Frontend.Framework = true, "react"
+				c.Backend.Enabled, c.Backend.Framework = false, "fastapi"
+				c.Backend.Database.Primary = "postgresql"
+			},
+			want: "React",
+		},
+		{
+			name: "no database",
+			setup: func(c *ProjectConfig) {
+				c.Backend.Enabled, c.Backend.Framework = true, "go-gin"
+				c.Backend.Database.Primary = "none"
+				c.Infrastructure.Kubernetes = true
+			},
+			want: "Gin (Kubernetes)",
+		},
+		{
+			name: "infrastructure only",
+			setup: func(c *ProjectConfig) {
+				c.Infrastructure.Docker = true
+				c.Infrastructure.CI = "gitlab-ci"
+			},
+			want: "Docker, GitLab CI",
+		},
+		{
+			name: "unknown identifiers kept",
+			setup: func(c *ProjectConfig) {
+				c.Backend.Enabled, c.Backend.Framework = true, "hanami"
+			},
+			want: "hanami",
+		},
+		{name: "empty", setup: func(c *ProjectConfig) {}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &ProjectConfig{}
+			tt.setup(c)
+			if got := c.StackString(); got != tt.want {
+				t.Errorf("StackString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,7 +2,12 @@ package config
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/clause-cli/clause/internal/config/enum"
 )
 
 // DefaultValues contains all default configuration values.
@@ -10,12 +15,13 @@ import (
 var DefaultValues = &defaults{
 	// Frontend defaults
 	Frontend: frontendDefaults{
-		Framework:        "react",
+		Framework:        string(enum.FrontendReact),
 		FrameworkVersion: "18",
+		NodeVersion:      "20",
 		TypeScript:       true,
-		Styling:          "tailwind",
-		PackageManager:   "npm",
-		BuildTool:        "vite",
+		Styling:          string(enum.StylingTailwind),
+		PackageManager:   string(enum.PackageManagerNPM),
+		BuildTool:        string(enum.BuildToolVite),
 		TestFramework:    "vitest",
 		Linter:           "eslint",
 		Formatter:        "prettier",
@@ -24,24 +30,24 @@ var DefaultValues = &defaults{
 
 	// Backend defaults
 	Backend: backendDefaults{
-		Framework:       "fastapi",
+		Framework:       string(enum.BackendFastAPI),
 		Language:        "python",
 		LanguageVersion: "3.11",
 		Directory:       "backend",
 		Database: databaseDefaults{
-			Primary:    "postgresql",
+			Primary:    string(enum.DatabasePostgreSQL),
 			ORM:        "sqlalchemy",
 			Migrations: true,
 			Redis:      false,
 		},
 		Auth: authDefaults{
-			Provider:        "jwt",
+			Provider:        string(enum.AuthJWT),
 			Methods:         []string{"email", "password"},
 			SessionDuration: 24,
 		},
 		API: apiDefaults{
-			Style:         "rest",
-			Versioning:    "url",
+			Style:         string(enum.APIStyleREST),
+			Versioning:    string(enum.VersioningURL),
 			Documentation: true,
 		},
 	},
@@ -51,8 +57,8 @@ var DefaultValues = &defaults{
 		Docker:        true,
 		DockerCompose: true,
 		Kubernetes:    false,
-		CI:            "github-actions",
-		Hosting:       "vercel",
+		CI:            string(enum.CIGitHubActions),
+		Hosting:       string(enum.HostingVercel),
 		CDN:           true,
 		Monitoring: monitoringDefaults{
 			Enabled:       true,
@@ -67,7 +73,7 @@ var DefaultValues = &defaults{
 	// Governance defaults
 	Governance: governanceDefaults{
 		Enabled:           true,
-		ContextLevel:      "comprehensive",
+		ContextLevel:      string(enum.ContextComprehensive),
 		ComponentRegistry: true,
 		BrainstormMd:      true,
 		PromptGuidelines:  true,
@@ -109,6 +115,7 @@ type defaults struct {
 type frontendDefaults struct {
 	Framework        string
 	FrameworkVersion string
+	NodeVersion      string
 	TypeScript       bool
 	Styling          string
 	PackageManager   string
@@ -297,11 +304,18 @@ type Preset struct {
 	// Description describes what the preset is for
 	Description string
 
-	// Apply applies the preset to a configuration
+	// Extends names a preset applied before this one (optional)
+	Extends string
+
+	// Apply applies the preset's own changes to a configuration
 	Apply func(*ProjectConfig)
 }
 
-// AvailablePresets contains all available configuration presets.
+// presetsMu guards AvailablePresets against concurrent RegisterPreset calls.
+var presetsMu sync.RWMutex
+
+// AvailablePresets contains all available configuration presets. Use
+// Presets to read it and RegisterPreset to change it.
 var AvailablePresets = []Preset{
 	{
 		Name:        "minimal",
@@ -337,6 +351,9 @@ var AvailablePresets = []Preset{
 
 // GetPreset returns a preset by name.
 func GetPreset(name string) (*Preset, error) {
+	presetsMu.RLock()
+	defer presetsMu.RUnlock()
+
 	for _, preset := range AvailablePresets {
 		if preset.Name == name {
 			return &preset, nil
@@ -345,8 +362,69 @@ func GetPreset(name string) (*Preset, error) {
 	return nil, fmt.Errorf("preset not found: %s", name)
 }
 
+// RegisterPreset adds a preset to AvailablePresets, replacing any preset with
+// the same name. Set Extends to build on an existing preset.
+func RegisterPreset(preset Preset) {
+	presetCache.Lock()
+	defer presetCache.Unlock()
+
+	// Presets extending this one may be cached with the old definition
+	presetCache.configs = make(map[string]*ProjectConfig)
+	presetCache.generation++
+
+	presetsMu.Lock()
+	defer presetsMu.Unlock()
+
+	for i, existing := range AvailablePresets {
+		if existing.Name == preset.Name {
+			AvailablePresets[i] = preset
+			return
+		}
+	}
+	AvailablePresets = append(AvailablePresets, preset)
+}
+
+// Lineage returns the names of the presets applied for p, from the root of
+// its Extends chain to p itself.
+func (p Preset) Lineage() ([]string, error) {
+	chain := []string{p.Name}
+	seen := map[string]bool{p.Name: true}
+
+	for current := p; current.Extends != ""; {
+		if seen[current.Extends] {
+			return nil, fmt.Errorf("preset cycle detected: %s", strings.Join(append(chain, current.Extends), " -> "))
+		}
+
+		parent, err := GetPreset(current.Extends)
+		if err != nil {
+			return nil, fmt.Errorf("preset %s extends unknown preset %s", current.Name, current.Extends)
+		}
+
+		chain = append(chain, parent.Name)
+		seen[parent.Name] = true
+		current = *parent
+	}
+
+	// Parents are applied first
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+	return chain, nil
+}
+
+// Presets returns a copy of the available presets.
+func Presets() []Preset {
+	presetsMu.RLock()
+	defer presetsMu.RUnlock()
+
+	return append([]Preset(nil), AvailablePresets...)
+}
+
 // PresetNames returns all available preset names.
 func PresetNames() []string {
+	presetsMu.RLock()
+	defer presetsMu.RUnlock()
+
 	names := make([]string, len(AvailablePresets))
 	for i, preset := range AvailablePresets {
 		names[i] = preset.Name
@@ -437,6 +515,7 @@ func applySaaSPreset(c *ProjectConfig) {
 		BackgroundJobs: true,
 		FileUpload:     true,
 		Email:          true,
+		EmailProvider:  "resend",
 		Logging:        true,
 		Metrics:        true,
 		RateLimiting:   true,
@@ -583,6 +662,8 @@ func getFrontendDefault(parts []string) (interface{}, error) {
 	switch parts[0] {
 	case "framework":
 		return DefaultValues.Frontend.Framework, nil
+	case "node_version":
+		return DefaultValues.Frontend.NodeVersion, nil
 	case "typescript":
 		return DefaultValues.Frontend.TypeScript, nil
 	case "styling":
@@ -712,19 +793,96 @@ func getDevelopmentDefault(parts []string) (interface{}, error) {
 	}
 }
 
+// presetCache memoizes preset configurations by name. Cached entries are
+// never handed out directly; callers always receive a clone. generation
+// changes whenever the cache is cleared, so a config built from presets
+// that have since been replaced is not stored.
+var presetCache = struct {
+	sync.Mutex
+	configs    map[string]*ProjectConfig
+	generation int
+}{configs: make(map[string]*ProjectConfig)}
+
 // LoadPreset creates a new ProjectConfig with the specified preset applied.
+// Preset results are cached, so repeated calls are cheap and each returns an
+// independent copy. Preset Apply functions run without the cache locked, so
+// they may load other presets.
 func LoadPreset(name string) (*ProjectConfig, error) {
+	presetCache.Lock()
+	cached, ok := presetCache.configs[name]
+	generation := presetCache.generation
+	var config *ProjectConfig
+	if ok {
+		config = cached.Clone()
+	}
+	presetCache.Unlock()
+
+	if !ok {
+		built, err := buildPreset(name)
+		if err != nil {
+			return nil, err
+		}
+		config = built.Clone()
+
+		presetCache.Lock()
+		if presetCache.generation == generation {
+			presetCache.configs[name] = built
+		}
+		presetCache.Unlock()
+	}
+
+	now := time.Now()
+	config.Metadata.CreatedAt = now
+	config.Metadata.UpdatedAt = now
+
+	return config, nil
+}
+
+// buildPreset applies the named preset and its ancestors to a new config.
+func buildPreset(name string) (*ProjectConfig, error) {
 	preset, err := GetPreset(name)
 	if err != nil {
 		return nil, err
 	}
 
-	config := NewProjectConfig()
-	preset.Apply(config)
+	lineage, err := preset.Lineage()
+	if err != nil {
+		return nil, err
+	}
 
+	config := NewProjectConfig()
+	for _, ancestor := range lineage {
+		p, _ := GetPreset(ancestor)
+		if p.Apply != nil {
+			p.Apply(config)
+		}
+	}
 	return config, nil
 }
 
+// PreviewConfig returns the configuration produced by a preset with the given
+// overrides applied, using the same dot-notation keys as SetConfigValue. An
+// empty or unknown preset starts from the defaults. Overrides that cannot be
+// applied are skipped, so it is safe to call on every keystroke.
+func PreviewConfig(preset string, overrides map[string]interface{}) *ProjectConfig {
+	config, err := LoadPreset(preset)
+	if err != nil {
+		config = DefaultConfig()
+	}
+
+	keys := make([]string, 0, len(overrides))
+	for key := range overrides {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		_ = setNestedValue(config, key, overrides[key])
+	}
+
+	return config
+}
+
 // DefaultConfig creates a new ProjectConfig with all default values applied.
 func DefaultConfig() *ProjectConfig {
 	cfg := NewProjectConfig()
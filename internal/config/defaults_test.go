@@ -0,0 +1,139 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLoadPresetReturnsClones(t *testing.T) {
+	for _, name := range []string{"minimal", "saas", "api-only", "enterprise"} {
+		t.Run(name, func(t *testing.T) {
+			first, err := LoadPreset(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			second, err := LoadPreset(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if first == second {
+				t.Fatal("LoadPreset returned the same pointer twice")
+			}
+			if !reflect.DeepEqual(withoutTimestamps(first), withoutTimestamps(second)) {
+				t.Fatal("repeated LoadPreset calls returned different configs")
+			}
+
+			// Mutating one result must not leak into the cache
+			want := append([]string(nil), second.Backend.Auth.Methods...)
+			first.Backend.Auth.Methods[0] = "mutated"
+			first.Backend.Auth.Methods = append(first.Backend.Auth.Methods, "extra")
+			first.Governance.Rules.Rules = map[string]RuleConfig{"x": {Enabled: true}}
+			first.Metadata.Name = "mutated"
+
+			third, err := LoadPreset(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(third.Backend.Auth.Methods, want) {
+				t.Errorf("auth methods = %v after mutating an earlier result, want %v", third.Backend.Auth.Methods, want)
+			}
+			if len(third.Governance.Rules.Rules) != 0 || third.Metadata.Name == "mutated" {
+				t.Error("mutating an earlier result changed the cached preset")
+			}
+			if !reflect.DeepEqual(withoutTimestamps(second), withoutTimestamps(third)) {
+				t.Error("cached preset changed between calls")
+			}
+		})
+	}
+}
+
+func TestLoadPresetApplyLoadsPreset(t *testing.T) {
+	presetsMu.RLock()
+	saved := append([]Preset(nil), AvailablePresets...)
+	presetsMu.RUnlock()
+	t.Cleanup(func() {
+		presetsMu.Lock()
+		AvailablePresets = saved
+		presetsMu.Unlock()
+
+		presetCache.Lock()
+		presetCache.configs = make(map[string]*ProjectConfig)
+		presetCache.generation++
+		presetCache.Unlock()
+	})
+
+	// An Apply that loads another preset must not deadlock on the cache
+	RegisterPreset(Preset{
+		Name: "test-nested",
+		Apply: func(cfg *ProjectConfig) {
+			base, err := LoadPreset("minimal")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			cfg.Frontend.Framework = base.Frontend.Framework
+			cfg.Metadata.Description = "nested"
+		},
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		cfg, err := LoadPreset("test-nested")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if cfg.Metadata.Description != "nested" {
+			t.Errorf("description = %q, want %q", cfg.Metadata.Description, "nested")
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("LoadPreset deadlocked when Apply loaded another preset")
+	}
+}
+
+func TestPreviewConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		preset    string
+		overrides map[string]interface{}
+		check     func(*ProjectConfig) bool
+	}{
+		{"preset", "saas", nil, func(c *ProjectConfig) bool { return c.Backend.Enabled }},
+		{"override", "saas", map[string]interface{}{"frontend.framework": "vue"},
+			func(c *ProjectConfig) bool { return c.Frontend.Framework == "vue" }},
+		{"unknown preset uses defaults", "nope", map[string]interface{}{"metadata.name": "demo"},
+			func(c *ProjectConfig) bool { return c.Metadata.Name == "demo" && c.Frontend.Framework == "react" }},
+		{"bad override skipped", "saas", map[string]interface{}{"no.such.field": "x", "frontend.framework": "svelte"},
+			func(c *ProjectConfig) bool { return c.Frontend.Framework == "svelte" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := PreviewConfig(tt.preset, tt.overrides)
+			if !tt.check(cfg) {
+				t.Errorf("unexpected preview config: %+v", cfg)
+			}
+
+			// Overrides must not leak into later loads of the preset
+			again := PreviewConfig(tt.preset, nil)
+			if tt.overrides["frontend.framework"] != nil && again.Frontend.Framework == tt.overrides["frontend.framework"] {
+				t.Error("preview overrides leaked into the preset cache")
+			}
+		})
+	}
+}
+
+// withoutTimestamps returns a copy of cfg with the creation times cleared,
+// which differ between otherwise identical loads.
+func withoutTimestamps(cfg *ProjectConfig) *ProjectConfig {
+	c := cfg.Clone()
+	c.Metadata.CreatedAt, c.Metadata.UpdatedAt = time.Time{}, time.Time{}
+	return c
+}
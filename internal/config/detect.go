@@ -0,0 +1,349 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/clause-cli/clause/pkg/utils"
+)
+
+// frontendCandidateDirs are the directories searched for a frontend package.json.
+var frontendCandidateDirs = []string{".", "frontend", "web", "client", "app", "apps/web"}
+
+// backendCandidateDirs are the directories searched for backend manifests.
+var backendCandidateDirs = []string{".", "backend", "server", "api", "apps/api"}
+
+// frontendDependencyFrameworks maps package.json dependencies to frameworks.
+// Meta-frameworks come first so they win over the library they build on.
+var frontendDependencyFrameworks = []struct {
+	dependency string
+	framework  string
+}{
+	{"next", "nextjs"},
+	{"nuxt", "nuxt"},
+	{"@sveltejs/kit", "sveltekit"},
+	{"@remix-run/react", "remix"},
+	{"astro", "astro"},
+	{"@angular/core", "angular"},
+	{"solid-js", "solid"},
+	{"svelte", "svelte"},
+	{"vue", "vue"},
+	{"react", "react"},
+}
+
+// nodeBackendFrameworks maps package.json dependencies to backend frameworks.
+var nodeBackendFrameworks = []struct {
+	dependency string
+	framework  string
+}{
+	{"@nestjs/core", "nestjs"},
+	{"express", "express"},
+}
+
+// pythonBackendFrameworks maps Python requirements to backend frameworks.
+var pythonBackendFrameworks = []struct {
+	dependency string
+	framework  string
+}{
+	{"fastapi", "fastapi"},
+	{"django", "django"},
+}
+
+// goBackendFrameworks maps Go module paths to backend frameworks.
+var goBackendFrameworks = []struct {
+	dependency string
+	framework  string
+}{
+	{"github.com/gin-gonic/gin", "go-gin"},
+	{"github.com/gofiber/fiber", "go-fiber"},
+	{"github.com/labstack/echo", "go-echo"},
+}
+
+// databaseHints maps dependency names found in manifests to databases.
+var databaseHints = []struct {
+	dependency string
+	database   string
+}{
+	{"psycopg", "postgresql"},
+	{"asyncpg", "postgresql"},
+	{"\"pg\"", "postgresql"},
+	{"github.com/lib/pq", "postgresql"},
+	{"github.com/jackc/pgx", "postgresql"},
+	{"mysql", "mysql"},
+	{"mongoose", "mongodb"},
+	{"mongodb", "mongodb"},
+	{"pymongo", "mongodb"},
+	{"sqlite", "sqlite"},
+}
+
+// DetectConfig inspects an existing project and returns a configuration
+// describing its stack. Sections that are not found are disabled, and
+// settings that cannot be detected keep their defaults.
+func DetectConfig(projectDir string) (*ProjectConfig, error) {
+	info, err := os.Stat(projectDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read project directory: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("not a directory: %s", projectDir)
+	}
+
+	cfg := NewProjectConfig()
+
+	absDir, err := filepath.Abs(projectDir)
+	if err != nil {
+		absDir = projectDir
+	}
+	cfg.Metadata.Name = utils.KebabCase(filepath.Base(absDir))
+
+	detectFrontend(cfg, projectDir)
+	detectBackend(cfg, projectDir)
+	detectInfrastructure(cfg, projectDir)
+
+	return cfg, nil
+}
+
+// packageJSON holds the parts of package.json used for detection.
+type packageJSON struct {
+	Name            string            `json:"name"`
+	Description     string            `json:"description"`
+	Dependencies    map[string]string `json:"dependencies"`
+	DevDependencies map[string]string `json:"devDependencies"`
+}
+
+// hasDependency reports whether the package depends on name.
+func (p *packageJSON) hasDependency(name string) bool {
+	if _, ok := p.Dependencies[name]; ok {
+		return true
+	}
+	_, ok := p.DevDependencies[name]
+	return ok
+}
+
+// readPackageJSON parses dir/package.json, returning nil if it is missing or invalid.
+func readPackageJSON(dir string) *packageJSON {
+	data, err := os.ReadFile(filepath.Join(dir, "package.json"))
+	if err != nil {
+		return nil
+	}
+
+	var pkg packageJSON
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return nil
+	}
+	return &pkg
+}
+
+// detectFrontend fills the frontend section from the first package.json
+// that depends on a known frontend framework.
+func detectFrontend(cfg *ProjectConfig, projectDir string) {
+	cfg.Frontend.Enabled = false
+
+	for _, candidate := range frontendCandidateDirs {
+		dir := filepath.Join(projectDir, candidate)
+		pkg := readPackageJSON(dir)
+		if pkg == nil {
+			continue
+		}
+
+		framework := ""
+		for _, f := range frontendDependencyFrameworks {
+			if pkg.hasDependency(f.dependency) {
+				framework = f.framework
+				break
+			}
+		}
+		if framework == "" {
+			continue
+		}
+
+		cfg.Frontend.Enabled = true
+		cfg.Frontend.Framework = framework
+		cfg.Frontend.Directory = filepath.ToSlash(candidate)
+		cfg.Frontend.TypeScript = pkg.hasDependency("typescript") || utils.FileExists(filepath.Join(dir, "tsconfig.json"))
+		cfg.Frontend.PackageManager = detectPackageManager(projectDir, dir)
+
+		if pkg.Description != "" && cfg.Metadata.Description == "" {
+			cfg.Metadata.Description = pkg.Description
+		}
+
+		// Tooling is only reported when it is actually used
+		cfg.Frontend.Styling = ""
+		cfg.Frontend.BuildTool = ""
+		cfg.Frontend.TestFramework = ""
+		cfg.Frontend.Linter = ""
+		cfg.Frontend.Formatter = ""
+
+		switch {
+		case pkg.hasDependency("tailwindcss"):
+			cfg.Frontend.Styling = "tailwind"
+		case pkg.hasDependency("styled-components"):
+			cfg.Frontend.Styling = "styled-components"
+		case pkg.hasDependency("sass"):
+			cfg.Frontend.Styling = "scss"
+		}
+
+		switch {
+		case pkg.hasDependency("vite"):
+			cfg.Frontend.BuildTool = "vite"
+		case pkg.hasDependency("webpack"):
+			cfg.Frontend.BuildTool = "webpack"
+		}
+
+		switch {
+		case pkg.hasDependency("vitest"):
+			cfg.Frontend.TestFramework = "vitest"
+		case pkg.hasDependency("jest"):
+			cfg.Frontend.TestFramework = "jest"
+		}
+
+		if pkg.hasDependency("eslint") {
+			cfg.Frontend.Linter = "eslint"
+		}
+		if pkg.hasDependency("prettier") {
+			cfg.Frontend.Formatter = "prettier"
+		}
+		return
+	}
+}
+
+// detectPackageManager infers the package manager from lockfiles in the
+// package directory or the project root.
+func detectPackageManager(projectDir, dir string) string {
+	lockfiles := []struct {
+		file    string
+		manager string
+	}{
+		{"pnpm-lock.yaml", "pnpm"},
+		{"yarn.lock", "yarn"},
+		{"bun.lockb", "bun"},
+		{"package-lock.json", "npm"},
+	}
+
+	for _, d := range []string{dir, projectDir} {
+		for _, l := range lockfiles {
+			if utils.FileExists(filepath.Join(d, l.file)) {
+				return l.manager
+			}
+		}
+	}
+	return "npm"
+}
+
+// detectBackend fills the backend section from the first recognized manifest.
+func detectBackend(cfg *ProjectConfig, projectDir string) {
+	cfg.Backend.Enabled = false
+
+	for _, candidate := range backendCandidateDirs {
+		dir := filepath.Join(projectDir, candidate)
+
+		language, framework, manifest := detectBackendIn(dir)
+		if language == "" {
+			continue
+		}
+
+		// A frontend-only package.json at the same location is not a backend
+		if language == "node" && framework == "" {
+			continue
+		}
+
+		cfg.Backend.Enabled = true
+		cfg.Backend.Language = language
+		cfg.Backend.Framework = framework
+		cfg.Backend.Directory = filepath.ToSlash(candidate)
+		cfg.Backend.Database.Primary = ""
+		cfg.Backend.Database.ORM = ""
+		cfg.Backend.Database.Migrations = false
+
+		for _, hint := range databaseHints {
+			if strings.Contains(manifest, hint.dependency) {
+				cfg.Backend.Database.Primary = hint.database
+				break
+			}
+		}
+		return
+	}
+}
+
+// detectBackendIn returns the language, framework and manifest content of a
+// backend in dir, or empty strings if none is found.
+func detectBackendIn(dir string) (language, framework, manifest string) {
+	for _, name := range []string{"requirements.txt", "pyproject.toml"} {
+		if data, err := os.ReadFile(filepath.Join(dir, name)); err == nil {
+			content := strings.ToLower(string(data))
+			for _, f := range pythonBackendFrameworks {
+				if strings.Contains(content, f.dependency) {
+					return "python", f.framework, content
+				}
+			}
+			return "python", "", content
+		}
+	}
+
+	if data, err := os.ReadFile(filepath.Join(dir, "go.mod")); err == nil {
+		content := string(data)
+		for _, f := range goBackendFrameworks {
+			if strings.Contains(content, f.dependency) {
+				return "go", f.framework, content
+			}
+		}
+		return "go", "", content
+	}
+
+	if pkg := readPackageJSON(dir); pkg != nil {
+		data, _ := os.ReadFile(filepath.Join(dir, "package.json"))
+		language := "node"
+		if pkg.hasDependency("typescript") {
+			language = "typescript"
+		}
+		for _, f := range nodeBackendFrameworks {
+			if pkg.hasDependency(f.dependency) {
+				return language, f.framework, string(data)
+			}
+		}
+		return "node", "", string(data)
+	}
+
+	return "", "", ""
+}
+
+// detectInfrastructure fills the infrastructure section from well-known files.
+func detectInfrastructure(cfg *ProjectConfig, projectDir string) {
+	exists := func(parts ...string) bool {
+		return utils.FileExists(filepath.Join(append([]string{projectDir}, parts...)...))
+	}
+
+	cfg.Infrastructure.Docker = exists("Dockerfile")
+	cfg.Infrastructure.DockerCompose = exists("docker-compose.yml") || exists("docker-compose.yaml") ||
+		exists("compose.yml") || exists("compose.yaml")
+	cfg.Infrastructure.Kubernetes = exists("k8s") || exists("kubernetes") || exists("helm")
+
+	switch {
+	case exists(".github", "workflows"):
+		cfg.Infrastructure.CI = "github-actions"
+	case exists(".gitlab-ci.yml"):
+		cfg.Infrastructure.CI = "gitlab-ci"
+	case exists(".circleci"):
+		cfg.Infrastructure.CI = "circleci"
+	case exists("Jenkinsfile"):
+		cfg.Infrastructure.CI = "jenkins"
+	default:
+		cfg.Infrastructure.CI = ""
+	}
+
+	switch {
+	case exists("vercel.json"):
+		cfg.Infrastructure.Hosting = "vercel"
+	case exists("netlify.toml"):
+		cfg.Infrastructure.Hosting = "netlify"
+	case exists("fly.toml"):
+		cfg.Infrastructure.Hosting = "fly"
+	case exists("render.yaml"):
+		cfg.Infrastructure.Hosting = "render"
+	default:
+		cfg.Infrastructure.Hosting = ""
+	}
+}
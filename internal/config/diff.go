@@ -0,0 +1,137 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ConfigChange is a single field that differs between two configurations.
+type ConfigChange struct {
+	// Path is the dot-notation path of the field
+	Path string `json:"path"`
+
+	// Old is the value in the original configuration, or nil if absent
+	Old interface{} `json:"old,omitempty"`
+
+	// New is the value in the changed configuration, or nil if absent
+	New interface{} `json:"new,omitempty"`
+}
+
+// String returns a human-readable description of the change, such as
+// "frontend.framework: react -> vue".
+func (c ConfigChange) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Path, formatChangeValue(c.Old), formatChangeValue(c.New))
+}
+
+// formatChangeValue formats one side of a change, marking absent values.
+func formatChangeValue(v interface{}) string {
+	if v == nil {
+		return "(unset)"
+	}
+	return fmt.Sprint(v)
+}
+
+// ConfigChanges is the list of changes between two configurations.
+type ConfigChanges []ConfigChange
+
+// String returns one change per line.
+func (c ConfigChanges) String() string {
+	lines := make([]string, len(c))
+	for i, change := range c {
+		lines[i] = change.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// diffIgnoredFields are fields that differ on every load and are not
+// meaningful changes.
+var diffIgnoredFields = map[string]bool{
+	"metadata.created_at": true,
+	"metadata.updated_at": true,
+}
+
+// Diff returns the fields that differ between a and b, sorted by path.
+// Paths use the same dot notation as SetConfigValue. Nested sections and
+// maps are compared field by field; lists are compared as a whole. Convert
+// the result to ConfigChanges to print it.
+//
+// Comparing encodes both configurations, which only fails for a value in
+// Extra that cannot be marshaled; Diff then returns no changes. Use
+// ProjectConfig.Diff to get that error.
+func Diff(a, b *ProjectConfig) []ConfigChange {
+	changes, _ := a.Diff(b)
+	return changes
+}
+
+// Diff returns the fields that differ between c and other, sorted by path,
+// as Diff does. An error means a configuration could not be encoded for
+// comparison, not that the two are equal.
+func (c *ProjectConfig) Diff(other *ProjectConfig) (ConfigChanges, error) {
+	oldMap, err := configToMap(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare configs: %w", err)
+	}
+	newMap, err := configToMap(other)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare configs: %w", err)
+	}
+
+	var changes ConfigChanges
+	diffTree("", oldMap, newMap, (*[]ConfigChange)(&changes))
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes, nil
+}
+
+// diffTree compares two nested maps, recursing into maps and treating every
+// other value as a leaf.
+func diffTree(prefix string, oldMap, newMap map[string]interface{}, changes *[]ConfigChange) {
+	keys := make(map[string]bool)
+	for k := range oldMap {
+		keys[k] = true
+	}
+	for k := range newMap {
+		keys[k] = true
+	}
+
+	for key := range keys {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		if diffIgnoredFields[path] {
+			continue
+		}
+
+		o, n := oldMap[key], newMap[key]
+		om, oIsMap := asMap(o)
+		nm, nIsMap := asMap(n)
+		if (oIsMap || o == nil) && (nIsMap || n == nil) && (oIsMap || nIsMap) {
+			diffTree(path, om, nm, changes)
+			continue
+		}
+
+		if !reflect.DeepEqual(o, n) {
+			*changes = append(*changes, ConfigChange{Path: path, Old: o, New: n})
+		}
+	}
+}
+
+// ProfileDiff loads the project configuration with each profile applied and
+// returns how profileB differs from profileA. An empty profile name compares
+// against the base configuration.
+func ProfileDiff(projectDir, profileA, profileB string) (ConfigChanges, error) {
+	a, err := NewLoader(WithProjectDir(projectDir), WithProfile(profileA)).Load()
+	if err != nil {
+		return nil, err
+	}
+	b, err := NewLoader(WithProjectDir(projectDir), WithProfile(profileB)).Load()
+	if err != nil {
+		return nil, err
+	}
+	return a.Diff(b)
+}
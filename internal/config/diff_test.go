@@ -0,0 +1,133 @@
+package config
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(cfg *ProjectConfig)
+		want   []ConfigChange
+		text   string
+	}{
+		{
+			name:   "modified",
+			change: func(cfg *ProjectConfig) { cfg.Frontend.Framework = "vue" },
+			want:   []ConfigChange{{Path: "frontend.framework", Old: "react", New: "vue"}},
+			text:   "frontend.framework: react -> vue",
+		},
+		{
+			name: "added and removed",
+			change: func(cfg *ProjectConfig) {
+				cfg.Variables = map[string]string{"company": "Acme"}
+				cfg.Frontend.Directory = ""
+			},
+			want: []ConfigChange{
+				{Path: "frontend.directory", Old: "src", New: ""},
+				{Path: "variables.company", New: "Acme"},
+			},
+			text: "frontend.directory: src -> \nvariables.company: (unset) -> Acme",
+		},
+		{
+			name:   "unchanged",
+			change: func(cfg *ProjectConfig) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := DefaultConfig()
+			b := DefaultConfig()
+			tt.change(b)
+
+			got := Diff(a, b)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff() = %v, want %v", got, tt.want)
+			}
+			if text := ConfigChanges(got).String(); text != tt.text {
+				t.Errorf("String() = %q, want %q", text, tt.text)
+			}
+		})
+	}
+}
+
+func TestProfileDiff(t *testing.T) {
+	// ProfileDiff reads the global config from the home directory
+	t.Setenv("USERPROFILE", "")
+	t.Setenv("HOME", t.TempDir())
+
+	dir := writeProject(t, map[string]string{
+		".clause/config.yaml": `metadata:
+  name: demo
+infrastructure:
+  hosting: vercel
+  monitoring:
+    logging:
+      level: info
+`,
+		".clause/config.staging.yaml": `infrastructure:
+  hosting: railway
+  monitoring:
+    logging:
+      level: debug
+`,
+		".clause/config.prod.yaml": `infrastructure:
+  hosting: aws
+  monitoring:
+    logging:
+      level: warn
+`,
+	})
+
+	tests := []struct {
+		name     string
+		profileA string
+		profileB string
+		want     map[string][2]interface{}
+	}{
+		{
+			"staging to prod", "staging", "prod",
+			map[string][2]interface{}{
+				"infrastructure.hosting":                  {"railway", "aws"},
+				"infrastructure.monitoring.logging.level": {"debug", "warn"},
+			},
+		},
+		{
+			"base to staging", "", "staging",
+			map[string][2]interface{}{
+				"infrastructure.hosting":                  {"vercel", "railway"},
+				"infrastructure.monitoring.logging.level": {"info", "debug"},
+			},
+		},
+		{"same profile", "prod", "prod", map[string][2]interface{}{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := ProfileDiff(dir, tt.profileA, tt.profileB)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var paths []string
+			for _, change := range changes {
+				paths = append(paths, change.Path)
+				want, ok := tt.want[change.Path]
+				if !ok {
+					t.Errorf("unexpected change %s", change)
+					continue
+				}
+				if change.Old != want[0] || change.New != want[1] {
+					t.Errorf("%s changed %v -> %v, want %v -> %v", change.Path, change.Old, change.New, want[0], want[1])
+				}
+			}
+			if len(changes) != len(tt.want) {
+				sort.Strings(paths)
+				t.Errorf("got %d changes %v, want %d", len(changes), paths, len(tt.want))
+			}
+		})
+	}
+}
@@ -23,9 +23,10 @@
 //
 //	1. Explicit flags/options (highest priority)
 //	2. Environment variables (CLAUSE_*)
-//	3. Project configuration (.clause/config.yaml)
-//	4. Global configuration (~/.clause/config.yaml)
-//	5. Default values (lowest priority)
+//	3. Profile overlay (.clause/config.<profile>.yaml)
+//	4. Project configuration (.clause/config.yaml)
+//	5. Global configuration (~/.clause/config.yaml)
+//	6. Default values (lowest priority)
 //
 // Example usage:
 //
@@ -37,6 +38,65 @@
 //	    log.Fatal(err)
 //	}
 //
+// LoadContext stops between sources once its context is done and returns
+// an error wrapping the context's error:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	cfg, err := loader.LoadContext(ctx)
+//
+// Once every source is merged, ApplyEnablementCascade clears the settings
+// of disabled sections, so a disabled backend carries no database or auth
+// settings into validation and generation.
+//
+// A single document can also be read from a file or any reader. Readers
+// have no extension, so the format is given explicitly:
+//
+//	cfg, err := loader.LoadFromReader(os.Stdin, "yaml")
+//
+// Profiles overlay environment-specific settings from
+// .clause/config.<profile>.yaml on top of the project configuration.
+// CORS origins from a profile are added to the base origins, and frontend
+// and backend feature flags are merged one by one, so a prod profile can
+// enable metrics without repeating the other features:
+//
+//	loader := config.NewLoader(
+//	    config.WithProjectDir("/path/to/project"),
+//	    config.WithProfile("staging"),
+//	)
+//
+// ProfileDiff reports how two profiles differ once applied:
+//
+//	changes, err := config.ProfileDiff("/path/to/project", "staging", "prod")
+//	for _, change := range changes {
+//	    fmt.Println(change) // infrastructure.hosting: aws -> gcp
+//	}
+//
+// ListProfiles returns the profiles a project defines, and ActiveProfile
+// the one selected with the CLAUSE_PROFILE environment variable:
+//
+//	profiles, err := config.ListProfiles("/path/to/project") // [prod staging]
+//	active := config.ActiveProfile()
+//
+// PromoteProfile writes the keys a profile sets into .clause/config.yaml,
+// keeping the file's comments, once they should apply everywhere,
+// optionally deleting the profile file:
+//
+//	err := config.PromoteProfile("/path/to/project", "staging", true)
+//
+// EncodeConfig turns a configuration into a compact, versioned token that
+// can be shared as text, and DecodeConfig turns it back into the same
+// configuration:
+//
+//	token, err := config.EncodeConfig(cfg)
+//	shared, err := config.DecodeConfig(token)
+//
+// Diff compares any two configurations, using the same dot-notation paths
+// as SetConfigValue:
+//
+//	changes := config.Diff(before, after)
+//	fmt.Println(config.ConfigChanges(changes)) // frontend.framework: react -> vue
+//
 // # Saving Configuration
 //
 // Configuration can be saved to files with automatic backup support:
@@ -49,6 +109,18 @@
 //	    log.Fatal(err)
 //	}
 //
+// Unrecognized top-level keys, such as sections added by a newer version of
+// Clause, are kept in ProjectConfig.Extra and written back on save.
+//
+// YAML is written with MarshalCanonical: fields in declaration order and
+// map keys such as development.scripts sorted, so saving an unchanged
+// config only changes its updated_at timestamp.
+//
+// DeleteConfigKeyInPlace removes a key from a hand-authored YAML file while
+// keeping its comments and key order:
+//
+//	err := config.DeleteConfigKeyInPlace(".clause/config.yaml", "backend.database.redis_version")
+//
 // # Validation
 //
 // Configuration can be validated to ensure correctness:
@@ -61,6 +133,68 @@
 //	    }
 //	}
 //
+// The accepted values of enumerated fields such as frontend.framework and
+// backend.database.primary are defined once, as typed constants and sets in
+// the enum subpackage, and shared by the validator and the schema export.
+//
+// The severity of individual checks can be changed per field path, either
+// on the validator or in governance.rules.rules. An override of "off", or
+// "enabled: false" without a severity, removes the check from the results;
+// a rule without an enabled key is enabled. Keys that are governance rule
+// names are left to the governance validator:
+//
+//	governance:
+//	  rules:
+//	    rules:
+//	      infrastructure.monitoring.enabled:
+//	        severity: "off"
+//	      infrastructure.docker_compose:
+//	        severity: error
+//
+// metadata.repository accepts an HTTPS or SSH URL, host/owner/repo or
+// owner/repo. NormalizeRepoURL returns both canonical forms, and the
+// validator warns about values it cannot parse:
+//
+//	https, ssh, err := config.NormalizeRepoURL("git@github.com:org/repo.git")
+//
+// metadata.keywords should hold at most MaxKeywords lowercase keywords of up
+// to MaxKeywordLength characters, as package registries expect; the
+// validator warns otherwise. NormalizeKeywords converts a list to that form:
+//
+//	cfg.Metadata.Keywords = config.NormalizeKeywords(cfg.Metadata.Keywords)
+//
+// Analyze combines validation with deprecation and coherence checks and
+// attaches a suggested fix to each issue, for display by commands:
+//
+//	report := config.Analyze(cfg)
+//	for _, issue := range report.Issues {
+//	    fmt.Printf("[%s] %s: %s (%s)\n", issue.Severity, issue.Field, issue.Message, issue.Suggestion)
+//	}
+//
+// # Field Documentation
+//
+// FieldDocs describes every configuration field with its path, type,
+// default and doc comment. Descriptions are generated from config.go, so
+// run go generate after changing the config structs:
+//
+//	for _, doc := range config.FieldDocs() {
+//	    fmt.Printf("%s (%s): %s\n", doc.Path, doc.Type, doc.Description)
+//	}
+//
+// ExportJSONSchema builds a Draft-07 JSON Schema from the same field
+// comments, with enums taken from the enum package, for editor completion:
+//
+//	schema, err := config.ExportJSONSchema()
+//
+// # Variables
+//
+// The variables section defines custom values that generated templates can
+// reference as .Vars. Names must be valid identifiers:
+//
+//	variables:
+//	  company: Acme Inc
+//	  api_base_url: https://api.example.com
+//
 // # Presets
 //
 // Presets provide pre-configured setups for common use cases:
@@ -74,9 +208,26 @@
 //
 // Example with preset:
 //
-//	preset, _ := config.GetPreset("saas")
-//	cfg := config.NewProjectConfig()
-//	preset.Apply(cfg)
+//	cfg, err := config.LoadPreset("saas")
+//
+// A preset can build on another with Extends; the parent is applied first.
+// Custom presets are added with RegisterPreset:
+//
+//	config.RegisterPreset(config.Preset{
+//	    Name:    "saas-eu",
+//	    Extends: "saas",
+//	    Apply:   func(c *config.ProjectConfig) { c.Infrastructure.Hosting = "gcp" },
+//	})
+//	preset, _ := config.GetPreset("saas-eu")
+//	lineage, _ := preset.Lineage() // [saas saas-eu]
+//
+// LoadPreset caches preset results and returns a fresh copy on every call.
+// PreviewConfig layers dot-notation overrides on top, which keeps live
+// previews cheap:
+//
+//	cfg := config.PreviewConfig("saas", map[string]interface{}{
+//		"backend.framework": "nestjs",
+//	})
 //
 // # Environment Variables
 //
@@ -87,6 +238,17 @@
 //	CLAUSE_BACKEND_DATABASE=postgresql
 //	CLAUSE_GOVERNANCE_ENABLED=true
 //
+// Every field can be set this way: the variable name is the field path in
+// upper case with dots replaced by underscores. Values are converted to the
+// field type, and lists are comma-separated:
+//
+//	CLAUSE_BACKEND_AUTH_SESSION_DURATION=48
+//	CLAUSE_FRONTEND_FEATURES_SSR=true
+//	CLAUSE_BACKEND_API_CORS_ORIGINS=https://app.example.com,https://admin.example.com
+//
+// Variables that match no field are logged and reported by
+// Loader.UnknownEnvVars.
+//
 // # File Format
 //
 // Configuration files use YAML format by default:
@@ -105,4 +267,20 @@
 //	  database:
 //	    primary: postgresql
 //	    orm: sqlalchemy
+//
+// Project files written with an older schema version are upgraded on load
+// by MigrateConfigFile, which applies each newer migration step in order,
+// editing YAML files as a node tree so comments survive, and keeps the
+// original as a .bak:
+//
+//	if _, err := config.MigrateConfigFile(".clause/config.yaml"); err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// Renamed fields are mapped to their current names on every load, before
+// the file is decoded, so a 0.x top-level "database: mysql" is read as
+// backend.database.primary.
+//
+// JSON and TOML files are also supported; the format is chosen by the file
+// extension when loading and by WithFormat when saving.
 package config
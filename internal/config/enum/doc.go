@@ -0,0 +1,15 @@
+// Package enum defines the accepted values of the enumerated configuration
+// fields, such as frontend frameworks, databases and build tools.
+//
+// Each category is a string type with one constant per value and a Set
+// listing every value in display order:
+//
+//	cfg.Frontend.Framework = string(enum.FrontendNextJS)
+//
+//	if !enum.Databases.Contains(cfg.Backend.Database.Primary) {
+//	    fmt.Printf("supported: %s\n", enum.Databases)
+//	}
+//
+// The validator and the JSON Schema export read their accepted values from
+// these sets, so a new value is added in one place.
+package enum
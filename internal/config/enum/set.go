@@ -0,0 +1,36 @@
+package enum
+
+import "strings"
+
+// Set is the list of accepted values of an enum type, in display order.
+type Set[T ~string] []T
+
+// All returns a copy of the values.
+func (s Set[T]) All() []T {
+	return append([]T(nil), s...)
+}
+
+// Strings returns the values as plain strings.
+func (s Set[T]) Strings() []string {
+	values := make([]string, len(s))
+	for i, v := range s {
+		values[i] = string(v)
+	}
+	return values
+}
+
+// Contains reports whether value is one of the values.
+func (s Set[T]) Contains(value string) bool {
+	for _, v := range s {
+		if string(v) == value {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the values separated by commas, for messages such as
+// "supported: npm, yarn, pnpm, bun".
+func (s Set[T]) String() string {
+	return strings.Join(s.Strings(), ", ")
+}
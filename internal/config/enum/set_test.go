@@ -0,0 +1,41 @@
+package enum
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSet(t *testing.T) {
+	set := Set[PackageManager]{PackageManagerNPM, PackageManagerPNPM}
+
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{"npm", true},
+		{"pnpm", true},
+		{"NPM", false},
+		{"yarn", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := set.Contains(tt.value); got != tt.want {
+				t.Errorf("Contains(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+
+	if got, want := set.String(), "npm, pnpm"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if got, want := set.Strings(), []string{"npm", "pnpm"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Strings() = %v, want %v", got, want)
+	}
+
+	all := set.All()
+	all[0] = "bun"
+	if set[0] != PackageManagerNPM {
+		t.Error("All() shares its backing array with the set")
+	}
+}
@@ -0,0 +1,283 @@
+package enum
+
+// FrontendFramework is a frontend.framework value.
+type FrontendFramework string
+
+// Supported frontend frameworks.
+const (
+	FrontendReact     FrontendFramework = "react"
+	FrontendVue       FrontendFramework = "vue"
+	FrontendSvelte    FrontendFramework = "svelte"
+	FrontendAngular   FrontendFramework = "angular"
+	FrontendNextJS    FrontendFramework = "nextjs"
+	FrontendNuxt      FrontendFramework = "nuxt"
+	FrontendSvelteKit FrontendFramework = "sveltekit"
+	FrontendRemix     FrontendFramework = "remix"
+	FrontendAstro     FrontendFramework = "astro"
+	FrontendSolid     FrontendFramework = "solid"
+)
+
+// FrontendFrameworks lists the supported frontend frameworks.
+var FrontendFrameworks = Set[FrontendFramework]{
+	FrontendReact, FrontendVue, FrontendSvelte, FrontendAngular,
+	FrontendNextJS, FrontendNuxt, FrontendSvelteKit, FrontendRemix,
+	FrontendAstro, FrontendSolid,
+}
+
+// SSRFrameworks lists the frontend frameworks that render on the server.
+var SSRFrameworks = Set[FrontendFramework]{
+	FrontendNextJS, FrontendNuxt, FrontendSvelteKit, FrontendRemix,
+	FrontendAstro, FrontendAngular,
+}
+
+// BackendFramework is a backend.framework value.
+type BackendFramework string
+
+// Supported backend frameworks.
+const (
+	BackendFastAPI    BackendFramework = "fastapi"
+	BackendExpress    BackendFramework = "express"
+	BackendNestJS     BackendFramework = "nestjs"
+	BackendDjango     BackendFramework = "django"
+	BackendGin        BackendFramework = "go-gin"
+	BackendFiber      BackendFramework = "go-fiber"
+	BackendEcho       BackendFramework = "go-echo"
+	BackendAxum       BackendFramework = "rust-axum"
+	BackendActix      BackendFramework = "rust-actix"
+	BackendRocket     BackendFramework = "rust-rocket"
+	BackendRails      BackendFramework = "rails"
+	BackendPhoenix    BackendFramework = "phoenix"
+	BackendSpringBoot BackendFramework = "spring"
+)
+
+// BackendFrameworks lists the supported backend frameworks.
+var BackendFrameworks = Set[BackendFramework]{
+	BackendFastAPI, BackendExpress, BackendNestJS, BackendDjango,
+	BackendGin, BackendFiber, BackendEcho,
+	BackendAxum, BackendActix, BackendRocket,
+	BackendRails, BackendPhoenix, BackendSpringBoot,
+}
+
+// Styling is a frontend.styling value.
+type Styling string
+
+// Supported styling solutions.
+const (
+	StylingTailwind         Styling = "tailwind"
+	StylingCSSModules       Styling = "css-modules"
+	StylingStyledComponents Styling = "styled-components"
+	StylingSCSS             Styling = "scss"
+	StylingSass             Styling = "sass"
+	StylingLess             Styling = "less"
+	StylingEmotion          Styling = "emotion"
+	StylingStitches         Styling = "stitches"
+)
+
+// StylingOptions lists the supported styling solutions.
+var StylingOptions = Set[Styling]{
+	StylingTailwind, StylingCSSModules, StylingStyledComponents,
+	StylingSCSS, StylingSass, StylingLess, StylingEmotion, StylingStitches,
+}
+
+// PackageManager is a frontend.package_manager value.
+type PackageManager string
+
+// Supported package managers.
+const (
+	PackageManagerNPM  PackageManager = "npm"
+	PackageManagerYarn PackageManager = "yarn"
+	PackageManagerPNPM PackageManager = "pnpm"
+	PackageManagerBun  PackageManager = "bun"
+)
+
+// PackageManagers lists the supported package managers.
+var PackageManagers = Set[PackageManager]{
+	PackageManagerNPM, PackageManagerYarn, PackageManagerPNPM, PackageManagerBun,
+}
+
+// BuildTool is a frontend.build_tool value.
+type BuildTool string
+
+// Supported build tools.
+const (
+	BuildToolVite      BuildTool = "vite"
+	BuildToolWebpack   BuildTool = "webpack"
+	BuildToolESBuild   BuildTool = "esbuild"
+	BuildToolRollup    BuildTool = "rollup"
+	BuildToolTurbo     BuildTool = "turbo"
+	BuildToolTurbopack BuildTool = "turbopack"
+	BuildToolParcel    BuildTool = "parcel"
+	BuildToolSWC       BuildTool = "swc"
+)
+
+// BuildTools lists the supported build tools. "turbopack" replaces the
+// "turboPack" spelling the validator accepted before; the old spelling is
+// not a build tool of its own, and the validator accepts it with a
+// deprecation warning.
+var BuildTools = Set[BuildTool]{
+	BuildToolVite, BuildToolWebpack, BuildToolESBuild, BuildToolRollup,
+	BuildToolTurbo, BuildToolTurbopack, BuildToolParcel, BuildToolSWC,
+}
+
+// Database is a backend.database.primary value.
+type Database string
+
+// Supported databases.
+const (
+	DatabasePostgreSQL  Database = "postgresql"
+	DatabaseMySQL       Database = "mysql"
+	DatabaseSQLite      Database = "sqlite"
+	DatabaseMongoDB     Database = "mongodb"
+	DatabaseMariaDB     Database = "mariadb"
+	DatabaseCockroachDB Database = "cockroachdb"
+	DatabasePlanetScale Database = "planetscale"
+)
+
+// Databases lists the supported primary databases.
+var Databases = Set[Database]{
+	DatabasePostgreSQL, DatabaseMySQL, DatabaseSQLite, DatabaseMongoDB,
+	DatabaseMariaDB, DatabaseCockroachDB, DatabasePlanetScale,
+}
+
+// DocumentDatabases lists the databases that manage schema without
+// migrations.
+var DocumentDatabases = Set[Database]{DatabaseMongoDB}
+
+// AuthProvider is a backend.auth.provider value.
+type AuthProvider string
+
+// Supported authentication providers.
+const (
+	AuthJWT      AuthProvider = "jwt"
+	AuthOAuth    AuthProvider = "oauth"
+	AuthOIDC     AuthProvider = "oidc"
+	AuthClerk    AuthProvider = "clerk"
+	AuthAuth0    AuthProvider = "auth0"
+	AuthFirebase AuthProvider = "firebase"
+	AuthNextAuth AuthProvider = "nextauth"
+	AuthPassport AuthProvider = "passport"
+	AuthLucia    AuthProvider = "lucia"
+	AuthSupabase AuthProvider = "supabase"
+	AuthCognito  AuthProvider = "cognito"
+)
+
+// AuthProviders lists the supported authentication providers.
+var AuthProviders = Set[AuthProvider]{
+	AuthJWT, AuthOAuth, AuthOIDC,
+	AuthClerk, AuthAuth0, AuthFirebase,
+	AuthNextAuth, AuthPassport, AuthLucia,
+	AuthSupabase, AuthCognito,
+}
+
+// SessionAuthProviders lists the auth providers whose sessions the
+// generated backend issues itself. Hosted providers manage their own.
+var SessionAuthProviders = Set[AuthProvider]{
+	AuthJWT, AuthOAuth, AuthOIDC, AuthNextAuth, AuthPassport, AuthLucia,
+}
+
+// APIStyle is a backend.api.style value.
+type APIStyle string
+
+// Supported API styles.
+const (
+	APIStyleREST    APIStyle = "rest"
+	APIStyleGraphQL APIStyle = "graphql"
+	APIStyleGRPC    APIStyle = "grpc"
+	APIStyleTRPC    APIStyle = "trpc"
+	APIStyleTSOA    APIStyle = "tsoa"
+)
+
+// APIStyles lists the supported API styles.
+var APIStyles = Set[APIStyle]{
+	APIStyleREST, APIStyleGraphQL, APIStyleGRPC, APIStyleTRPC, APIStyleTSOA,
+}
+
+// APIVersioning is a backend.api.versioning value.
+type APIVersioning string
+
+// Supported API versioning strategies.
+const (
+	VersioningURL    APIVersioning = "url"
+	VersioningHeader APIVersioning = "header"
+	VersioningQuery  APIVersioning = "query"
+	VersioningNone   APIVersioning = "none"
+)
+
+// APIVersioningStrategies lists the supported API versioning strategies.
+var APIVersioningStrategies = Set[APIVersioning]{
+	VersioningURL, VersioningHeader, VersioningQuery, VersioningNone,
+}
+
+// CIProvider is an infrastructure.ci value.
+type CIProvider string
+
+// Supported CI/CD providers.
+const (
+	CIGitHubActions      CIProvider = "github-actions"
+	CIGitLabCI           CIProvider = "gitlab-ci"
+	CICircleCI           CIProvider = "circleci"
+	CIJenkins            CIProvider = "jenkins"
+	CIAzurePipelines     CIProvider = "azure-pipelines"
+	CITravis             CIProvider = "travis"
+	CIBitbucketPipelines CIProvider = "bitbucket-pipelines"
+	CIBuildkite          CIProvider = "buildkite"
+)
+
+// CIProviders lists the supported CI/CD providers.
+var CIProviders = Set[CIProvider]{
+	CIGitHubActions, CIGitLabCI, CICircleCI,
+	CIJenkins, CIAzurePipelines, CITravis,
+	CIBitbucketPipelines, CIBuildkite,
+}
+
+// HostingProvider is an infrastructure.hosting value.
+type HostingProvider string
+
+// Supported hosting platforms.
+const (
+	HostingVercel       HostingProvider = "vercel"
+	HostingNetlify      HostingProvider = "netlify"
+	HostingAWS          HostingProvider = "aws"
+	HostingGCP          HostingProvider = "gcp"
+	HostingAzure        HostingProvider = "azure"
+	HostingDigitalOcean HostingProvider = "digitalocean"
+	HostingRailway      HostingProvider = "railway"
+	HostingRender       HostingProvider = "render"
+	HostingFly          HostingProvider = "fly"
+	HostingHeroku       HostingProvider = "heroku"
+	HostingCloudflare   HostingProvider = "cloudflare"
+	HostingSelfHosted   HostingProvider = "self-hosted"
+)
+
+// HostingProviders lists the supported hosting platforms.
+var HostingProviders = Set[HostingProvider]{
+	HostingVercel, HostingNetlify, HostingAWS, HostingGCP, HostingAzure,
+	HostingDigitalOcean, HostingRailway, HostingRender, HostingFly,
+	HostingHeroku, HostingCloudflare, HostingSelfHosted,
+}
+
+// ContextLevel is a governance.context_level value.
+type ContextLevel string
+
+// Supported AI context levels.
+const (
+	ContextMinimal       ContextLevel = "minimal"
+	ContextStandard      ContextLevel = "standard"
+	ContextComprehensive ContextLevel = "comprehensive"
+)
+
+// ContextLevels lists the supported AI context levels.
+var ContextLevels = Set[ContextLevel]{ContextMinimal, ContextStandard, ContextComprehensive}
+
+// AssistantTarget is a governance.assistant_targets value.
+type AssistantTarget string
+
+// Supported AI assistants.
+const (
+	AssistantCursor  AssistantTarget = "cursor"
+	AssistantCopilot AssistantTarget = "copilot"
+	AssistantClaude  AssistantTarget = "claude"
+)
+
+// AssistantTargets lists the AI assistants that instructions can be written for.
+var AssistantTargets = Set[AssistantTarget]{AssistantCursor, AssistantCopilot, AssistantClaude}
@@ -0,0 +1,99 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/clause-cli/clause/pkg/output"
+	"github.com/clause-cli/clause/pkg/utils"
+)
+
+// envFields maps environment variable names without the prefix, such as
+// BACKEND_AUTH_SESSION_DURATION, to the field they set. The fields are
+// static, so the map is built once.
+var envFields = sync.OnceValue(func() map[string]FieldDoc {
+	fields := make(map[string]FieldDoc)
+	for _, doc := range FieldDocs() {
+		key := strings.ToUpper(strings.ReplaceAll(doc.Path, ".", "_"))
+		fields[key] = doc
+	}
+	return fields
+})
+
+// applyFieldEnvVars sets any config leaf from a <prefix><PATH> variable,
+// where PATH is the dot-notation path in upper case with dots replaced by
+// underscores. Variables in skip are handled elsewhere. Variables that match
+// no field, or whose value cannot be applied, are recorded in
+// l.unknownEnvVars and logged.
+func (l *Loader) applyFieldEnvVars(config *ProjectConfig, skip map[string]func(string)) {
+	fields := envFields()
+	l.unknownEnvVars = nil
+
+	for _, env := range os.Environ() {
+		key, value, _ := strings.Cut(env, "=")
+		if !strings.HasPrefix(key, l.envPrefix) || value == "" {
+			continue
+		}
+		if _, ok := skip[key]; ok {
+			continue
+		}
+
+		field, ok := fields[strings.TrimPrefix(key, l.envPrefix)]
+		if !ok {
+			l.unknownEnvVars = append(l.unknownEnvVars, key)
+			output.DefaultLogger.Debug("Ignoring unknown environment variable %s", key)
+			continue
+		}
+
+		if err := setFieldFromEnv(config, key, field, value); err != nil {
+			l.unknownEnvVars = append(l.unknownEnvVars, key)
+			output.DefaultLogger.Warn("Ignoring environment variable: %v", err)
+		}
+	}
+}
+
+// UnknownEnvVars returns the prefixed environment variables that the last
+// Load could not apply.
+func (l *Loader) UnknownEnvVars() []string {
+	return l.unknownEnvVars
+}
+
+// setFieldFromEnv converts the value of the environment variable key to the
+// field's type and sets it. Lists are comma-separated. Errors name key.
+func setFieldFromEnv(config *ProjectConfig, key string, field FieldDoc, value string) error {
+	switch field.Type {
+	case "string":
+		return setNestedValue(config, field.Path, value)
+	case "bool":
+		b, err := utils.ParseBoolStrict(value)
+		if err != nil {
+			return fmt.Errorf("%s must be a boolean: %w", key, err)
+		}
+		return setNestedValue(config, field.Path, b)
+	case "int":
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%s must be an integer, got %q", key, value)
+		}
+		return setNestedValue(config, field.Path, n)
+	case "[]string":
+		list, err := listFieldByPath(config, field.Path)
+		if err != nil {
+			return err
+		}
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		list.Set(reflect.ValueOf(items))
+		return nil
+	default:
+		return fmt.Errorf("%s sets %s (%s), which cannot be set from the environment", key, field.Path, field.Type)
+	}
+}
@@ -0,0 +1,85 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSetFieldFromEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		value   string
+		get     func(cfg *ProjectConfig) interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:  "bool yes",
+			key:   "CLAUSE_FRONTEND_ENABLED",
+			value: "yes",
+			get:   func(cfg *ProjectConfig) interface{} { return cfg.Frontend.Enabled },
+			want:  true,
+		},
+		{
+			name:  "bool off",
+			key:   "CLAUSE_FRONTEND_ENABLED",
+			value: "off",
+			get:   func(cfg *ProjectConfig) interface{} { return cfg.Frontend.Enabled },
+			want:  false,
+		},
+		{
+			name:    "bool typo",
+			key:     "CLAUSE_FRONTEND_ENABLED",
+			value:   "ture",
+			wantErr: true,
+		},
+		{
+			name:  "int",
+			key:   "CLAUSE_BACKEND_AUTH_SESSION_DURATION",
+			value: "48",
+			get:   func(cfg *ProjectConfig) interface{} { return cfg.Backend.Auth.SessionDuration },
+			want:  48,
+		},
+		{
+			name:    "int typo",
+			key:     "CLAUSE_BACKEND_AUTH_SESSION_DURATION",
+			value:   "two days",
+			wantErr: true,
+		},
+		{
+			name:  "string",
+			key:   "CLAUSE_INFRASTRUCTURE_HOSTING",
+			value: "railway",
+			get:   func(cfg *ProjectConfig) interface{} { return cfg.Infrastructure.Hosting },
+			want:  "railway",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field, ok := envFields()[strings.TrimPrefix(tt.key, "CLAUSE_")]
+			if !ok {
+				t.Fatalf("no field for %s", tt.key)
+			}
+			cfg := DefaultConfig()
+			if b, ok := tt.want.(bool); ok {
+				cfg.Frontend.Enabled = !b
+			}
+
+			err := setFieldFromEnv(cfg, tt.key, field, tt.value)
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), tt.key) {
+					t.Fatalf("error = %v, want an error naming %s", err, tt.key)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := tt.get(cfg); got != tt.want {
+				t.Errorf("%s=%s set %v, want %v", tt.key, tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// projectConfigFields is ProjectConfig without its marshaling methods.
+type projectConfigFields ProjectConfig
+
+// knownTopLevelKeys are the top-level keys that map to ProjectConfig fields.
+var knownTopLevelKeys = func() map[string]bool {
+	keys := make(map[string]bool)
+	t := reflect.TypeOf(ProjectConfig{})
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0]
+		if name != "" && name != "-" {
+			keys[name] = true
+		}
+	}
+	return keys
+}()
+
+// extraKeys returns the unrecognized top-level keys of a decoded config.
+func extraKeys(m map[string]interface{}) map[string]interface{} {
+	var extra map[string]interface{}
+	for key, value := range m {
+		if knownTopLevelKeys[key] {
+			continue
+		}
+		if extra == nil {
+			extra = make(map[string]interface{})
+		}
+		extra[key] = value
+	}
+	return extra
+}
+
+// sortedExtraKeys returns the keys of c.Extra in a stable order.
+func (c *ProjectConfig) sortedExtraKeys() []string {
+	keys := make([]string, 0, len(c.Extra))
+	for key := range c.Extra {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// UnmarshalYAML decodes the config and keeps unrecognized top-level keys in
+// Extra, so data written by newer versions survives a load and save.
+func (c *ProjectConfig) UnmarshalYAML(value *yaml.Node) error {
+	if err := value.Decode((*projectConfigFields)(c)); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	if err := value.Decode(&m); err != nil {
+		return err
+	}
+	c.Extra = extraKeys(m)
+	return nil
+}
+
+// MarshalYAML encodes the config followed by the keys in Extra.
+func (c *ProjectConfig) MarshalYAML() (interface{}, error) {
+	if len(c.Extra) == 0 {
+		return (*projectConfigFields)(c), nil
+	}
+
+	node := &yaml.Node{}
+	if err := node.Encode((*projectConfigFields)(c)); err != nil {
+		return nil, err
+	}
+
+	for _, key := range c.sortedExtraKeys() {
+		if knownTopLevelKeys[key] {
+			continue
+		}
+
+		valueNode := &yaml.Node{}
+		if err := valueNode.Encode(c.Extra[key]); err != nil {
+			return nil, fmt.Errorf("failed to encode %s: %w", key, err)
+		}
+		node.Content = append(node.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+			valueNode,
+		)
+	}
+	return node, nil
+}
+
+// UnmarshalJSON decodes the config and keeps unrecognized top-level keys in Extra.
+func (c *ProjectConfig) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, (*projectConfigFields)(c)); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	c.Extra = extraKeys(m)
+	return nil
+}
+
+// MarshalJSON encodes the config followed by the keys in Extra.
+func (c *ProjectConfig) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal((*projectConfigFields)(c))
+	if err != nil || len(c.Extra) == 0 {
+		return data, err
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for key, value := range c.Extra {
+		if !knownTopLevelKeys[key] {
+			m[key] = value
+		}
+	}
+	return json.Marshal(m)
+}
+
+// unmarshalTOML decodes a TOML config and keeps unrecognized top-level keys
+// in Extra. TOML has no unmarshaler hook, so this mirrors UnmarshalJSON.
+func unmarshalTOML(data []byte, c *ProjectConfig) error {
+	if err := toml.Unmarshal(data, (*projectConfigFields)(c)); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	if err := toml.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	c.Extra = extraKeys(m)
+	return nil
+}
+
+// marshalTOML encodes the config followed by the keys in Extra.
+func marshalTOML(c *ProjectConfig) ([]byte, error) {
+	data, err := toml.Marshal((*projectConfigFields)(c))
+	if err != nil || len(c.Extra) == 0 {
+		return data, err
+	}
+
+	var m map[string]interface{}
+	if err := toml.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for key, value := range c.Extra {
+		if !knownTopLevelKeys[key] {
+			m[key] = value
+		}
+	}
+	return toml.Marshal(m)
+}
+
+// cloneExtraValue deep-copies a value decoded from YAML or JSON.
+func cloneExtraValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		cloned := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			cloned[key] = cloneExtraValue(item)
+		}
+		return cloned
+	case []interface{}:
+		cloned := make([]interface{}, len(v))
+		for i, item := range v {
+			cloned[i] = cloneExtraValue(item)
+		}
+		return cloned
+	default:
+		return v
+	}
+}
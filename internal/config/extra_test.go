@@ -0,0 +1,87 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestExtraKeysRoundTrip(t *testing.T) {
+	want := map[string]interface{}{
+		"experimental": map[string]interface{}{
+			"turbo": true,
+			"flags": []interface{}{"a", "b"},
+		},
+	}
+
+	tests := []struct {
+		format  string
+		content string
+	}{
+		{"yaml", "metadata:\n  name: demo\nexperimental:\n  turbo: true\n  flags:\n    - a\n    - b\n"},
+		{"json", `{"metadata": {"name": "demo"}, "experimental": {"turbo": true, "flags": ["a", "b"]}}`},
+		{"toml", "[metadata]\nname = \"demo\"\n\n[experimental]\nturbo = true\nflags = [\"a\", \"b\"]\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			dir := t.TempDir()
+			src := filepath.Join(dir, "config."+tt.format)
+			if err := os.WriteFile(src, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			loader := NewLoader(WithGlobalDir(t.TempDir()))
+			cfg, err := loader.LoadFromPath(src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(cfg.Extra, want) {
+				t.Fatalf("loaded extra = %#v, want %#v", cfg.Extra, want)
+			}
+
+			dst := filepath.Join(dir, "saved", "config."+tt.format)
+			if err := NewSaver(WithFormat(tt.format)).Save(cfg, dst); err != nil {
+				t.Fatal(err)
+			}
+			saved, err := loader.LoadFromPath(dst)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(saved.Extra, want) {
+				t.Errorf("saved extra = %#v, want %#v", saved.Extra, want)
+			}
+			if saved.Metadata.Name != "demo" {
+				t.Errorf("saved name = %q, want demo", saved.Metadata.Name)
+			}
+		})
+	}
+}
+
+func TestExtraKeysSurviveProjectSave(t *testing.T) {
+	dir := writeProject(t, map[string]string{
+		".clause/config.yaml": "metadata:\n  name: demo\nexperimental:\n  turbo: true\n",
+	})
+
+	cfg, err := loadProject(t, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.Frontend.Framework = "vue"
+	if err := NewSaver().SaveToProject(cfg, dir); err != nil {
+		t.Fatal(err)
+	}
+
+	saved, err := loadProject(t, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{"experimental": map[string]interface{}{"turbo": true}}
+	if !reflect.DeepEqual(saved.Extra, want) {
+		t.Errorf("extra after save = %#v, want %#v", saved.Extra, want)
+	}
+	if saved.Frontend.Framework != "vue" {
+		t.Errorf("framework = %q, want vue", saved.Frontend.Framework)
+	}
+}
@@ -0,0 +1,77 @@
+package config
+
+//go:generate go run gen_fielddocs.go
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// FieldDoc describes a single configuration field.
+type FieldDoc struct {
+	// Path is the dot-notation path of the field
+	Path string `json:"path"`
+
+	// Type is the Go type of the field
+	Type string `json:"type"`
+
+	// Default is the default value, or nil if the field has none
+	Default interface{} `json:"default,omitempty"`
+
+	// Description is the field's doc comment
+	Description string `json:"description"`
+}
+
+// FieldDocs returns documentation for every configuration field, in
+// declaration order. Descriptions come from the field comments in config.go
+// (see fielddocs_gen.go) and defaults from GetDefaultFor.
+func FieldDocs() []FieldDoc {
+	var docs []FieldDoc
+	collectFieldDocs(reflect.TypeOf(ProjectConfig{}), "", &docs)
+	return docs
+}
+
+// FieldDocFor returns the documentation for a single field path.
+func FieldDocFor(path string) (FieldDoc, bool) {
+	for _, doc := range FieldDocs() {
+		if doc.Path == path {
+			return doc, true
+		}
+	}
+	return FieldDoc{}, false
+}
+
+// collectFieldDocs walks a struct type and appends a FieldDoc for each leaf field.
+func collectFieldDocs(t reflect.Type, prefix string, docs *[]FieldDoc) {
+	timeType := reflect.TypeOf(time.Time{})
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+
+		if field.Type.Kind() == reflect.Struct && field.Type != timeType {
+			collectFieldDocs(field.Type, path, docs)
+			continue
+		}
+
+		doc := FieldDoc{
+			Path:        path,
+			Type:        strings.TrimPrefix(field.Type.String(), "config."),
+			Description: fieldComments[path],
+		}
+		if value, err := GetDefaultFor(path); err == nil {
+			doc.Default = value
+		}
+		*docs = append(*docs, doc)
+	}
+}
@@ -0,0 +1,124 @@
+// Code generated by gen_fielddocs.go; DO NOT EDIT.
+
+package config
+
+// fieldComments maps field paths to the doc comments in config.go.
+var fieldComments = map[string]string{
+	"backend":                                           "Backend contains backend framework and service configuration",
+	"backend.api":                                       "API contains API configuration",
+	"backend.api.cors":                                  "CORS contains CORS configuration",
+	"backend.api.cors.credentials":                      "Credentials indicates if credentials are allowed",
+	"backend.api.cors.enabled":                          "Enabled indicates if CORS is enabled",
+	"backend.api.cors.methods":                          "Methods contains allowed HTTP methods",
+	"backend.api.cors.origins":                          "Origins contains allowed origins",
+	"backend.api.documentation":                         "Documentation indicates if API documentation is generated",
+	"backend.api.style":                                 "Style is the API style (rest, graphql, grpc, trpc)",
+	"backend.api.versioning":                            "Versioning is the API versioning strategy (url, header, none)",
+	"backend.auth":                                      "Auth contains authentication configuration",
+	"backend.auth.methods":                              "Methods contains enabled authentication methods",
+	"backend.auth.provider":                             "Provider is the authentication provider (jwt, oauth, clerk, auth0, firebase)",
+	"backend.auth.session_duration":                     "SessionDuration is the session duration in hours",
+	"backend.database":                                  "Database contains database configuration",
+	"backend.database.migrations":                       "Migrations indicates if database migrations are enabled",
+	"backend.database.orm":                              "ORM is the ORM/tool to use (prisma, sqlalchemy, gorm, mongoose)",
+	"backend.database.primary":                          "Primary is the primary database type (postgresql, mysql, sqlite, mongodb)",
+	"backend.database.primary_version":                  "PrimaryVersion is the database version",
+	"backend.database.redis":                            "Redis indicates if Redis is used for caching",
+	"backend.database.redis_version":                    "RedisVersion is the Redis version",
+	"backend.directory":                                 "Directory is the backend source directory",
+	"backend.enabled":                                   "Enabled indicates if the project has a backend",
+	"backend.features":                                  "Features contains optional backend features",
+	"backend.features.background_jobs":                  "BackgroundJobs enables background job processing",
+	"backend.features.email":                            "Email enables email sending capabilities",
+	"backend.features.email_provider":                   "EmailProvider is the email delivery service (smtp, sendgrid, ses, resend, postmark)",
+	"backend.features.file_upload":                      "FileUpload enables file upload handling",
+	"backend.features.logging":                          "Logging enables structured logging",
+	"backend.features.metrics":                          "Metrics enables metrics collection",
+	"backend.features.rate_limiting":                    "RateLimiting enables API rate limiting",
+	"backend.features.websocket":                        "WebSocket enables WebSocket support",
+	"backend.framework":                                 "Framework is the backend framework (fastapi, express, nestjs, go-gin, rust-axum)",
+	"backend.framework_version":                         "FrameworkVersion is the framework version",
+	"backend.language":                                  "Language is the backend programming language",
+	"backend.language_version":                          "LanguageVersion is the language version",
+	"development":                                       "Development contains development workflow settings",
+	"development.editor":                                "Editor contains editor configuration",
+	"development.editor.config":                         "Config indicates if .editorconfig is generated",
+	"development.editor.extensions":                     "Extensions contains recommended VS Code extensions",
+	"development.editor.vscode":                         "VSCode indicates if VS Code settings are generated",
+	"development.git":                                   "Git indicates if git is initialized",
+	"development.hooks":                                 "Hooks contains git hooks configuration",
+	"development.hooks.commit_msg":                      "CommitMsg enables commit message validation",
+	"development.hooks.lint_staged":                     "LintStaged enables linting of staged files",
+	"development.hooks.pre_commit":                      "PreCommit enables pre-commit hooks",
+	"development.hooks.pre_push":                        "PrePush enables pre-push hooks",
+	"development.scripts":                               "Scripts contains custom npm/make scripts",
+	"frontend":                                          "Frontend contains frontend framework and tooling configuration",
+	"frontend.build_tool":                               "BuildTool is the build tool (vite, webpack, esbuild, rollup)",
+	"frontend.directory":                                "Directory is the frontend source directory",
+	"frontend.enabled":                                  "Enabled indicates if the project has a frontend",
+	"frontend.features":                                 "Features contains optional frontend features",
+	"frontend.features.dark_mode":                       "DarkMode enables dark mode support",
+	"frontend.features.i18n":                            "I18n enables internationalization",
+	"frontend.features.pwa":                             "PWA enables progressive web app features",
+	"frontend.features.ssg":                             "SSG enables static site generation",
+	"frontend.features.ssr":                             "SSR enables server-side rendering",
+	"frontend.features.storybook":                       "Storybook enables Storybook for component development",
+	"frontend.formatter":                                "Formatter is the code formatter (prettier, biome)",
+	"frontend.framework":                                "Framework is the frontend framework (react, vue, svelte, angular, nextjs)",
+	"frontend.framework_version":                        "FrameworkVersion is the framework version",
+	"frontend.linter":                                   "Linter is the linting tool (eslint, biome)",
+	"frontend.node_version":                             "NodeVersion is the Node.js major version used by Docker, CI and engines",
+	"frontend.package_manager":                          "PackageManager is the package manager (npm, yarn, pnpm, bun)",
+	"frontend.styling":                                  "Styling is the styling approach (tailwind, css-modules, styled-components, scss)",
+	"frontend.test_framework":                           "TestFramework is the testing framework (jest, vitest, playwright, cypress)",
+	"frontend.typescript":                               "TypeScript indicates if TypeScript is used",
+	"governance":                                        "Governance contains AI governance and compliance settings",
+	"governance.assistant_targets":                      "AssistantTargets lists the AI assistants to write instructions for (cursor, copilot, claude)",
+	"governance.brainstorm_md":                          "BrainstormMd indicates if Brainstorm.md is generated",
+	"governance.component_registry":                     "ComponentRegistry indicates if component registry is maintained",
+	"governance.context_level":                          "ContextLevel is the AI context detail level (minimal, standard, comprehensive)",
+	"governance.documentation":                          "Documentation contains documentation standards",
+	"governance.documentation.api":                      "API indicates if API documentation is generated",
+	"governance.documentation.changelog":                "Changelog indicates if CHANGELOG.md is generated",
+	"governance.documentation.contributing":             "Contributing indicates if CONTRIBUTING.md is generated",
+	"governance.documentation.format":                   "Format is the documentation format (markdown, restructuredtext)",
+	"governance.documentation.inline":                   "Inline indicates if inline code documentation is enforced",
+	"governance.documentation.readme":                   "README indicates if README.md is generated",
+	"governance.enabled":                                "Enabled indicates if governance features are enabled",
+	"governance.prompt_guidelines":                      "PromptGuidelines indicates if AI prompt guidelines are generated",
+	"governance.rules":                                  "Rules contains governance rules configuration",
+	"governance.rules.custom_rules_path":                "CustomRulesPath is the path to custom rules file, relative to the config file",
+	"governance.rules.enabled":                          "Enabled indicates if rules enforcement is enabled",
+	"governance.rules.exclude_patterns":                 "ExcludePatterns contains glob patterns for files to exclude from governance",
+	"governance.rules.rules":                            "Rules contains specific rule configurations",
+	"governance.rules.strict_mode":                      "StrictMode enables strict rule enforcement (fails on warnings)",
+	"infrastructure":                                    "Infrastructure contains deployment and infrastructure configuration",
+	"infrastructure.cdn":                                "CDN indicates if a CDN is used",
+	"infrastructure.ci":                                 "CI is the CI/CD platform (github-actions, gitlab-ci, circleci, jenkins)",
+	"infrastructure.docker":                             "Docker indicates if Docker is used",
+	"infrastructure.docker_compose":                     "DockerCompose indicates if Docker Compose is used for local development",
+	"infrastructure.hosting":                            "Hosting is the hosting platform (vercel, netlify, aws, gcp, azure, self-hosted)",
+	"infrastructure.kubernetes":                         "Kubernetes indicates if Kubernetes manifests are generated",
+	"infrastructure.monitoring":                         "Monitoring contains monitoring configuration",
+	"infrastructure.monitoring.enabled":                 "Enabled indicates if monitoring is enabled",
+	"infrastructure.monitoring.error_tracking":          "ErrorTracking indicates if error tracking is enabled",
+	"infrastructure.monitoring.error_tracking_provider": "ErrorTrackingProvider is the error tracking provider (sentry, rollbar)",
+	"infrastructure.monitoring.logging":                 "Logging contains logging configuration",
+	"infrastructure.monitoring.logging.format":          "Format is the log format (json, text)",
+	"infrastructure.monitoring.logging.level":           "Level is the log level (debug, info, warn, error)",
+	"infrastructure.monitoring.logging.provider":        "Provider is the logging provider (none, datadog, cloudwatch, stackdriver)",
+	"infrastructure.monitoring.provider":                "Provider is the monitoring provider (datadog, newrelic, prometheus, grafana)",
+	"metadata":                                          "Metadata contains project identification information",
+	"metadata.author":                                   "Author is the project author or team",
+	"metadata.clause_version":                           "ClauseVersion is the version of Clause used to create the project",
+	"metadata.created_at":                               "CreatedAt is when the project was created",
+	"metadata.description":                              "Description is a brief project description",
+	"metadata.keywords":                                 "Keywords are searchable project keywords",
+	"metadata.license":                                  "License is the project license",
+	"metadata.name":                                     "Name is the project name",
+	"metadata.repository":                               "Repository is the git repository URL",
+	"metadata.updated_at":                               "UpdatedAt is when the configuration was last modified",
+	"metadata.version":                                  "Version is the current project version",
+	"variables":                                         "Variables are custom values available to templates as .Vars",
+	"version":                                           "Version is the configuration schema version",
+}
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFieldDocFor(t *testing.T) {
+	tests := []struct {
+		path        string
+		wantType    string
+		wantDefault interface{}
+		wantDesc    string
+	}{
+		{"frontend.framework", "string", "react", "Framework is the frontend framework (react, vue, svelte, angular, nextjs)"},
+		{"frontend.node_version", "string", "20", "NodeVersion is the Node.js major version used by Docker, CI and engines"},
+		{"frontend.enabled", "bool", nil, "Enabled indicates if the project has a frontend"},
+		{"metadata.name", "string", nil, "Name is the project name"},
+		{"metadata.created_at", "time.Time", nil, "CreatedAt is when the project was created"},
+		{"variables", "map[string]string", nil, "Variables are custom values available to templates as .Vars"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			doc, ok := FieldDocFor(tt.path)
+			if !ok {
+				t.Fatalf("no field doc for %s", tt.path)
+			}
+			if doc.Path != tt.path {
+				t.Errorf("path = %q, want %q", doc.Path, tt.path)
+			}
+			if doc.Type != tt.wantType {
+				t.Errorf("type = %q, want %q", doc.Type, tt.wantType)
+			}
+			if !reflect.DeepEqual(doc.Default, tt.wantDefault) {
+				t.Errorf("default = %#v, want %#v", doc.Default, tt.wantDefault)
+			}
+			if doc.Description != tt.wantDesc {
+				t.Errorf("description = %q, want %q", doc.Description, tt.wantDesc)
+			}
+		})
+	}
+
+	if _, ok := FieldDocFor("frontend"); ok {
+		t.Error("struct fields should not have their own field doc")
+	}
+}
+
+func TestFieldDocsComplete(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, doc := range FieldDocs() {
+		if seen[doc.Path] {
+			t.Errorf("duplicate field doc for %s", doc.Path)
+		}
+		seen[doc.Path] = true
+
+		if doc.Type == "" {
+			t.Errorf("%s has no type", doc.Path)
+		}
+		if doc.Description == "" {
+			t.Errorf("%s has no description; run go generate in internal/config", doc.Path)
+		}
+	}
+}
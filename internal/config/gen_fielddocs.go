@@ -0,0 +1,95 @@
+//go:build ignore
+
+// gen_fielddocs generates fielddocs_gen.go from the field comments in
+// config.go. Run it with go generate after changing the config structs.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "config.go", nil, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	structs := make(map[string]*ast.StructType)
+	ast.Inspect(file, func(n ast.Node) bool {
+		if spec, ok := n.(*ast.TypeSpec); ok {
+			if st, ok := spec.Type.(*ast.StructType); ok {
+				structs[spec.Name.Name] = st
+			}
+		}
+		return true
+	})
+
+	docs := make(map[string]string)
+	collect(structs, structs["ProjectConfig"], "", docs)
+
+	paths := make([]string, 0, len(docs))
+	for path := range docs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_fielddocs.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package config\n\n")
+	buf.WriteString("// fieldComments maps field paths to the doc comments in config.go.\n")
+	buf.WriteString("var fieldComments = map[string]string{\n")
+	for _, path := range paths {
+		fmt.Fprintf(&buf, "\t%s: %s,\n", strconv.Quote(path), strconv.Quote(docs[path]))
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("fielddocs_gen.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// collect records the doc comment of every field, recursing into struct fields.
+func collect(structs map[string]*ast.StructType, st *ast.StructType, prefix string, docs map[string]string) {
+	if st == nil {
+		return
+	}
+
+	for _, field := range st.Fields.List {
+		if field.Tag == nil || len(field.Names) == 0 {
+			continue
+		}
+
+		tag, _ := strconv.Unquote(field.Tag.Value)
+		name := strings.Split(reflect.StructTag(tag).Get("yaml"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+
+		docs[path] = strings.Join(strings.Fields(field.Doc.Text()), " ")
+
+		if ident, ok := field.Type.(*ast.Ident); ok {
+			collect(structs, structs[ident.Name], path, docs)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+
+	"github.com/clause-cli/clause/pkg/utils"
+)
+
+// DeleteConfigKeyInPlace removes the key at the dot-notation keyPath from the
+// YAML or TOML file at path. A YAML file is edited as a node tree rather
+// than re-serialized from ProjectConfig, so comments and the order of the
+// remaining keys are kept. A TOML file is decoded and re-encoded without the
+// key, which keeps its values but not its comments.
+func DeleteConfigKeyInPlace(path, keyPath string) error {
+	release, err := utils.AcquireLock(path)
+	if err != nil {
+		return fmt.Errorf("failed to lock config: %w", err)
+	}
+	defer release()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+	case ".toml":
+		return deleteTOMLKey(path, data, keyPath)
+	default:
+		return fmt.Errorf("cannot edit %s in place: only YAML and TOML config files are supported", path)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("failed to parse config file: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return fmt.Errorf("key not found: %s", keyPath)
+	}
+
+	if !deleteNodeKey(doc.Content[0], strings.Split(keyPath, ".")) {
+		return fmt.Errorf("key not found: %s", keyPath)
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(yamlIndent(data))
+	if err := encoder.Encode(&doc); err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+
+	return utils.AtomicWrite(path, buf.Bytes())
+}
+
+// deleteTOMLKey removes the key at keyPath from TOML data and writes the
+// result to path.
+func deleteTOMLKey(path string, data []byte, keyPath string) error {
+	var m map[string]interface{}
+	if err := toml.Unmarshal(data, &m); err != nil {
+		return fmt.Errorf("failed to parse config file: %w", err)
+	}
+
+	parts := strings.Split(keyPath, ".")
+	table := m
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := table[part].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("key not found: %s", keyPath)
+		}
+		table = next
+	}
+	if _, ok := table[parts[len(parts)-1]]; !ok {
+		return fmt.Errorf("key not found: %s", keyPath)
+	}
+	delete(table, parts[len(parts)-1])
+
+	out, err := toml.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+	return utils.AtomicWrite(path, out)
+}
+
+// deleteNodeKey removes the key at parts from a mapping node, reporting
+// whether it was found.
+func deleteNodeKey(node *yaml.Node, parts []string) bool {
+	if node.Kind != yaml.MappingNode {
+		return false
+	}
+
+	// Mapping content alternates key and value nodes
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value != parts[0] {
+			continue
+		}
+		if len(parts) > 1 {
+			return deleteNodeKey(node.Content[i+1], parts[1:])
+		}
+		node.Content = append(node.Content[:i], node.Content[i+2:]...)
+		return true
+	}
+	return false
+}
+
+// yamlIndent returns the indentation width of the first indented line, so
+// an edited file keeps its original style. It defaults to two spaces.
+func yamlIndent(data []byte) int {
+	for _, line := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimLeft(line, " ")
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "- ") {
+			continue
+		}
+		if indent := len(line) - len(trimmed); indent > 0 {
+			return indent
+		}
+	}
+	return 2
+}
@@ -0,0 +1,154 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+const commentedYAML = `# Project config
+metadata:
+  name: demo # project name
+backend:
+  # The web framework
+  framework: fastapi
+  database:
+    # Primary database
+    primary: postgresql
+    # Object-relational mapper
+    orm: sqlalchemy
+`
+
+func TestDeleteConfigKeyInPlace(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		key      string
+		wantErr  bool
+		keep     []string
+		removed  []string
+		checkKey func(t *testing.T, data []byte)
+	}{
+		{
+			name:    "nested yaml key",
+			file:    "config.yaml",
+			content: commentedYAML,
+			key:     "backend.database.orm",
+			keep: []string{
+				"# Project config",
+				"name: demo # project name",
+				"# The web framework",
+				"framework: fastapi",
+				"# Primary database",
+				"primary: postgresql",
+			},
+			removed: []string{"orm: sqlalchemy"},
+			checkKey: func(t *testing.T, data []byte) {
+				var m map[string]map[string]interface{}
+				if err := yaml.Unmarshal(data, &m); err != nil {
+					t.Fatalf("edited file does not parse: %v", err)
+				}
+				db, _ := m["backend"]["database"].(map[string]interface{})
+				if db["primary"] != "postgresql" {
+					t.Errorf("database.primary = %v, want postgresql", db["primary"])
+				}
+				if _, ok := db["orm"]; ok {
+					t.Error("database.orm still present")
+				}
+			},
+		},
+		{
+			name:    "top-level yaml key",
+			file:    "config.yml",
+			content: commentedYAML,
+			key:     "metadata",
+			keep:    []string{"# The web framework", "orm: sqlalchemy"},
+			removed: []string{"name: demo"},
+		},
+		{
+			name:    "nested toml key",
+			file:    "config.toml",
+			content: "[backend]\nframework = \"fastapi\"\n\n[backend.database]\nprimary = \"postgresql\"\norm = \"sqlalchemy\"\n",
+			key:     "backend.database.orm",
+			removed: []string{"sqlalchemy"},
+			checkKey: func(t *testing.T, data []byte) {
+				var m map[string]interface{}
+				if err := toml.Unmarshal(data, &m); err != nil {
+					t.Fatalf("edited file does not parse: %v", err)
+				}
+				backend := m["backend"].(map[string]interface{})
+				if backend["framework"] != "fastapi" {
+					t.Errorf("backend.framework = %v, want fastapi", backend["framework"])
+				}
+			},
+		},
+		{
+			name:    "missing yaml key",
+			file:    "config.yaml",
+			content: commentedYAML,
+			key:     "backend.database.cache",
+			wantErr: true,
+		},
+		{
+			name:    "missing toml key",
+			file:    "config.toml",
+			content: "[backend]\nframework = \"fastapi\"\n",
+			key:     "backend.orm",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported format",
+			file:    "config.json",
+			content: `{"backend": {"framework": "fastapi"}}`,
+			key:     "backend.framework",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			err := DeleteConfigKeyInPlace(path, tt.key)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				data, _ := os.ReadFile(path)
+				if string(data) != tt.content {
+					t.Error("file changed despite the error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, s := range tt.keep {
+				if !strings.Contains(string(data), s) {
+					t.Errorf("edited file lost %q:\n%s", s, data)
+				}
+			}
+			for _, s := range tt.removed {
+				if strings.Contains(string(data), s) {
+					t.Errorf("edited file still contains %q:\n%s", s, data)
+				}
+			}
+			if tt.checkKey != nil {
+				tt.checkKey(t, data)
+			}
+		})
+	}
+}
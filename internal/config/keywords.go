@@ -0,0 +1,72 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clause-cli/clause/pkg/utils"
+)
+
+const (
+	// MaxKeywords is the number of keywords package registries commonly
+	// accept.
+	MaxKeywords = 10
+
+	// MaxKeywordLength is the longest keyword, in characters, that package
+	// registries commonly accept.
+	MaxKeywordLength = 50
+)
+
+// NormalizeKeywords returns keywords in the form package registries expect:
+// lowercase kebab-case, so "My Keyword" becomes "my-keyword". Keywords that
+// are empty after normalization and repeated keywords are dropped.
+func NormalizeKeywords(keywords []string) []string {
+	normalized := make([]string, 0, len(keywords))
+	seen := make(map[string]bool, len(keywords))
+	for _, keyword := range keywords {
+		keyword = utils.KebabCase(strings.ToLower(strings.TrimSpace(keyword)))
+		if keyword == "" || seen[keyword] {
+			continue
+		}
+		seen[keyword] = true
+		normalized = append(normalized, keyword)
+	}
+	return normalized
+}
+
+// validateKeywords warns about keyword lists that package registries would
+// reject: too many keywords, keywords that are too long, and keywords with
+// uppercase letters or spaces.
+func validateKeywords(keywords []string) ValidationErrors {
+	var errors ValidationErrors
+
+	if len(keywords) > MaxKeywords {
+		errors = append(errors, ValidationError{
+			Field:    "metadata.keywords",
+			Message:  fmt.Sprintf("too many keywords: %d (most registries accept at most %d)", len(keywords), MaxKeywords),
+			Value:    len(keywords),
+			Severity: "warning",
+		})
+	}
+
+	for _, keyword := range keywords {
+		if len(keyword) > MaxKeywordLength {
+			errors = append(errors, ValidationError{
+				Field:    "metadata.keywords",
+				Message:  fmt.Sprintf("keyword is longer than %d characters", MaxKeywordLength),
+				Value:    keyword,
+				Severity: "warning",
+			})
+		}
+		if strings.ToLower(keyword) != keyword || strings.ContainsAny(keyword, " \t") {
+			errors = append(errors, ValidationError{
+				Field:    "metadata.keywords",
+				Message:  fmt.Sprintf("keyword should be lowercase without spaces, e.g. %q", utils.KebabCase(strings.ToLower(keyword))),
+				Value:    keyword,
+				Severity: "warning",
+			})
+		}
+	}
+
+	return errors
+}
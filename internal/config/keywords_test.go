@@ -0,0 +1,77 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeKeywords(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+		want  []string
+	}{
+		{"spaces and case", []string{"My Keyword"}, []string{"my-keyword"}},
+		{"already normalized", []string{"cli", "code-generator"}, []string{"cli", "code-generator"}},
+		{"surrounding whitespace", []string{"  Go  "}, []string{"go"}},
+		{"duplicates after normalizing", []string{"web app", "Web App", "web-app"}, []string{"web-app"}},
+		{"empty entries dropped", []string{"", "  ", "api"}, []string{"api"}},
+		{"none", nil, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeKeywords(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NormalizeKeywords(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateKeywords(t *testing.T) {
+	tooMany := make([]string, MaxKeywords+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("keyword-%d", i)
+	}
+
+	tests := []struct {
+		name     string
+		keywords []string
+		want     []string
+	}{
+		{"valid", []string{"cli", "scaffolding"}, nil},
+		{"at the limit", tooMany[:MaxKeywords], nil},
+		{"over the limit", tooMany, []string{"too many keywords"}},
+		{"too long", []string{strings.Repeat("a", MaxKeywordLength+1)}, []string{"longer than"}},
+		{"uppercase", []string{"Golang"}, []string{`"golang"`}},
+		{"spaces", []string{"my keyword"}, []string{`"my-keyword"`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newDefaultConfig()
+			cfg.Metadata.Name = "demo"
+			cfg.Metadata.Keywords = tt.keywords
+
+			var got ValidationErrors
+			for _, err := range NewValidator().validateMetadata(&cfg.Metadata) {
+				if err.Field == "metadata.keywords" {
+					got = append(got, err)
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d keyword issues %v, want %d", len(got), got, len(tt.want))
+			}
+			for i, err := range got {
+				if err.Severity != "warning" {
+					t.Errorf("severity = %q, want warning", err.Severity)
+				}
+				if !strings.Contains(err.Message, tt.want[i]) {
+					t.Errorf("message %q does not contain %q", err.Message, tt.want[i])
+				}
+			}
+		})
+	}
+}
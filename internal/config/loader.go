@@ -1,12 +1,18 @@
 package config
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/pelletier/go-toml/v2"
 	"gopkg.in/yaml.v3"
 
 	"github.com/clause-cli/clause/pkg/utils"
@@ -16,9 +22,10 @@ import (
 // Priority order (highest to lowest):
 // 1. Explicit flags/options
 // 2. Environment variables
-// 3. Project configuration (.clause/config.yaml)
-// 4. Global configuration (~/.clause/config.yaml)
-// 5. Default values
+// 3. Profile overlay (.clause/config.<profile>.yaml)
+// 4. Project configuration (.clause/config.yaml)
+// 5. Global configuration (~/.clause/config.yaml)
+// 6. Default values
 type Loader struct {
 	// projectDir is the project directory path
 	projectDir string
@@ -31,6 +38,12 @@ type Loader struct {
 
 	// overrides contains explicit flag/option overrides
 	overrides map[string]interface{}
+
+	// profile is the name of the profile overlay to apply (e.g. "staging")
+	profile string
+
+	// unknownEnvVars lists prefixed variables the last Load could not apply
+	unknownEnvVars []string
 }
 
 // LoaderOption is a functional option for configuring the Loader.
@@ -64,6 +77,14 @@ func WithOverrides(overrides map[string]interface{}) LoaderOption {
 	}
 }
 
+// WithProfile sets the profile overlay to apply on top of the project config.
+// The overlay is read from .clause/config.<profile>.yaml.
+func WithProfile(profile string) LoaderOption {
+	return func(l *Loader) {
+		l.profile = profile
+	}
+}
+
 // NewLoader creates a new configuration loader with the given options.
 func NewLoader(opts ...LoaderOption) *Loader {
 	home := utils.GetHomeDirectory()
@@ -81,53 +102,129 @@ func NewLoader(opts ...LoaderOption) *Loader {
 	return l
 }
 
+// defaultsTemplate returns the default configuration, built once and cloned
+// by newDefaultConfig so repeated loads do not rebuild it.
+var defaultsTemplate = sync.OnceValue(NewProjectConfig)
+
+// newDefaultConfig returns an independent copy of the default configuration
+// with fresh timestamps.
+func newDefaultConfig() *ProjectConfig {
+	config := defaultsTemplate().Clone()
+	now := time.Now()
+	config.Metadata.CreatedAt = now
+	config.Metadata.UpdatedAt = now
+	return config
+}
+
 // Load loads configuration from all sources and merges them.
 func (l *Loader) Load() (*ProjectConfig, error) {
+	return l.LoadContext(context.Background())
+}
+
+// LoadContext is like Load but stops between sources once ctx is done. The
+// returned error wraps ctx.Err(), so callers can check for
+// context.Canceled or context.DeadlineExceeded with errors.Is.
+func (l *Loader) LoadContext(ctx context.Context) (*ProjectConfig, error) {
+	// checkpoint reports a done context before the named step
+	checkpoint := func(step string) error {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("config load stopped before %s: %w", step, err)
+		}
+		return nil
+	}
+
 	// Start with defaults
-	config := NewProjectConfig()
+	config := newDefaultConfig()
 
 	// Load global configuration (lowest priority)
+	if err := checkpoint("global config"); err != nil {
+		return nil, err
+	}
 	if err := l.loadGlobalConfig(config); err != nil && !os.IsNotExist(err) {
 		return nil, fmt.Errorf("failed to load global config: %w", err)
 	}
 
 	// Load project configuration
+	if err := checkpoint("project config"); err != nil {
+		return nil, err
+	}
 	if err := l.loadProjectConfig(config); err != nil && !os.IsNotExist(err) {
 		return nil, fmt.Errorf("failed to load project config: %w", err)
 	}
 
+	// Apply the profile overlay
+	if err := checkpoint("profile overlay"); err != nil {
+		return nil, err
+	}
+	if err := l.loadProfileConfig(config); err != nil {
+		return nil, fmt.Errorf("failed to load profile %q: %w", l.profile, err)
+	}
+
 	// Apply environment variables
 	l.applyEnvVars(config)
 
 	// Apply explicit overrides (highest priority)
 	l.applyOverrides(config)
 
+	// Clear settings of disabled sections
+	config.ApplyEnablementCascade()
+
 	return config, nil
 }
 
 // LoadFromPath loads configuration from a specific file path.
 func (l *Loader) LoadFromPath(path string) (*ProjectConfig, error) {
-	config := NewProjectConfig()
-
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read config file: %w", err)
 	}
 
-	ext := strings.ToLower(filepath.Ext(path))
-	switch ext {
-	case ".yaml", ".yml":
+	config, err := parseConfig(data, strings.TrimPrefix(filepath.Ext(path), "."))
+	if err != nil {
+		return nil, err
+	}
+	resolveRulesPath(&config.Governance.Rules, path)
+	return config, nil
+}
+
+// LoadFromReader loads configuration from a reader such as stdin. The format
+// is "yaml", "yml", "json" or "toml", since a reader has no extension to infer it from.
+func (l *Loader) LoadFromReader(r io.Reader, format string) (*ProjectConfig, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	return parseConfig(data, format)
+}
+
+// parseConfig decodes data in the given format over the default configuration.
+func parseConfig(data []byte, format string) (*ProjectConfig, error) {
+	config := newDefaultConfig()
+
+	data, err := rewriteLegacyFields(data, format)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(format) {
+	case "yaml", "yml":
 		if err := yaml.Unmarshal(data, config); err != nil {
 			return nil, fmt.Errorf("failed to parse YAML config: %w", err)
 		}
-	case ".json":
+	case "json":
 		if err := json.Unmarshal(data, config); err != nil {
 			return nil, fmt.Errorf("failed to parse JSON config: %w", err)
 		}
+	case "toml":
+		if err := unmarshalTOML(data, config); err != nil {
+			return nil, fmt.Errorf("failed to parse TOML config: %w", err)
+		}
 	default:
-		return nil, fmt.Errorf("unsupported config format: %s", ext)
+		return nil, fmt.Errorf("unsupported config format: %s", format)
 	}
 
+	config.ApplyEnablementCascade()
 	return config, nil
 }
 
@@ -147,12 +244,18 @@ func (l *Loader) loadProjectConfig(config *ProjectConfig) error {
 	locations := []string{
 		filepath.Join(l.projectDir, ".clause", "config.yaml"),
 		filepath.Join(l.projectDir, ".clause", "config.yml"),
+		filepath.Join(l.projectDir, ".clause", "config.toml"),
 		filepath.Join(l.projectDir, "clause.yaml"),
 		filepath.Join(l.projectDir, "clause.yml"),
+		filepath.Join(l.projectDir, "clause.toml"),
 	}
 
 	for _, path := range locations {
 		if utils.FileExists(path) {
+			// Upgrade files written by an older version of Clause first
+			if _, err := MigrateConfigFile(path); err != nil {
+				return fmt.Errorf("failed to migrate %s: %w", path, err)
+			}
 			return l.mergeConfigFile(config, path)
 		}
 	}
@@ -160,6 +263,36 @@ func (l *Loader) loadProjectConfig(config *ProjectConfig) error {
 	return os.ErrNotExist
 }
 
+// ProfileConfigPath returns the path of a profile overlay within a project.
+func ProfileConfigPath(projectDir, profile string) string {
+	return filepath.Join(projectDir, ".clause", "config."+profile+".yaml")
+}
+
+// loadProfileConfig merges the profile overlay into the config. Unlike the
+// other sources, CORS origins from a profile are added to the base origins
+// rather than replacing them, so each environment only lists its extras.
+// Feature flags are merged one by one like every other source, so a profile
+// that only enables backend.features.metrics keeps the base's other flags.
+func (l *Loader) loadProfileConfig(config *ProjectConfig) error {
+	if l.profile == "" || l.projectDir == "" {
+		return nil
+	}
+
+	path := ProfileConfigPath(l.projectDir, l.profile)
+	if !utils.FileExists(path) {
+		return fmt.Errorf("profile config not found: %s", path)
+	}
+
+	baseOrigins := append([]string(nil), config.Backend.API.CORS.Origins...)
+
+	if err := l.mergeConfigFile(config, path); err != nil {
+		return err
+	}
+
+	config.Backend.API.CORS.Origins = unionStrings(baseOrigins, config.Backend.API.CORS.Origins)
+	return nil
+}
+
 // mergeConfigFile merges a configuration file into the existing config.
 func (l *Loader) mergeConfigFile(config *ProjectConfig, path string) error {
 	if !utils.FileExists(path) {
@@ -172,16 +305,85 @@ func (l *Loader) mergeConfigFile(config *ProjectConfig, path string) error {
 	}
 
 	// Parse as generic map first for partial updates
-	var partial map[string]interface{}
-	if err := yaml.Unmarshal(data, &partial); err != nil {
-		return fmt.Errorf("failed to parse config file: %w", err)
+	partial, err := decodeConfigMap(data, strings.TrimPrefix(filepath.Ext(path), "."))
+	if err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
 	}
+	renameLegacyFields(partial)
 
 	// Merge into config
-	return mergeMapIntoConfig(config, partial)
+	if err := mergeMapIntoConfig(config, partial); err != nil {
+		return err
+	}
+	if rules := lookupMap(partial, []string{"governance", "rules"}, false); rules != nil {
+		if _, ok := rules["custom_rules_path"]; ok {
+			resolveRulesPath(&config.Governance.Rules, path)
+		}
+	}
+	return nil
+}
+
+// resolveRulesPath makes a relative custom rules path relative to the
+// directory of the config file at configPath rather than to the working
+// directory.
+func resolveRulesPath(r *GovernanceRules, configPath string) {
+	if r.CustomRulesPath == "" {
+		return
+	}
+
+	rulesPath := utils.ResolvePortable(r.CustomRulesPath)
+	if !filepath.IsAbs(rulesPath) {
+		rulesPath = filepath.Join(filepath.Dir(configPath), rulesPath)
+	}
+	r.CustomRulesPath = rulesPath
 }
 
-// applyEnvVars applies environment variable overrides to the config.
+// decodeConfigMap decodes a config file in the given format into a generic
+// map. TOML integers are converted to int, as YAML decodes them, so the
+// merge functions see the same types for every format.
+func decodeConfigMap(data []byte, format string) (map[string]interface{}, error) {
+	var m map[string]interface{}
+	switch strings.ToLower(format) {
+	case "yaml", "yml":
+		if err := yaml.Unmarshal(data, &m); err != nil {
+			return nil, err
+		}
+	case "json":
+		if err := json.Unmarshal(data, &m); err != nil {
+			return nil, err
+		}
+	case "toml":
+		if err := toml.Unmarshal(data, &m); err != nil {
+			return nil, err
+		}
+		normalizeTOMLValue(m)
+	default:
+		return nil, fmt.Errorf("unsupported config format: %s", format)
+	}
+	return m, nil
+}
+
+// normalizeTOMLValue converts the int64 values go-toml decodes into int,
+// recursively, and returns the converted value.
+func normalizeTOMLValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case int64:
+		return int(v)
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = normalizeTOMLValue(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = normalizeTOMLValue(value)
+		}
+	}
+	return v
+}
+
+// applyEnvVars applies environment variable overrides to the config. The
+// explicit mappings below predate the generic per-field variables and are
+// kept for backward compatibility.
 func (l *Loader) applyEnvVars(config *ProjectConfig) {
 	envMappings := map[string]func(string){
 		"CLAUSE_FRONTEND_FRAMEWORK":      func(v string) { config.Frontend.Framework = v },
@@ -201,6 +403,8 @@ func (l *Loader) applyEnvVars(config *ProjectConfig) {
 		"CLAUSE_NO_COLOR":                func(v string) { /* handled elsewhere */ },
 	}
 
+	l.applyFieldEnvVars(config, envMappings)
+
 	for envKey, setter := range envMappings {
 		if value := os.Getenv(envKey); value != "" {
 			setter(value)
@@ -219,6 +423,14 @@ func (l *Loader) applyOverrides(config *ProjectConfig) {
 
 // mergeMapIntoConfig merges a generic map into a ProjectConfig struct.
 func mergeMapIntoConfig(config *ProjectConfig, m map[string]interface{}) error {
+	// Keep unrecognized top-level keys so they survive a save
+	for key, value := range extraKeys(m) {
+		if config.Extra == nil {
+			config.Extra = make(map[string]interface{})
+		}
+		config.Extra[key] = cloneExtraValue(value)
+	}
+
 	// Handle metadata
 	if metadata, ok := m["metadata"].(map[string]interface{}); ok {
 		if name, ok := metadata["name"].(string); ok {
@@ -252,6 +464,9 @@ func mergeMapIntoConfig(config *ProjectConfig, m map[string]interface{}) error {
 		if framework, ok := frontend["framework"].(string); ok {
 			config.Frontend.Framework = framework
 		}
+		if nodeVersion, ok := nodeVersionString(frontend["node_version"]); ok {
+			config.Frontend.NodeVersion = nodeVersion
+		}
 		if ts, ok := frontend["typescript"].(bool); ok {
 			config.Frontend.TypeScript = ts
 		}
@@ -345,6 +560,12 @@ func mergeMapIntoConfig(config *ProjectConfig, m map[string]interface{}) error {
 		if guidelines, ok := governance["prompt_guidelines"].(bool); ok {
 			config.Governance.PromptGuidelines = guidelines
 		}
+		if targets, ok := governance["assistant_targets"].([]interface{}); ok {
+			config.Governance.AssistantTargets = toStringSlice(targets)
+		}
+		if rules, ok := governance["rules"].(map[string]interface{}); ok {
+			mergeGovernanceRules(&config.Governance.Rules, rules)
+		}
 	}
 
 	// Handle development
@@ -360,11 +581,42 @@ func mergeMapIntoConfig(config *ProjectConfig, m map[string]interface{}) error {
 		}
 	}
 
+	// Handle variables, merged key by key
+	if vars, ok := m["variables"].(map[string]interface{}); ok {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		for key, value := range vars {
+			config.Variables[key] = fmt.Sprint(value)
+		}
+	}
+
 	return nil
 }
 
 // Helper functions for merging nested configs
 
+// unionStrings returns the items of a followed by the items of b that are
+// not already present.
+func unionStrings(a, b []string) []string {
+	if len(a) == 0 && len(b) == 0 {
+		return a
+	}
+
+	seen := make(map[string]bool, len(a)+len(b))
+	result := make([]string, 0, len(a)+len(b))
+	for _, item := range append(append([]string{}, a...), b...) {
+		if seen[item] {
+			continue
+		}
+		seen[item] = true
+		result = append(result, item)
+	}
+	return result
+}
+
+// mergeFrontendFeatures merges the feature flags in m, leaving flags that m
+// does not mention unchanged.
 func mergeFrontendFeatures(f *FrontendFeatures, m map[string]interface{}) {
 	if ssr, ok := m["ssr"].(bool); ok {
 		f.SSR = ssr
@@ -439,9 +691,11 @@ func mergeAPIConfig(a *APIConfig, m map[string]interface{}) {
 	}
 }
 
+// mergeBackendFeatures merges the feature flags and email provider in m,
+// leaving fields that m does not mention unchanged.
 func mergeBackendFeatures(f *BackendFeatures, m map[string]interface{}) {
-	if ws, ok := m["websocket"].(bool); ok {
-		f.WebSocket = ws
+	if websocket, ok := m["websocket"].(bool); ok {
+		f.WebSocket = websocket
 	}
 	if jobs, ok := m["background_jobs"].(bool); ok {
 		f.BackgroundJobs = jobs
@@ -452,8 +706,11 @@ func mergeBackendFeatures(f *BackendFeatures, m map[string]interface{}) {
 	if email, ok := m["email"].(bool); ok {
 		f.Email = email
 	}
-	if rateLimit, ok := m["rate_limiting"].(bool); ok {
-		f.RateLimiting = rateLimit
+	if provider, ok := m["email_provider"].(string); ok {
+		f.EmailProvider = provider
+	}
+	if rateLimiting, ok := m["rate_limiting"].(bool); ok {
+		f.RateLimiting = rateLimiting
 	}
 	if logging, ok := m["logging"].(bool); ok {
 		f.Logging = logging
@@ -489,6 +746,46 @@ func mergeMonitoringConfig(m *MonitoringConfig, cfg map[string]interface{}) {
 	}
 }
 
+func mergeGovernanceRules(r *GovernanceRules, m map[string]interface{}) {
+	if enabled, ok := m["enabled"].(bool); ok {
+		r.Enabled = enabled
+	}
+	if strict, ok := m["strict_mode"].(bool); ok {
+		r.StrictMode = strict
+	}
+	if path, ok := m["custom_rules_path"].(string); ok {
+		r.CustomRulesPath = utils.ResolvePortable(path)
+	}
+	if patterns, ok := m["exclude_patterns"].([]interface{}); ok {
+		r.ExcludePatterns = toStringSlice(patterns)
+	}
+	if rules, ok := m["rules"].(map[string]interface{}); ok {
+		if r.Rules == nil {
+			r.Rules = make(map[string]RuleConfig)
+		}
+		for name, value := range rules {
+			ruleMap, ok := value.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			rule, exists := r.Rules[name]
+			if !exists {
+				rule = RuleConfig{Enabled: true}
+			}
+			if enabled, ok := ruleMap["enabled"].(bool); ok {
+				rule.Enabled = enabled
+			}
+			if severity, ok := ruleMap["severity"].(string); ok {
+				rule.Severity = severity
+			}
+			if options, ok := ruleMap["options"].(map[string]interface{}); ok {
+				rule.Options = options
+			}
+			r.Rules[name] = rule
+		}
+	}
+}
+
 func mergeGitHooksConfig(g *GitHooksConfig, m map[string]interface{}) {
 	if preCommit, ok := m["pre_commit"].(bool); ok {
 		g.PreCommit = preCommit
@@ -527,6 +824,25 @@ func toStringSlice(slice []interface{}) []string {
 	return result
 }
 
+// nodeVersionString converts a node_version value to its string form. An
+// unquoted version such as 20 or 20.1 decodes as a number.
+func nodeVersionString(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case int:
+		return strconv.Itoa(v), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case uint64:
+		return strconv.FormatUint(v, 10), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	default:
+		return "", false
+	}
+}
+
 // parseBool parses a string to bool with common variations.
 func parseBool(s string) bool {
 	switch strings.ToLower(strings.TrimSpace(s)) {
@@ -543,8 +859,10 @@ func FindProjectConfig(dir string) (string, error) {
 	locations := []string{
 		".clause/config.yaml",
 		".clause/config.yml",
+		".clause/config.toml",
 		"clause.yaml",
 		"clause.yml",
+		"clause.toml",
 	}
 
 	for _, loc := range locations {
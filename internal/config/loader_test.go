@@ -0,0 +1,528 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMergeFrontendFeatures(t *testing.T) {
+	tests := []struct {
+		name    string
+		overlay map[string]interface{}
+		want    FrontendFeatures
+	}{
+		{"ssr", map[string]interface{}{"ssr": true}, FrontendFeatures{SSR: true}},
+		{"ssg", map[string]interface{}{"ssg": true}, FrontendFeatures{SSG: true}},
+		{"pwa", map[string]interface{}{"pwa": true}, FrontendFeatures{PWA: true}},
+		{"i18n", map[string]interface{}{"i18n": true}, FrontendFeatures{I18n: true}},
+		{"dark_mode", map[string]interface{}{"dark_mode": true}, FrontendFeatures{DarkMode: true}},
+		{"storybook", map[string]interface{}{"storybook": true}, FrontendFeatures{Storybook: true}},
+		{"wrong type ignored", map[string]interface{}{"ssr": "yes"}, FrontendFeatures{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var f FrontendFeatures
+			mergeFrontendFeatures(&f, tt.overlay)
+			if f != tt.want {
+				t.Errorf("got %+v, want %+v", f, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeFrontendFeaturesKeepsUnmentionedFlags(t *testing.T) {
+	f := FrontendFeatures{SSR: true, DarkMode: true}
+	mergeFrontendFeatures(&f, map[string]interface{}{"pwa": true, "ssr": "yes"})
+
+	want := FrontendFeatures{SSR: true, DarkMode: true, PWA: true}
+	if f != want {
+		t.Errorf("got %+v, want %+v", f, want)
+	}
+}
+
+func TestMergeBackendFeatures(t *testing.T) {
+	tests := []struct {
+		name    string
+		overlay map[string]interface{}
+		want    BackendFeatures
+	}{
+		{"websocket", map[string]interface{}{"websocket": true}, BackendFeatures{WebSocket: true}},
+		{"background_jobs", map[string]interface{}{"background_jobs": true}, BackendFeatures{BackgroundJobs: true}},
+		{"file_upload", map[string]interface{}{"file_upload": true}, BackendFeatures{FileUpload: true}},
+		{"email", map[string]interface{}{"email": true}, BackendFeatures{Email: true}},
+		{"email_provider", map[string]interface{}{"email_provider": "resend"}, BackendFeatures{EmailProvider: "resend"}},
+		{"rate_limiting", map[string]interface{}{"rate_limiting": true}, BackendFeatures{RateLimiting: true}},
+		{"logging", map[string]interface{}{"logging": true}, BackendFeatures{Logging: true}},
+		{"metrics", map[string]interface{}{"metrics": true}, BackendFeatures{Metrics: true}},
+		{"wrong type ignored", map[string]interface{}{"metrics": "true"}, BackendFeatures{}},
+		{"nil ignored", map[string]interface{}{"email": nil}, BackendFeatures{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var f BackendFeatures
+			mergeBackendFeatures(&f, tt.overlay)
+			if f != tt.want {
+				t.Errorf("got %+v, want %+v", f, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeBackendFeaturesKeepsUnmentionedFlags(t *testing.T) {
+	f := BackendFeatures{Logging: true, Email: true, EmailProvider: "smtp"}
+	mergeBackendFeatures(&f, map[string]interface{}{"metrics": true, "logging": false})
+
+	want := BackendFeatures{Metrics: true, Email: true, EmailProvider: "smtp"}
+	if f != want {
+		t.Errorf("got %+v, want %+v", f, want)
+	}
+}
+
+func TestParseConfigNumericNodeVersion(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		data   string
+		want   string
+	}{
+		{"yaml string", "yaml", "frontend:\n  node_version: \"20.11\"\n", "20.11"},
+		{"yaml int", "yaml", "frontend:\n  node_version: 20\n", "20"},
+		{"yaml float", "yaml", "frontend:\n  node_version: 20.1\n", "20.1"},
+		{"json int", "json", `{"frontend": {"node_version": 22}}`, "22"},
+		{"json float", "json", `{"frontend": {"node_version": 20.1}}`, "20.1"},
+		{"toml int", "toml", "[frontend]\nnode_version = 18\n", "18"},
+		{"toml float", "toml", "[frontend]\nnode_version = 20.1\n", "20.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := parseConfig([]byte(tt.data), tt.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.Frontend.NodeVersion != tt.want {
+				t.Errorf("got %q, want %q", cfg.Frontend.NodeVersion, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeMapIntoConfigNumericNodeVersion(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{"string", "20.11", "20.11"},
+		{"int", 20, "20"},
+		{"int64", int64(18), "18"},
+		{"float", 20.1, "20.1"},
+		{"wrong type ignored", true, newDefaultConfig().Frontend.NodeVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newDefaultConfig()
+			overlay := map[string]interface{}{"frontend": map[string]interface{}{"node_version": tt.value}}
+			if err := mergeMapIntoConfig(cfg, overlay); err != nil {
+				t.Fatal(err)
+			}
+			if cfg.Frontend.NodeVersion != tt.want {
+				t.Errorf("got %q, want %q", cfg.Frontend.NodeVersion, tt.want)
+			}
+		})
+	}
+}
+
+// writeProject creates a project directory holding the given files.
+func writeProject(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// loadProject loads the project in dir with an empty global config.
+func loadProject(t *testing.T, dir string, opts ...LoaderOption) (*ProjectConfig, error) {
+	t.Helper()
+
+	opts = append([]LoaderOption{WithProjectDir(dir), WithGlobalDir(t.TempDir())}, opts...)
+	return NewLoader(opts...).Load()
+}
+
+func TestProfileCORSOrigins(t *testing.T) {
+	base := `metadata:
+  name: demo
+backend:
+  enabled: true
+  api:
+    cors:
+      enabled: true
+      origins:
+        - https://example.com
+        - http://localhost:3000
+`
+
+	tests := []struct {
+		name    string
+		profile string
+		overlay string
+		want    []string
+	}{
+		{"no profile", "", "", []string{"https://example.com", "http://localhost:3000"}},
+		{
+			"staging adds an origin", "staging",
+			"backend:\n  api:\n    cors:\n      origins:\n        - https://staging.example.com\n",
+			[]string{"https://example.com", "http://localhost:3000", "https://staging.example.com"},
+		},
+		{
+			"duplicate origins kept once", "staging",
+			"backend:\n  api:\n    cors:\n      origins:\n        - https://example.com\n",
+			[]string{"https://example.com", "http://localhost:3000"},
+		},
+		{
+			"profile without origins", "prod",
+			"infrastructure:\n  hosting: aws\n",
+			[]string{"https://example.com", "http://localhost:3000"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := map[string]string{".clause/config.yaml": base}
+			if tt.profile != "" {
+				files[".clause/config."+tt.profile+".yaml"] = tt.overlay
+			}
+			dir := writeProject(t, files)
+
+			cfg, err := loadProject(t, dir, WithProfile(tt.profile))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := cfg.Backend.API.CORS.Origins; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got origins %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProfileFeatureFlags(t *testing.T) {
+	base := `metadata:
+  name: demo
+backend:
+  enabled: true
+  features:
+    logging: true
+    email: true
+    metrics: false
+frontend:
+  enabled: true
+  features:
+    dark_mode: true
+`
+
+	tests := []struct {
+		name         string
+		profile      string
+		overlay      string
+		wantBackend  BackendFeatures
+		wantDarkMode bool
+	}{
+		{"no profile", "", "", BackendFeatures{Logging: true, Email: true}, true},
+		{
+			"prod enables metrics", "prod",
+			"backend:\n  features:\n    metrics: true\n",
+			BackendFeatures{Logging: true, Email: true, Metrics: true}, true,
+		},
+		{
+			"prod disables one flag", "prod",
+			"backend:\n  features:\n    email: false\nfrontend:\n  features:\n    pwa: true\n",
+			BackendFeatures{Logging: true}, true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := map[string]string{".clause/config.yaml": base}
+			if tt.profile != "" {
+				files[".clause/config."+tt.profile+".yaml"] = tt.overlay
+			}
+			dir := writeProject(t, files)
+
+			cfg, err := loadProject(t, dir, WithProfile(tt.profile))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := cfg.Backend.Features
+			if got.Logging != tt.wantBackend.Logging || got.Email != tt.wantBackend.Email || got.Metrics != tt.wantBackend.Metrics {
+				t.Errorf("backend features = %+v, want logging %v, email %v, metrics %v",
+					got, tt.wantBackend.Logging, tt.wantBackend.Email, tt.wantBackend.Metrics)
+			}
+			if cfg.Frontend.Features.DarkMode != tt.wantDarkMode {
+				t.Errorf("dark_mode = %v, want %v", cfg.Frontend.Features.DarkMode, tt.wantDarkMode)
+			}
+		})
+	}
+}
+
+func TestLoadVariables(t *testing.T) {
+	base := "metadata:\n  name: demo\nvariables:\n  company: Acme\n  api_url: https://api.acme.dev\n"
+
+	tests := []struct {
+		name    string
+		profile string
+		overlay string
+		want    map[string]string
+	}{
+		{"project file", "", "", map[string]string{"company": "Acme", "api_url": "https://api.acme.dev"}},
+		{
+			"profile overrides one key", "staging",
+			"variables:\n  api_url: https://staging.acme.dev\n",
+			map[string]string{"company": "Acme", "api_url": "https://staging.acme.dev"},
+		},
+		{
+			"profile adds a key", "staging",
+			"variables:\n  region: eu\n",
+			map[string]string{"company": "Acme", "api_url": "https://api.acme.dev", "region": "eu"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := map[string]string{".clause/config.yaml": base}
+			if tt.profile != "" {
+				files[".clause/config."+tt.profile+".yaml"] = tt.overlay
+			}
+			dir := writeProject(t, files)
+
+			cfg, err := loadProject(t, dir, WithProfile(tt.profile))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(cfg.Variables, tt.want) {
+				t.Errorf("got variables %v, want %v", cfg.Variables, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFromReader(t *testing.T) {
+	documents := map[string]string{
+		"yaml": "metadata:\n  name: demo\nfrontend:\n  framework: vue\nbackend:\n  enabled: true\n  framework: go-gin\n  auth:\n    methods:\n      - email\n      - github\n",
+		"json": `{"metadata": {"name": "demo"}, "frontend": {"framework": "vue"},
+			"backend": {"enabled": true, "framework": "go-gin", "auth": {"methods": ["email", "github"]}}}`,
+		"toml": "[metadata]\nname = \"demo\"\n\n[frontend]\nframework = \"vue\"\n\n[backend]\nenabled = true\nframework = \"go-gin\"\n\n[backend.auth]\nmethods = [\"email\", \"github\"]\n",
+	}
+
+	want, err := NewLoader().LoadFromReader(strings.NewReader(documents["yaml"]), "yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want.Metadata.Name != "demo" || want.Frontend.Framework != "vue" || !want.Backend.Enabled ||
+		!reflect.DeepEqual(want.Backend.Auth.Methods, []string{"email", "github"}) {
+		t.Fatalf("YAML document loaded as %+v", want)
+	}
+
+	tests := []struct {
+		name    string
+		format  string
+		data    string
+		wantErr bool
+	}{
+		{"json", "json", documents["json"], false},
+		{"toml", "toml", documents["toml"], false},
+		{"yml", "yml", documents["yaml"], false},
+		{"upper case format", "JSON", documents["json"], false},
+		{"unsupported format", "ini", documents["yaml"], true},
+		{"malformed json", "json", `{"metadata": `, true},
+		{"json parsed as toml", "toml", documents["json"], true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewLoader().LoadFromReader(strings.NewReader(tt.data), tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadFromReader error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(withoutTimestamps(got), withoutTimestamps(want)) {
+				t.Errorf("%s config differs from the YAML one:\n%+v\nwant\n%+v", tt.format, got, want)
+			}
+		})
+	}
+}
+
+func TestLoadsDoNotShareMemory(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+	}{
+		{"defaults only", map[string]string{".clause/config.yaml": "metadata:\n  name: demo\n"}},
+		{"project lists and maps", map[string]string{".clause/config.yaml": `metadata:
+  name: demo
+  keywords: [a, b]
+backend:
+  api:
+    cors:
+      origins: ["http://localhost:3000"]
+development:
+  scripts:
+    dev: make dev
+`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := writeProject(t, tt.files)
+			first, err := loadProject(t, dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			second, err := loadProject(t, dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertNoSharedMemory(t, "config", reflect.ValueOf(first).Elem(), reflect.ValueOf(second).Elem())
+		})
+	}
+}
+
+// assertNoSharedMemory fails if a and b hold a non-empty slice or map
+// backed by the same memory anywhere in their structure.
+func assertNoSharedMemory(t *testing.T, path string, a, b reflect.Value) {
+	t.Helper()
+
+	switch a.Kind() {
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if !a.Type().Field(i).IsExported() {
+				continue
+			}
+			assertNoSharedMemory(t, path+"."+a.Type().Field(i).Name, a.Field(i), b.Field(i))
+		}
+	case reflect.Slice:
+		if a.Len() > 0 && b.Len() > 0 && a.Pointer() == b.Pointer() {
+			t.Errorf("%s shares its backing array between loads", path)
+		}
+	case reflect.Map:
+		if a.Len() > 0 && b.Len() > 0 && a.Pointer() == b.Pointer() {
+			t.Errorf("%s shares its map between loads", path)
+		}
+	case reflect.Ptr, reflect.Interface:
+		if !a.IsNil() && !b.IsNil() {
+			assertNoSharedMemory(t, path, a.Elem(), b.Elem())
+		}
+	}
+}
+
+func BenchmarkLoad(b *testing.B) {
+	dir := b.TempDir()
+	loader := NewLoader(WithProjectDir(dir), WithGlobalDir(b.TempDir()))
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := loader.Load(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkNewProjectConfig(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		NewProjectConfig()
+	}
+}
+
+func BenchmarkNewDefaultConfig(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		newDefaultConfig()
+	}
+}
+
+// cancelAfter is a context that reports context.Canceled once Err has been
+// called checks times, to cancel a load before a given step.
+type cancelAfter struct {
+	context.Context
+	checks int
+}
+
+func (c *cancelAfter) Err() error {
+	if c.checks <= 0 {
+		return context.Canceled
+	}
+	c.checks--
+	return nil
+}
+
+func TestLoadContextCancel(t *testing.T) {
+	tests := []struct {
+		name   string
+		checks int
+		want   string
+	}{
+		{"before global config", 0, "before global config"},
+		{"before project config", 1, "before project config"},
+		{"before profile overlay", 2, "before profile overlay"},
+		{"not cancelled", 3, ""},
+	}
+
+	dir := writeProject(t, map[string]string{".clause/config.yaml": "metadata:\n  name: demo\n"})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &cancelAfter{Context: context.Background(), checks: tt.checks}
+			loader := NewLoader(WithProjectDir(dir), WithGlobalDir(t.TempDir()))
+
+			cfg, err := loader.LoadContext(ctx)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if cfg.Metadata.Name != "demo" {
+					t.Errorf("name = %q, want demo", cfg.Metadata.Name)
+				}
+				return
+			}
+
+			if !errors.Is(err, context.Canceled) {
+				t.Fatalf("error = %v, want context.Canceled", err)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error %q does not name the step %q", err, tt.want)
+			}
+			if cfg != nil {
+				t.Error("a cancelled load returned a config")
+			}
+		})
+	}
+
+	t.Run("deadline exceeded", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 0)
+		defer cancel()
+
+		_, err := NewLoader(WithProjectDir(dir), WithGlobalDir(t.TempDir())).LoadContext(ctx)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("error = %v, want context.DeadlineExceeded", err)
+		}
+	})
+}
@@ -0,0 +1,225 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MergeStrategy determines how conflicting changes are resolved.
+type MergeStrategy string
+
+const (
+	// MergeOurs keeps our value when both sides changed a field.
+	MergeOurs MergeStrategy = "ours"
+
+	// MergeTheirs keeps their value when both sides changed a field.
+	MergeTheirs MergeStrategy = "theirs"
+
+	// MergeUnion combines list values from both sides and keeps our
+	// value for other conflicting fields.
+	MergeUnion MergeStrategy = "union"
+)
+
+// Conflict describes a field that was changed differently on both sides.
+type Conflict struct {
+	// Field is the dot-notation path of the conflicting field
+	Field string `json:"field"`
+
+	// Base is the value in the common ancestor
+	Base interface{} `json:"base,omitempty"`
+
+	// Ours is the value on our side
+	Ours interface{} `json:"ours,omitempty"`
+
+	// Theirs is the value on their side
+	Theirs interface{} `json:"theirs,omitempty"`
+
+	// Resolved is the value chosen by the merge strategy
+	Resolved interface{} `json:"resolved,omitempty"`
+}
+
+// String returns a human-readable description of the conflict.
+func (c Conflict) String() string {
+	return fmt.Sprintf("%s: ours=%v theirs=%v (base=%v, using %v)", c.Field, c.Ours, c.Theirs, c.Base, c.Resolved)
+}
+
+// mergeIgnoredFields are fields that change on every save and never conflict.
+var mergeIgnoredFields = map[string]bool{
+	"metadata.updated_at": true,
+}
+
+// MergeConfigs performs a three-way merge of two configurations that share a
+// common ancestor. Fields changed on only one side take that side's value.
+// Fields changed differently on both sides are resolved using the strategy
+// and reported as conflicts.
+func MergeConfigs(base, ours, theirs *ProjectConfig, strategy MergeStrategy) (*ProjectConfig, []Conflict, error) {
+	baseMap, err := configToMap(base)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read base config: %w", err)
+	}
+	oursMap, err := configToMap(ours)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read our config: %w", err)
+	}
+	theirsMap, err := configToMap(theirs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read their config: %w", err)
+	}
+
+	var conflicts []Conflict
+	merged := mergeTree("", baseMap, oursMap, theirsMap, strategy, &conflicts)
+
+	result, err := mapToConfig(merged)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build merged config: %w", err)
+	}
+
+	return result, conflicts, nil
+}
+
+// configToMap converts a configuration into a generic map keyed by its YAML names.
+func configToMap(config *ProjectConfig) (map[string]interface{}, error) {
+	if config == nil {
+		return map[string]interface{}{}, nil
+	}
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	var m map[string]interface{}
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+	return m, nil
+}
+
+// mapToConfig converts a generic map back into a configuration.
+func mapToConfig(m map[string]interface{}) (*ProjectConfig, error) {
+	data, err := yaml.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	config := &ProjectConfig{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+	return config, nil
+}
+
+// mergeTree merges three nested maps key by key, recursing into maps and
+// treating every other value as a leaf.
+func mergeTree(prefix string, base, ours, theirs map[string]interface{}, strategy MergeStrategy, conflicts *[]Conflict) map[string]interface{} {
+	keys := make(map[string]bool)
+	for _, m := range []map[string]interface{}{base, ours, theirs} {
+		for k := range m {
+			keys[k] = true
+		}
+	}
+
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	result := make(map[string]interface{})
+	for _, key := range sorted {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		b, o, t := base[key], ours[key], theirs[key]
+
+		bm, bIsMap := asMap(b)
+		om, oIsMap := asMap(o)
+		tm, tIsMap := asMap(t)
+		if oIsMap && tIsMap && (bIsMap || b == nil) {
+			result[key] = mergeTree(path, bm, om, tm, strategy, conflicts)
+			continue
+		}
+
+		value := mergeLeaf(path, b, o, t, strategy, conflicts)
+		if value != nil {
+			result[key] = value
+		}
+	}
+
+	return result
+}
+
+// mergeLeaf merges a single value.
+func mergeLeaf(path string, base, ours, theirs interface{}, strategy MergeStrategy, conflicts *[]Conflict) interface{} {
+	oursChanged := !reflect.DeepEqual(base, ours)
+	theirsChanged := !reflect.DeepEqual(base, theirs)
+
+	switch {
+	case !theirsChanged:
+		return ours
+	case !oursChanged:
+		return theirs
+	case reflect.DeepEqual(ours, theirs):
+		return ours
+	case mergeIgnoredFields[path]:
+		return ours
+	}
+
+	var resolved interface{}
+	switch strategy {
+	case MergeTheirs:
+		resolved = theirs
+	case MergeUnion:
+		if list, ok := unionLists(ours, theirs); ok {
+			resolved = list
+		} else {
+			resolved = ours
+		}
+	default:
+		resolved = ours
+	}
+
+	*conflicts = append(*conflicts, Conflict{
+		Field:    path,
+		Base:     base,
+		Ours:     ours,
+		Theirs:   theirs,
+		Resolved: resolved,
+	})
+
+	return resolved
+}
+
+// asMap reports whether v is a nested map.
+func asMap(v interface{}) (map[string]interface{}, bool) {
+	m, ok := v.(map[string]interface{})
+	return m, ok
+}
+
+// unionLists combines two lists, preserving order and dropping duplicates.
+// It returns false if either value is not a list.
+func unionLists(a, b interface{}) ([]interface{}, bool) {
+	listA, okA := a.([]interface{})
+	listB, okB := b.([]interface{})
+	if (!okA && a != nil) || (!okB && b != nil) {
+		return nil, false
+	}
+
+	seen := make(map[string]bool)
+	var result []interface{}
+	for _, item := range append(append([]interface{}{}, listA...), listB...) {
+		key := strings.TrimSpace(fmt.Sprint(item))
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, item)
+	}
+	return result, true
+}
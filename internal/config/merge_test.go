@@ -0,0 +1,129 @@
+package config
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMergeConfigsClean(t *testing.T) {
+	base := DefaultConfig()
+	base.Metadata.Name = "demo"
+
+	ours := base.Clone()
+	ours.Frontend.Framework = "vue"
+	ours.Metadata.Keywords = []string{"shop"}
+
+	theirs := base.Clone()
+	theirs.Backend.Framework = "express"
+	theirs.Infrastructure.Docker = !base.Infrastructure.Docker
+
+	for _, strategy := range []MergeStrategy{MergeOurs, MergeTheirs, MergeUnion} {
+		t.Run(string(strategy), func(t *testing.T) {
+			merged, conflicts, err := MergeConfigs(base, ours, theirs, strategy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(conflicts) != 0 {
+				t.Fatalf("got conflicts %v for changes to different fields", conflicts)
+			}
+			if merged.Frontend.Framework != "vue" || merged.Backend.Framework != "express" {
+				t.Errorf("got frameworks %s/%s, want vue/express", merged.Frontend.Framework, merged.Backend.Framework)
+			}
+			if merged.Infrastructure.Docker != theirs.Infrastructure.Docker {
+				t.Error("their docker change was lost")
+			}
+			if !reflect.DeepEqual(merged.Metadata.Keywords, []string{"shop"}) {
+				t.Errorf("got keywords %v, want [shop]", merged.Metadata.Keywords)
+			}
+		})
+	}
+}
+
+func TestMergeConfigsConflict(t *testing.T) {
+	base := DefaultConfig()
+	base.Metadata.Keywords = []string{"web"}
+
+	ours := base.Clone()
+	ours.Frontend.Framework = "vue"
+	ours.Metadata.Keywords = []string{"web", "shop"}
+
+	theirs := base.Clone()
+	theirs.Frontend.Framework = "svelte"
+	theirs.Metadata.Keywords = []string{"web", "store"}
+
+	tests := []struct {
+		strategy  MergeStrategy
+		framework string
+		keywords  []string
+	}{
+		{MergeOurs, "vue", []string{"web", "shop"}},
+		{MergeTheirs, "svelte", []string{"web", "store"}},
+		{MergeUnion, "vue", []string{"web", "shop", "store"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.strategy), func(t *testing.T) {
+			merged, conflicts, err := MergeConfigs(base, ours, theirs, tt.strategy)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			fields := make(map[string]Conflict)
+			for _, c := range conflicts {
+				fields[c.Field] = c
+			}
+			if len(fields) != 2 {
+				t.Fatalf("got conflicts %v, want frontend.framework and metadata.keywords", conflicts)
+			}
+
+			c, ok := fields["frontend.framework"]
+			if !ok || c.Ours != "vue" || c.Theirs != "svelte" || c.Base != base.Frontend.Framework || c.Resolved != tt.framework {
+				t.Errorf("got framework conflict %+v", c)
+			}
+			if merged.Frontend.Framework != tt.framework {
+				t.Errorf("got framework %s, want %s", merged.Frontend.Framework, tt.framework)
+			}
+			if !reflect.DeepEqual(merged.Metadata.Keywords, tt.keywords) {
+				t.Errorf("got keywords %v, want %v", merged.Metadata.Keywords, tt.keywords)
+			}
+		})
+	}
+}
+
+func TestMergeConfigsSameChange(t *testing.T) {
+	base := DefaultConfig()
+	ours := base.Clone()
+	ours.Backend.Framework = "django"
+	theirs := ours.Clone()
+
+	merged, conflicts, err := MergeConfigs(base, ours, theirs, MergeOurs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conflicts) != 0 || merged.Backend.Framework != "django" {
+		t.Errorf("got %s with conflicts %v, want django and no conflicts", merged.Backend.Framework, conflicts)
+	}
+}
+
+// failingMarshaler is a value that cannot be encoded as YAML.
+type failingMarshaler struct{}
+
+func (failingMarshaler) MarshalYAML() (interface{}, error) {
+	return nil, errors.New("cannot encode")
+}
+
+func TestMergeConfigsError(t *testing.T) {
+	base := DefaultConfig()
+	ours := base.Clone()
+	theirs := base.Clone()
+	theirs.Extra = map[string]interface{}{"hook": failingMarshaler{}}
+
+	merged, conflicts, err := MergeConfigs(base, ours, theirs, MergeOurs)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if merged != nil || conflicts != nil {
+		t.Errorf("got %v and conflicts %v alongside the error", merged, conflicts)
+	}
+}
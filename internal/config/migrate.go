@@ -0,0 +1,423 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+
+	"github.com/clause-cli/clause/pkg/utils"
+)
+
+// migration upgrades a configuration to the layout of a schema version.
+type migration struct {
+	// version is the schema version the step upgrades to
+	version string
+
+	// description explains what the step changes
+	description string
+
+	// apply rewrites a loaded configuration in memory
+	apply func(*ProjectConfig) error
+
+	// rewrite makes the same change to a YAML file's node tree, so
+	// MigrateConfigFile keeps the file's comments and key order
+	rewrite func(root *yaml.Node) error
+}
+
+// migrations are applied in order to configurations older than their version.
+// Renamed fields are also mapped by legacyFieldRenames on every load, before
+// the file is decoded, so files without a version are read correctly too.
+var migrations = []migration{
+	{
+		version:     "1.0.0",
+		description: "move the flat database string into backend.database.primary",
+		apply:       migrateFlatDatabase,
+		rewrite: func(root *yaml.Node) error {
+			flatDatabaseRename.applyNode(root)
+			return nil
+		},
+	},
+}
+
+// Migrate upgrades a configuration written with schema fromVersion to
+// ConfigVersion by applying every newer migration step in order.
+func Migrate(config *ProjectConfig, fromVersion string) error {
+	pending, err := pendingMigrations(fromVersion)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range pending {
+		if err := m.apply(config); err != nil {
+			return fmt.Errorf("failed to %s: %w", m.description, err)
+		}
+	}
+
+	config.Version = ConfigVersion
+	return nil
+}
+
+// pendingMigrations returns the migration steps newer than fromVersion.
+func pendingMigrations(fromVersion string) ([]migration, error) {
+	var pending []migration
+	for _, m := range migrations {
+		cmp, err := utils.CompareVersions(fromVersion, m.version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid config version %q: %w", fromVersion, err)
+		}
+		if cmp < 0 {
+			pending = append(pending, m)
+		}
+	}
+	return pending, nil
+}
+
+// migrateFlatDatabase moves the 0.x top-level database string, kept in
+// ProjectConfig.Extra when a config is decoded without the legacy renames,
+// into backend.database.primary.
+func migrateFlatDatabase(config *ProjectConfig) error {
+	value, ok := config.Extra["database"]
+	if !ok {
+		return nil
+	}
+
+	database, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("database is %T, want a string", value)
+	}
+	if config.Backend.Database.Primary == "" {
+		config.Backend.Database.Primary = database
+	}
+
+	delete(config.Extra, "database")
+	if len(config.Extra) == 0 {
+		config.Extra = nil
+	}
+	return nil
+}
+
+// fieldRename moves a field from one dot-notation path to another.
+type fieldRename struct {
+	from, to string
+}
+
+// flatDatabaseRename moves the database string 0.x kept at the top level.
+var flatDatabaseRename = fieldRename{from: "database", to: "backend.database.primary"}
+
+// legacyFieldRenames map field names used by older versions of Clause to
+// their current paths. They run on every load, whatever the file's version.
+var legacyFieldRenames = []fieldRename{flatDatabaseRename}
+
+// applyMap moves the field within a decoded config map, reporting whether
+// it changed anything. A value already present at the new path wins and
+// the legacy key is dropped.
+func (r fieldRename) applyMap(m map[string]interface{}) bool {
+	oldParts := strings.Split(r.from, ".")
+	parent := lookupMap(m, oldParts[:len(oldParts)-1], false)
+	if parent == nil {
+		return false
+	}
+	oldKey := oldParts[len(oldParts)-1]
+	value, ok := parent[oldKey]
+	if !ok {
+		return false
+	}
+
+	newParts := strings.Split(r.to, ".")
+	target := lookupMap(m, newParts[:len(newParts)-1], true)
+	if target == nil {
+		return false
+	}
+	newKey := newParts[len(newParts)-1]
+	if _, exists := target[newKey]; !exists {
+		target[newKey] = value
+	}
+
+	delete(parent, oldKey)
+	return true
+}
+
+// applyNode moves the field within a YAML mapping node, with the same rules
+// as applyMap. The moved value keeps its comments.
+func (r fieldRename) applyNode(root *yaml.Node) bool {
+	oldParts := strings.Split(r.from, ".")
+	parent := lookupNode(root, oldParts[:len(oldParts)-1], false)
+	if parent == nil {
+		return false
+	}
+	i := nodeKeyIndex(parent, oldParts[len(oldParts)-1])
+	if i < 0 {
+		return false
+	}
+	key, value := parent.Content[i], parent.Content[i+1]
+
+	newParts := strings.Split(r.to, ".")
+	target := lookupNode(root, newParts[:len(newParts)-1], true)
+	if target == nil {
+		return false
+	}
+
+	parent.Content = append(parent.Content[:i], parent.Content[i+2:]...)
+	newKey := newParts[len(newParts)-1]
+	if nodeKeyIndex(target, newKey) < 0 {
+		key.Value = newKey
+		target.Content = append(target.Content, key, value)
+	}
+	return true
+}
+
+// lookupNode returns the mapping node at parts, creating missing levels
+// when create is set. It returns nil if a level is missing or is not a
+// mapping.
+func lookupNode(node *yaml.Node, parts []string, create bool) *yaml.Node {
+	for _, part := range parts {
+		if node.Kind != yaml.MappingNode {
+			return nil
+		}
+		i := nodeKeyIndex(node, part)
+		if i < 0 {
+			if !create {
+				return nil
+			}
+			node.Content = append(node.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: part},
+				&yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"})
+			i = len(node.Content) - 2
+		}
+		node = node.Content[i+1]
+	}
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	return node
+}
+
+// nodeKeyIndex returns the index of key within a mapping node's content,
+// or -1 if the mapping does not have it.
+func nodeKeyIndex(node *yaml.Node, key string) int {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// lookupMap returns the nested map at parts, creating missing levels when
+// create is set. It returns nil if a level is missing or is not a map.
+func lookupMap(m map[string]interface{}, parts []string, create bool) map[string]interface{} {
+	for _, part := range parts {
+		next, ok := m[part]
+		if !ok {
+			if !create {
+				return nil
+			}
+			next = make(map[string]interface{})
+			m[part] = next
+		}
+
+		child, ok := next.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		m = child
+	}
+	return m
+}
+
+// renameLegacyFields applies legacyFieldRenames to a decoded config map.
+func renameLegacyFields(m map[string]interface{}) bool {
+	changed := false
+	for _, rename := range legacyFieldRenames {
+		if rename.applyMap(m) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+// quoteNodeVersion replaces a numeric frontend.node_version, such as an
+// unquoted 20.1 in YAML, with its string form so it decodes into the
+// string field.
+func quoteNodeVersion(m map[string]interface{}) bool {
+	frontend, ok := m["frontend"].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	value, ok := frontend["node_version"]
+	if !ok {
+		return false
+	}
+	if _, isString := value.(string); isString {
+		return false
+	}
+	version, ok := nodeVersionString(value)
+	if !ok {
+		return false
+	}
+	frontend["node_version"] = version
+	return true
+}
+
+// rewriteLegacyFields returns data with legacy field names renamed and a
+// numeric node_version quoted, encoded in the same format. Data that needs
+// neither is returned unchanged.
+func rewriteLegacyFields(data []byte, format string) ([]byte, error) {
+	var m map[string]interface{}
+	var encode func(interface{}) ([]byte, error)
+
+	switch strings.ToLower(format) {
+	case "yaml", "yml":
+		if err := yaml.Unmarshal(data, &m); err != nil {
+			return nil, fmt.Errorf("failed to parse YAML config: %w", err)
+		}
+		encode = yaml.Marshal
+	case "json":
+		if err := json.Unmarshal(data, &m); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON config: %w", err)
+		}
+		encode = json.Marshal
+	case "toml":
+		if err := toml.Unmarshal(data, &m); err != nil {
+			return nil, fmt.Errorf("failed to parse TOML config: %w", err)
+		}
+		encode = toml.Marshal
+	default:
+		return data, nil
+	}
+
+	renamed := renameLegacyFields(m)
+	quoted := quoteNodeVersion(m)
+	if !renamed && !quoted {
+		return data, nil
+	}
+	return encode(m)
+}
+
+// MigrateConfigFile upgrades a config file written with an older schema
+// version in place, keeping a .bak backup of the original, and reports
+// whether it changed the file. The loader calls it for the project config
+// file. A YAML file is edited as a node tree, so its comments and key order
+// are kept; JSON and TOML files are re-encoded. Files without a version or
+// already at ConfigVersion are left untouched, and a version that does not
+// parse is an error.
+func MigrateConfigFile(path string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+
+	format := strings.TrimPrefix(filepath.Ext(path), ".")
+	head, err := decodeConfigMap(data, format)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse config file: %w", err)
+	}
+
+	var version string
+	switch v := head["version"].(type) {
+	case nil:
+	case string:
+		version = v
+	default:
+		// An unquoted YAML or TOML version such as 0.9 decodes as a number
+		version = fmt.Sprint(v)
+	}
+	if version == "" {
+		return false, nil
+	}
+	cmp, err := utils.CompareVersions(version, ConfigVersion)
+	if err != nil {
+		return false, fmt.Errorf("invalid config version %q: %w", version, err)
+	}
+	if cmp >= 0 {
+		return false, nil
+	}
+
+	switch strings.ToLower(format) {
+	case "yaml", "yml":
+		if err := migrateYAMLFile(path, version); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	config, err := parseConfig(data, format)
+	if err != nil {
+		return false, err
+	}
+	if err := Migrate(config, version); err != nil {
+		return false, err
+	}
+	if _, err := utils.BackupFile(path); err != nil {
+		return false, fmt.Errorf("failed to back up config: %w", err)
+	}
+	if err := NewSaver(WithFormat(format), WithBackup(false)).Save(config, path); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// migrateYAMLFile applies the legacy renames and every migration newer
+// than fromVersion to the YAML file at path as node edits.
+func migrateYAMLFile(path, fromVersion string) error {
+	release, err := utils.AcquireLock(path)
+	if err != nil {
+		return fmt.Errorf("failed to lock config: %w", err)
+	}
+	defer release()
+
+	// Re-read under the lock in case another process changed the file
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("failed to parse config file: %w", err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil
+	}
+	root := doc.Content[0]
+
+	for _, rename := range legacyFieldRenames {
+		rename.applyNode(root)
+	}
+
+	pending, err := pendingMigrations(fromVersion)
+	if err != nil {
+		return err
+	}
+	for _, m := range pending {
+		if err := m.rewrite(root); err != nil {
+			return fmt.Errorf("failed to %s: %w", m.description, err)
+		}
+	}
+
+	if i := nodeKeyIndex(root, "version"); i >= 0 {
+		root.Content[i+1].Tag = "!!str"
+		root.Content[i+1].Value = ConfigVersion
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(yamlIndent(data))
+	if err := encoder.Encode(&doc); err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+
+	if _, err := utils.BackupFile(path); err != nil {
+		return fmt.Errorf("failed to back up config: %w", err)
+	}
+	return utils.AtomicWrite(path, buf.Bytes())
+}
@@ -0,0 +1,323 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestMigrateConfigFile(t *testing.T) {
+	tests := []struct {
+		name        string
+		file        string
+		content     string
+		wantChanged bool
+		wantBackup  string
+		wantText    []string
+	}{
+		{
+			name: "old yaml", file: "config.yaml",
+			content:     "# Project settings\nversion: \"0.9.0\"\nmetadata:\n  name: demo # the project name\ndatabase: postgresql\n",
+			wantChanged: true, wantBackup: "config.yaml.bak",
+			wantText: []string{"# Project settings", "name: demo # the project name", "version: \"1.0.0\"", "primary: postgresql"},
+		},
+		{
+			name: "current yaml", file: "config.yaml",
+			content: "version: \"1.0.0\"\nmetadata:\n  name: demo\n",
+		},
+		{
+			name: "yaml without version", file: "config.yaml",
+			content: "metadata:\n  name: demo\ndatabase: postgresql\n",
+		},
+		{
+			name: "unquoted yaml version", file: "config.yaml",
+			content:     "version: 0.9\nmetadata:\n  name: demo\ndatabase: postgresql\n",
+			wantChanged: true, wantBackup: "config.yaml.bak",
+			wantText: []string{"version: 1.0.0", "primary: postgresql"},
+		},
+		{
+			name: "old json", file: "config.json",
+			content:     `{"version": "0.9.0", "metadata": {"name": "demo"}, "database": "postgresql"}`,
+			wantChanged: true, wantBackup: "config.json.bak",
+		},
+		{
+			name: "old toml", file: "config.toml",
+			content:     "version = \"0.9.0\"\ndatabase = \"postgresql\"\n\n[metadata]\nname = \"demo\"\n",
+			wantChanged: true, wantBackup: "config.toml.bak",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, tt.file)
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			changed, err := MigrateConfigFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if changed != tt.wantChanged {
+				t.Fatalf("changed = %v, want %v", changed, tt.wantChanged)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tt.wantChanged {
+				if string(data) != tt.content {
+					t.Errorf("unchanged file was rewritten:\n%s", data)
+				}
+				entries, _ := os.ReadDir(dir)
+				if len(entries) != 1 {
+					t.Errorf("unchanged file has %d entries next to it, want no backup", len(entries)-1)
+				}
+				return
+			}
+
+			for _, want := range tt.wantText {
+				if !strings.Contains(string(data), want) {
+					t.Errorf("migrated file does not contain %q:\n%s", want, data)
+				}
+			}
+			backup, err := os.ReadFile(filepath.Join(dir, tt.wantBackup))
+			if err != nil {
+				t.Fatalf("no backup: %v", err)
+			}
+			if string(backup) != tt.content {
+				t.Errorf("backup = %q, want the original file", backup)
+			}
+
+			cfg, err := NewLoader().LoadFromPath(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.Version != ConfigVersion || cfg.Backend.Database.Primary != "postgresql" || cfg.Metadata.Name != "demo" {
+				t.Errorf("migrated config has version %q, database %q, name %q",
+					cfg.Version, cfg.Backend.Database.Primary, cfg.Metadata.Name)
+			}
+		})
+	}
+}
+
+func TestMigrateConfigFileInvalidVersion(t *testing.T) {
+	for _, file := range []string{"config.yaml", "config.json", "config.toml"} {
+		t.Run(file, func(t *testing.T) {
+			content := map[string]string{
+				"config.yaml": "version: latest\nmetadata:\n  name: demo\n",
+				"config.json": `{"version": "latest", "metadata": {"name": "demo"}}`,
+				"config.toml": "version = \"latest\"\n\n[metadata]\nname = \"demo\"\n",
+			}[file]
+			path := filepath.Join(t.TempDir(), file)
+			if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			changed, err := MigrateConfigFile(path)
+			if err == nil || !strings.Contains(err.Error(), `invalid config version "latest"`) {
+				t.Errorf("error = %v, want an invalid version error", err)
+			}
+			if changed {
+				t.Error("changed = true alongside an error")
+			}
+			data, _ := os.ReadFile(path)
+			if string(data) != content {
+				t.Errorf("file was rewritten:\n%s", data)
+			}
+		})
+	}
+}
+
+func TestLoadMigratesProjectConfig(t *testing.T) {
+	dir := writeProject(t, map[string]string{
+		".clause/config.yaml": "# Project settings\nversion: \"0.9.0\"\nmetadata:\n  name: demo\ndatabase: mysql\n",
+	})
+
+	cfg, err := loadProject(t, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Version != ConfigVersion || cfg.Backend.Database.Primary != "mysql" {
+		t.Errorf("loaded version %q, database %q", cfg.Version, cfg.Backend.Database.Primary)
+	}
+
+	path := filepath.Join(dir, ".clause", "config.yaml")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"# Project settings", `version: "1.0.0"`, "primary: mysql"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("migrated file does not contain %q:\n%s", want, data)
+		}
+	}
+	if _, err := os.Stat(path + ".bak"); err != nil {
+		t.Error("no backup of the original file")
+	}
+}
+
+func TestMigrateFlatDatabase(t *testing.T) {
+	cfg := newDefaultConfig()
+	cfg.Backend.Database.Primary = ""
+	cfg.Extra = map[string]interface{}{"database": "sqlite"}
+
+	if err := Migrate(cfg, "0.9.0"); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Backend.Database.Primary != "sqlite" {
+		t.Errorf("Primary = %q, want sqlite", cfg.Backend.Database.Primary)
+	}
+	if cfg.Extra != nil {
+		t.Errorf("Extra = %v, want the database key removed", cfg.Extra)
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	defer func(saved []migration) { migrations = saved }(migrations)
+
+	var applied []string
+	step := func(version string) migration {
+		return migration{
+			version:     version,
+			description: "upgrade to " + version,
+			apply: func(*ProjectConfig) error {
+				applied = append(applied, version)
+				return nil
+			},
+			rewrite: func(*yaml.Node) error { return nil },
+		}
+	}
+	migrations = []migration{step("0.9.0"), step("1.0.0")}
+
+	tests := []struct {
+		from    string
+		want    []string
+		wantErr bool
+	}{
+		{"0.8.0", []string{"0.9.0", "1.0.0"}, false},
+		{"0.9.0", []string{"1.0.0"}, false},
+		{"1.0.0", nil, false},
+		{"not-a-version", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from, func(t *testing.T) {
+			applied = nil
+			cfg := newDefaultConfig()
+			cfg.Version = tt.from
+
+			err := Migrate(cfg, tt.from)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Migrate error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if strings.Join(applied, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("applied %v, want %v", applied, tt.want)
+			}
+			if err == nil && cfg.Version != ConfigVersion {
+				t.Errorf("version = %q, want %q", cfg.Version, ConfigVersion)
+			}
+		})
+	}
+}
+
+func TestLoadRenamesLegacyFields(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		body string
+		want string
+		// fromPath loads the file with LoadFromPath instead of Load
+		fromPath bool
+	}{
+		{name: "yaml", file: ".clause/config.yaml", body: "metadata:\n  name: demo\ndatabase: mysql\n", want: "mysql"},
+		{name: "toml", file: ".clause/config.toml", body: "database = \"mysql\"\n\n[metadata]\nname = \"demo\"\n", want: "mysql"},
+		{name: "yml at project root", file: "clause.yml", body: "metadata:\n  name: demo\ndatabase: mysql\n", want: "mysql"},
+		{
+			name:     "json from path",
+			file:     "config.json",
+			body:     `{"metadata": {"name": "demo"}, "database": "mysql"}`,
+			want:     "mysql",
+			fromPath: true,
+		},
+		{
+			name: "current field wins",
+			file: ".clause/config.yaml",
+			body: "metadata:\n  name: demo\ndatabase: mysql\nbackend:\n  database:\n    primary: sqlite\n",
+			want: "sqlite",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := writeProject(t, map[string]string{tt.file: tt.body})
+			var cfg *ProjectConfig
+			var err error
+			if tt.fromPath {
+				cfg, err = NewLoader(WithGlobalDir(t.TempDir())).LoadFromPath(filepath.Join(dir, tt.file))
+			} else {
+				cfg, err = loadProject(t, dir)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.Backend.Database.Primary != tt.want {
+				t.Errorf("backend.database.primary = %q, want %q", cfg.Backend.Database.Primary, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldRenameApplyMap(t *testing.T) {
+	rename := fieldRename{from: "infrastructure.ci", to: "infrastructure.ci_platform"}
+
+	tests := []struct {
+		name        string
+		input       map[string]interface{}
+		wantChanged bool
+		want        map[string]interface{}
+	}{
+		{
+			name:        "renames the field",
+			input:       map[string]interface{}{"infrastructure": map[string]interface{}{"ci": "gitlab-ci"}},
+			wantChanged: true,
+			want:        map[string]interface{}{"infrastructure": map[string]interface{}{"ci_platform": "gitlab-ci"}},
+		},
+		{
+			name: "keeps an existing new field",
+			input: map[string]interface{}{"infrastructure": map[string]interface{}{
+				"ci":          "gitlab-ci",
+				"ci_platform": "github-actions",
+			}},
+			wantChanged: true,
+			want:        map[string]interface{}{"infrastructure": map[string]interface{}{"ci_platform": "github-actions"}},
+		},
+		{
+			name:  "no legacy field",
+			input: map[string]interface{}{"infrastructure": map[string]interface{}{"docker": true}},
+			want:  map[string]interface{}{"infrastructure": map[string]interface{}{"docker": true}},
+		},
+		{
+			name:  "missing parent",
+			input: map[string]interface{}{},
+			want:  map[string]interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if changed := rename.applyMap(tt.input); changed != tt.wantChanged {
+				t.Errorf("applyMap() = %v, want %v", changed, tt.wantChanged)
+			}
+			if !reflect.DeepEqual(tt.input, tt.want) {
+				t.Errorf("map = %v, want %v", tt.input, tt.want)
+			}
+		})
+	}
+}
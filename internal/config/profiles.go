@@ -0,0 +1,162 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/clause-cli/clause/pkg/utils"
+)
+
+// ProfileEnv is the environment variable that selects the active profile.
+const ProfileEnv = "CLAUSE_PROFILE"
+
+// ListProfiles returns the names of the profiles in a project, one for each
+// .clause/config.<profile>.yaml file, in alphabetical order.
+func ListProfiles(projectDir string) ([]string, error) {
+	matches, err := filepath.Glob(ProfileConfigPath(projectDir, "*"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list profiles: %w", err)
+	}
+
+	var profiles []string
+	for _, match := range matches {
+		name := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(match), "config."), ".yaml")
+		if name != "" {
+			profiles = append(profiles, name)
+		}
+	}
+	return profiles, nil
+}
+
+// ActiveProfile returns the profile selected by ProfileEnv, or "" when no
+// profile is selected.
+func ActiveProfile() string {
+	return strings.TrimSpace(os.Getenv(ProfileEnv))
+}
+
+// PromoteProfile folds a profile into the base configuration: every key the
+// profile sets is written to .clause/config.yaml, so it applies without the
+// profile. The base file is edited as a node tree, so its comments, key
+// order and other settings are kept, and CORS origins are added to the base
+// origins as they are when the profile is loaded. When deleteProfile is set,
+// the profile file is removed afterwards.
+func PromoteProfile(projectDir, profile string, deleteProfile bool) error {
+	if profile == "" {
+		return fmt.Errorf("no profile to promote")
+	}
+
+	profilePath := ProfileConfigPath(projectDir, profile)
+	data, err := os.ReadFile(profilePath)
+	if err != nil {
+		return fmt.Errorf("failed to read profile %q: %w", profile, err)
+	}
+
+	var overlay yaml.Node
+	if err := yaml.Unmarshal(data, &overlay); err != nil {
+		return fmt.Errorf("failed to parse profile %q: %w", profile, err)
+	}
+
+	if len(overlay.Content) > 0 {
+		if overlay.Content[0].Kind != yaml.MappingNode {
+			return fmt.Errorf("profile %q is not a mapping", profile)
+		}
+		path := filepath.Join(projectDir, ".clause", "config.yaml")
+		if err := mergeIntoYAMLFile(path, overlay.Content[0]); err != nil {
+			return fmt.Errorf("failed to apply profile %q: %w", profile, err)
+		}
+	}
+
+	if deleteProfile {
+		if err := os.Remove(profilePath); err != nil {
+			return fmt.Errorf("failed to remove profile %q: %w", profile, err)
+		}
+	}
+	return nil
+}
+
+// mergeIntoYAMLFile merges the keys of overlay into the YAML file at path.
+func mergeIntoYAMLFile(path string, overlay *yaml.Node) error {
+	release, err := utils.AcquireLock(path)
+	if err != nil {
+		return fmt.Errorf("failed to lock config: %w", err)
+	}
+	defer release()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("failed to parse config file: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+	}
+	if doc.Content[0].Kind != yaml.MappingNode {
+		return fmt.Errorf("config file is not a mapping")
+	}
+
+	mergeNodes(doc.Content[0], overlay, nil)
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(yamlIndent(data))
+	if err := encoder.Encode(&doc); err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+
+	return utils.AtomicWrite(path, buf.Bytes())
+}
+
+// mergeNodes sets each key of the overlay mapping in the base mapping,
+// recursing into mappings both sides have. Replaced values keep the base
+// key, and with it the key's comments. path is the dot-notation path of
+// base, used to find the CORS origins.
+func mergeNodes(base, overlay *yaml.Node, path []string) {
+	for i := 0; i+1 < len(overlay.Content); i += 2 {
+		key, value := overlay.Content[i], overlay.Content[i+1]
+
+		j := nodeKeyIndex(base, key.Value)
+		if j < 0 {
+			base.Content = append(base.Content, key, value)
+			continue
+		}
+
+		existing := base.Content[j+1]
+		keyPath := append(append([]string(nil), path...), key.Value)
+		switch {
+		case existing.Kind == yaml.MappingNode && value.Kind == yaml.MappingNode:
+			mergeNodes(existing, value, keyPath)
+		case strings.Join(keyPath, ".") == "backend.api.cors.origins" &&
+			existing.Kind == yaml.SequenceNode && value.Kind == yaml.SequenceNode:
+			appendMissingItems(existing, value)
+		default:
+			base.Content[j+1] = value
+		}
+	}
+}
+
+// appendMissingItems adds the scalar items of overlay that base does not
+// already list.
+func appendMissingItems(base, overlay *yaml.Node) {
+	seen := make(map[string]bool)
+	for _, item := range base.Content {
+		seen[item.Value] = true
+	}
+	for _, item := range overlay.Content {
+		if !seen[item.Value] {
+			seen[item.Value] = true
+			base.Content = append(base.Content, item)
+		}
+	}
+}
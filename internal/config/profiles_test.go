@@ -0,0 +1,172 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestListProfiles(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  []string
+	}{
+		{
+			name: "staging and prod",
+			files: map[string]string{
+				".clause/config.yaml":         "metadata:\n  name: demo\n",
+				".clause/config.staging.yaml": "infrastructure:\n  hosting: railway\n",
+				".clause/config.prod.yaml":    "infrastructure:\n  hosting: aws\n",
+			},
+			want: []string{"prod", "staging"},
+		},
+		{
+			name: "base config only",
+			files: map[string]string{
+				".clause/config.yaml": "metadata:\n  name: demo\n",
+			},
+		},
+		{
+			name: "other formats are not profiles",
+			files: map[string]string{
+				".clause/config.staging.yaml": "infrastructure:\n  hosting: railway\n",
+				".clause/config.prod.toml":    "[infrastructure]\nhosting = \"aws\"\n",
+			},
+			want: []string{"staging"},
+		},
+		{
+			name:  "no .clause directory",
+			files: map[string]string{"README.md": "# demo\n"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := writeProject(t, tt.files)
+
+			got, err := ListProfiles(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListProfiles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestActiveProfile(t *testing.T) {
+	tests := []struct {
+		name string
+		env  string
+		want string
+	}{
+		{"staging", "staging", "staging"},
+		{"prod with whitespace", "  prod\n", "prod"},
+		{"unset", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(ProfileEnv, tt.env)
+
+			if got := ActiveProfile(); got != tt.want {
+				t.Errorf("ActiveProfile() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPromoteProfile(t *testing.T) {
+	const base = `# Base settings
+metadata:
+  name: demo # the project name
+  created_at: 2024-01-02T03:04:05Z
+frontend:
+  enabled: false
+backend:
+  api:
+    cors:
+      origins:
+        - https://example.com
+infrastructure:
+  # Where the app runs
+  hosting: vercel
+  monitoring:
+    logging:
+      level: info
+`
+
+	tests := []struct {
+		name          string
+		profile       string
+		deleteProfile bool
+		wantErr       bool
+	}{
+		{name: "keep the profile", profile: "staging"},
+		{name: "delete the profile", profile: "staging", deleteProfile: true},
+		{name: "missing profile", profile: "prod", wantErr: true},
+		{name: "no profile", profile: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := writeProject(t, map[string]string{
+				".clause/config.yaml": base,
+				".clause/config.staging.yaml": `infrastructure:
+  hosting: railway
+backend:
+  api:
+    cors:
+      origins:
+        - https://staging.example.com
+`,
+			})
+			path := filepath.Join(dir, ".clause", "config.yaml")
+
+			err := PromoteProfile(dir, tt.profile, tt.deleteProfile)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				if data, _ := os.ReadFile(path); string(data) != base {
+					t.Errorf("base config changed despite the error:\n%s", data)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range []string{
+				"# Base settings",
+				"name: demo # the project name",
+				"created_at: 2024-01-02T03:04:05Z",
+				"# Where the app runs\n  hosting: railway",
+				"frontend:\n  enabled: false",
+				"- https://example.com\n        - https://staging.example.com",
+				"level: info",
+			} {
+				if !strings.Contains(string(data), want) {
+					t.Errorf("promoted config does not contain %q:\n%s", want, data)
+				}
+			}
+			// Only the profile's keys are written, not the merged defaults
+			if strings.Contains(string(data), "governance:") {
+				t.Errorf("promoted config gained default settings:\n%s", data)
+			}
+
+			_, err = os.Stat(ProfileConfigPath(dir, tt.profile))
+			if exists := err == nil; exists == tt.deleteProfile {
+				t.Errorf("profile file exists = %v, want %v", exists, !tt.deleteProfile)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultRepoHost is the host assumed for repositories given as owner/repo.
+const DefaultRepoHost = "github.com"
+
+var (
+	// scpRepoRegex matches the scp-like SSH form, git@host:owner/repo.git
+	scpRepoRegex = regexp.MustCompile(`^[A-Za-z0-9._-]+@([A-Za-z0-9.-]+):(.+)$`)
+
+	// repoHostRegex matches a host name containing at least one dot
+	repoHostRegex = regexp.MustCompile(`^[A-Za-z0-9-]+(\.[A-Za-z0-9-]+)+(:\d+)?$`)
+
+	// repoSegmentRegex matches one owner, group or repository path segment
+	repoSegmentRegex = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+)
+
+// NormalizeRepoURL parses a repository given as an HTTPS URL, an SSH URL
+// (git@host:owner/repo.git or ssh://git@host/owner/repo.git), host/owner/repo
+// or owner/repo, and returns its canonical HTTPS and SSH forms:
+//
+//	https://github.com/org/repo
+//	git@github.com:org/repo.git
+func NormalizeRepoURL(s string) (https string, ssh string, err error) {
+	host, path, ok := splitRepoURL(strings.TrimSpace(s))
+	if !ok {
+		return "", "", fmt.Errorf("unrecognized repository URL %q", s)
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	segments := strings.Split(path, "/")
+	if len(segments) < 2 {
+		return "", "", fmt.Errorf("repository URL %q must name an owner and a repository", s)
+	}
+	for _, segment := range segments {
+		if !repoSegmentRegex.MatchString(segment) || strings.Trim(segment, ".") == "" {
+			return "", "", fmt.Errorf("invalid repository path %q in %q", path, s)
+		}
+	}
+
+	sshHost := strings.Split(host, ":")[0]
+	return "https://" + host + "/" + path, "git@" + sshHost + ":" + path + ".git", nil
+}
+
+// splitRepoURL separates the host from the repository path.
+func splitRepoURL(s string) (host, path string, ok bool) {
+	if m := scpRepoRegex.FindStringSubmatch(s); m != nil && !strings.Contains(s, "://") {
+		return m[1], m[2], true
+	}
+
+	if scheme, rest, found := strings.Cut(s, "://"); found {
+		switch strings.ToLower(scheme) {
+		case "https", "http", "ssh", "git", "git+ssh":
+		default:
+			return "", "", false
+		}
+		// Drop any user info, such as git@ in ssh://git@host/...
+		if at := strings.Index(rest, "@"); at >= 0 && at < strings.Index(rest+"/", "/") {
+			rest = rest[at+1:]
+		}
+		host, path, _ = strings.Cut(rest, "/")
+		if strings.EqualFold(scheme, "ssh") || strings.EqualFold(scheme, "git+ssh") {
+			// SSH URLs may carry a port that the HTTPS form cannot use
+			host = strings.Split(host, ":")[0]
+		}
+		return host, path, repoHostRegex.MatchString(host)
+	}
+
+	first, rest, found := strings.Cut(s, "/")
+	if !found {
+		return "", "", false
+	}
+	if repoHostRegex.MatchString(first) && strings.Contains(rest, "/") {
+		return first, rest, true
+	}
+	return DefaultRepoHost, s, true
+}
@@ -0,0 +1,81 @@
+package config
+
+import "testing"
+
+func TestNormalizeRepoURL(t *testing.T) {
+	const (
+		wantHTTPS = "https://github.com/org/repo"
+		wantSSH   = "git@github.com:org/repo.git"
+	)
+
+	tests := []struct {
+		name      string
+		input     string
+		wantHTTPS string
+		wantSSH   string
+		wantErr   bool
+	}{
+		{"https", "https://github.com/org/repo", wantHTTPS, wantSSH, false},
+		{"https with .git and slash", "https://github.com/org/repo.git/", wantHTTPS, wantSSH, false},
+		{"scp-like ssh", "git@github.com:org/repo.git", wantHTTPS, wantSSH, false},
+		{"ssh url", "ssh://git@github.com/org/repo.git", wantHTTPS, wantSSH, false},
+		{"ssh url with port", "ssh://git@github.com:2222/org/repo.git", wantHTTPS, wantSSH, false},
+		{"owner/repo", "org/repo", wantHTTPS, wantSSH, false},
+		{"surrounding spaces", "  org/repo  ", wantHTTPS, wantSSH, false},
+		{"host/owner/repo", "gitlab.com/group/sub/repo", "https://gitlab.com/group/sub/repo", "git@gitlab.com:group/sub/repo.git", false},
+		{"other host over ssh", "git@gitlab.com:group/repo.git", "https://gitlab.com/group/repo", "git@gitlab.com:group/repo.git", false},
+		{"single word", "repo", "", "", true},
+		{"unsupported scheme", "ftp://github.com/org/repo", "", "", true},
+		{"missing repository", "https://github.com/org", "", "", true},
+		{"spaces in path", "org/my repo", "", "", true},
+		{"dot segment", "org/..", "", "", true},
+		{"empty", "", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			https, ssh, err := NormalizeRepoURL(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeRepoURL(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if https != tt.wantHTTPS || ssh != tt.wantSSH {
+				t.Errorf("NormalizeRepoURL(%q) = %q, %q, want %q, %q", tt.input, https, ssh, tt.wantHTTPS, tt.wantSSH)
+			}
+		})
+	}
+}
+
+func TestValidateRepository(t *testing.T) {
+	tests := []struct {
+		name       string
+		repository string
+		wantWarn   bool
+	}{
+		{"empty", "", false},
+		{"owner/repo", "org/repo", false},
+		{"ssh", "git@github.com:org/repo.git", false},
+		{"garbage", "not a repo", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newDefaultConfig()
+			cfg.Metadata.Name = "demo"
+			cfg.Metadata.Repository = tt.repository
+
+			var warned bool
+			for _, err := range NewValidator().validateMetadata(&cfg.Metadata) {
+				if err.Field != "metadata.repository" {
+					continue
+				}
+				if err.Severity != "warning" {
+					t.Errorf("severity = %q, want warning", err.Severity)
+				}
+				warned = true
+			}
+			if warned != tt.wantWarn {
+				t.Errorf("warned = %v, want %v", warned, tt.wantWarn)
+			}
+		})
+	}
+}
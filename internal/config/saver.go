@@ -4,17 +4,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"time"
 
-	"gopkg.in/yaml.v3"
-
 	"github.com/clause-cli/clause/pkg/utils"
 )
 
 // Saver handles saving configuration to files.
 type Saver struct {
-	// format is the output format (yaml or json)
+	// format is the output format (yaml, json or toml)
 	format string
 
 	// indent is the indentation string for output
@@ -74,6 +73,13 @@ func (s *Saver) Save(config *ProjectConfig, path string) error {
 		return fmt.Errorf("failed to create config directory: %w", err)
 	}
 
+	// Serialize concurrent saves to the same file
+	release, err := utils.AcquireLock(path)
+	if err != nil {
+		return fmt.Errorf("failed to lock config: %w", err)
+	}
+	defer release()
+
 	// Create backup if file exists and backup is enabled
 	if s.backup && utils.FileExists(path) {
 		if err := s.createBackup(path); err != nil {
@@ -81,15 +87,24 @@ func (s *Saver) Save(config *ProjectConfig, path string) error {
 		}
 	}
 
+	// Store paths in a form that works on every team member's machine:
+	// relative to the config file when inside its directory, as the loader
+	// resolves them
+	if rulesPath := config.Governance.Rules.CustomRulesPath; rulesPath != "" {
+		config.Governance.Rules.CustomRulesPath = utils.PortablePath(relativeToConfig(rulesPath, path))
+		defer func() { config.Governance.Rules.CustomRulesPath = rulesPath }()
+	}
+
 	// Marshal configuration
 	var data []byte
-	var err error
 
 	switch strings.ToLower(s.format) {
 	case "yaml", "yml":
-		data, err = yaml.Marshal(config)
+		data, err = config.MarshalCanonical()
 	case "json":
 		data, err = json.MarshalIndent(config, "", s.indent)
+	case "toml":
+		data, err = marshalTOML(config)
 	default:
 		return fmt.Errorf("unsupported format: %s", s.format)
 	}
@@ -121,6 +136,24 @@ func (s *Saver) SaveToGlobal(config *ProjectConfig) error {
 	return s.Save(config, configPath)
 }
 
+// relativeToConfig returns an absolute target path relative to the
+// directory of the config file at configPath if it lies inside it, and
+// target unchanged otherwise.
+func relativeToConfig(target, configPath string) string {
+	if !filepath.IsAbs(target) {
+		return target
+	}
+	dir, err := filepath.Abs(filepath.Dir(configPath))
+	if err != nil {
+		return target
+	}
+	rel, err := filepath.Rel(dir, target)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return target
+	}
+	return rel
+}
+
 // createBackup creates a backup of the existing configuration file.
 func (s *Saver) createBackup(path string) error {
 	backupPath := path + ".backup"
@@ -200,6 +233,11 @@ func ExportYAML(config *ProjectConfig, path string) error {
 	return NewSaver(WithFormat("yaml"), WithBackup(false)).Save(config, path)
 }
 
+// ExportTOML exports the configuration as TOML.
+func ExportTOML(config *ProjectConfig, path string) error {
+	return NewSaver(WithFormat("toml"), WithBackup(false)).Save(config, path)
+}
+
 // UpdateProjectConfig loads, modifies, and saves a project configuration.
 func UpdateProjectConfig(projectDir string, modifier func(*ProjectConfig)) error {
 	loader := NewLoader(WithProjectDir(projectDir))
@@ -232,6 +270,9 @@ func MergeConfig(projectDir string, partial map[string]interface{}) error {
 
 // SetConfigValue sets a specific configuration value by key path.
 // Key paths use dot notation (e.g., "frontend.framework", "backend.database.primary").
+// List fields also accept "+=" to append a value if absent and "-=" to remove
+// it, either as part of the key path ("backend.auth.methods+=github") or as
+// a suffix with the value passed separately.
 func SetConfigValue(projectDir string, keyPath string, value interface{}) error {
 	loader := NewLoader(WithProjectDir(projectDir))
 	config, err := loader.Load()
@@ -239,7 +280,7 @@ func SetConfigValue(projectDir string, keyPath string, value interface{}) error
 		return fmt.Errorf("failed to load project config: %w", err)
 	}
 
-	if err := setNestedValue(config, keyPath, value); err != nil {
+	if err := SetValue(config, keyPath, value); err != nil {
 		return fmt.Errorf("failed to set config value: %w", err)
 	}
 
@@ -247,6 +288,112 @@ func SetConfigValue(projectDir string, keyPath string, value interface{}) error
 	return saver.SaveToProject(config, projectDir)
 }
 
+// SetValue sets a configuration value in memory. It accepts the same key
+// paths and list operators as SetConfigValue.
+func SetValue(config *ProjectConfig, keyPath string, value interface{}) error {
+	return applySetOperation(config, keyPath, value)
+}
+
+// List operators accepted in SetConfigValue key paths.
+const (
+	opAppend = "+="
+	opRemove = "-="
+)
+
+// applySetOperation applies a plain assignment or a list operation to config.
+// A []string value replaces the whole list field at the path.
+func applySetOperation(config *ProjectConfig, keyPath string, value interface{}) error {
+	path, op, value := parseSetOperation(keyPath, value)
+	if op != "" {
+		return updateListValue(config, path, op, fmt.Sprint(value))
+	}
+
+	if list, ok := value.([]string); ok {
+		field, err := listFieldByPath(config, path)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(append([]string(nil), list...)))
+		return nil
+	}
+	return setNestedValue(config, path, value)
+}
+
+// parseSetOperation splits a key path such as "backend.auth.methods+=github"
+// into its path, operator and value. A value embedded in the key path takes
+// precedence over the one passed in.
+func parseSetOperation(keyPath string, value interface{}) (string, string, interface{}) {
+	for _, op := range []string{opAppend, opRemove} {
+		idx := strings.Index(keyPath, op)
+		if idx < 0 {
+			continue
+		}
+		if embedded := strings.TrimSpace(keyPath[idx+len(op):]); embedded != "" {
+			value = embedded
+		}
+		return strings.TrimSpace(keyPath[:idx]), op, value
+	}
+	return keyPath, "", value
+}
+
+// updateListValue appends value to, or removes it from, the list field at
+// path. Appending a value that is already present and removing one that is
+// absent are no-ops.
+func updateListValue(config *ProjectConfig, path, op, value string) error {
+	field, err := listFieldByPath(config, path)
+	if err != nil {
+		return err
+	}
+
+	list := field.Interface().([]string)
+	switch op {
+	case opAppend:
+		if !contains(list, value) {
+			list = append(list, value)
+		}
+	case opRemove:
+		filtered := make([]string, 0, len(list))
+		for _, item := range list {
+			if item != value {
+				filtered = append(filtered, item)
+			}
+		}
+		list = filtered
+	}
+
+	field.Set(reflect.ValueOf(list))
+	return nil
+}
+
+// listFieldByPath resolves a dot-notation path to a settable []string field,
+// matching path segments against the fields' YAML names.
+func listFieldByPath(config *ProjectConfig, path string) (reflect.Value, error) {
+	current := reflect.ValueOf(config).Elem()
+
+	for _, part := range strings.Split(path, ".") {
+		if current.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("%s is not a list field", path)
+		}
+
+		next := reflect.Value{}
+		for i := 0; i < current.NumField(); i++ {
+			if strings.Split(current.Type().Field(i).Tag.Get("yaml"), ",")[0] == part {
+				next = current.Field(i)
+				break
+			}
+		}
+		if !next.IsValid() {
+			return reflect.Value{}, fmt.Errorf("unknown field: %s", path)
+		}
+		current = next
+	}
+
+	if current.Type() != reflect.TypeOf([]string(nil)) {
+		return reflect.Value{}, fmt.Errorf("%s is not a list field", path)
+	}
+	return current, nil
+}
+
 // setNestedValue sets a value in the config using dot notation path.
 func setNestedValue(config *ProjectConfig, path string, value interface{}) error {
 	parts := strings.Split(path, ".")
@@ -268,6 +415,15 @@ func setNestedValue(config *ProjectConfig, path string, value interface{}) error
 		return setGovernanceValue(&config.Governance, parts[1:], value)
 	case "development":
 		return setDevelopmentValue(&config.Development, parts[1:], value)
+	case "variables":
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid variables path")
+		}
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[parts[1]] = fmt.Sprint(value)
+		return nil
 	default:
 		return fmt.Errorf("unknown top-level field: %s", parts[0])
 	}
@@ -337,6 +493,11 @@ func setFrontendValue(f *FrontendConfig, parts []string, value interface{}) erro
 			f.Framework = v
 			return nil
 		}
+	case "node_version":
+		if v, ok := nodeVersionString(value); ok {
+			f.NodeVersion = v
+			return nil
+		}
 	case "typescript":
 		if v, ok := value.(bool); ok {
 			f.TypeScript = v
@@ -530,6 +691,11 @@ func setAPIValue(a *APIConfig, parts []string, value interface{}) error {
 }
 
 func setBackendFeaturesValue(f *BackendFeatures, field string, value interface{}) error {
+	if field == "email_provider" {
+		f.EmailProvider = fmt.Sprint(value)
+		return nil
+	}
+
 	v, ok := value.(bool)
 	if !ok {
 		return fmt.Errorf("feature value must be boolean")
@@ -561,6 +727,11 @@ func setInfrastructureValue(i *InfrastructureConfig, parts []string, value inter
 		return fmt.Errorf("empty infrastructure path")
 	}
 
+	// Handle nested configs
+	if len(parts) >= 2 && parts[0] == "monitoring" {
+		return setMonitoringValue(&i.Monitoring, parts[1:], value)
+	}
+
 	if len(parts) != 1 {
 		return fmt.Errorf("invalid infrastructure path")
 	}
@@ -600,11 +771,133 @@ func setInfrastructureValue(i *InfrastructureConfig, parts []string, value inter
 	return fmt.Errorf("unknown infrastructure field: %s", parts[0])
 }
 
+func setMonitoringValue(m *MonitoringConfig, parts []string, value interface{}) error {
+	if len(parts) >= 2 && parts[0] == "logging" {
+		return setLoggingValue(&m.Logging, parts[1:], value)
+	}
+
+	if len(parts) != 1 {
+		return fmt.Errorf("invalid monitoring path")
+	}
+
+	switch parts[0] {
+	case "enabled":
+		if v, ok := value.(bool); ok {
+			m.Enabled = v
+			return nil
+		}
+	case "provider":
+		if v, ok := value.(string); ok {
+			m.Provider = v
+			return nil
+		}
+	case "error_tracking":
+		if v, ok := value.(bool); ok {
+			m.ErrorTracking = v
+			return nil
+		}
+	case "error_tracking_provider":
+		if v, ok := value.(string); ok {
+			m.ErrorTrackingProvider = v
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown monitoring field: %s", parts[0])
+}
+
+func setLoggingValue(l *LoggingConfig, parts []string, value interface{}) error {
+	if len(parts) != 1 {
+		return fmt.Errorf("invalid logging path")
+	}
+
+	switch parts[0] {
+	case "level":
+		if v, ok := value.(string); ok {
+			l.Level = v
+			return nil
+		}
+	case "format":
+		if v, ok := value.(string); ok {
+			l.Format = v
+			return nil
+		}
+	case "provider":
+		if v, ok := value.(string); ok {
+			l.Provider = v
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown logging field: %s", parts[0])
+}
+
+func setGovernanceRulesValue(r *GovernanceRules, parts []string, value interface{}) error {
+	// Rule names are field paths and may contain dots, so the last part is
+	// the rule setting and everything between is the name
+	if len(parts) >= 3 && parts[0] == "rules" {
+		name := strings.Join(parts[1:len(parts)-1], ".")
+		return setRuleValue(r, name, parts[len(parts)-1], value)
+	}
+
+	if len(parts) != 1 {
+		return fmt.Errorf("invalid rules path")
+	}
+
+	switch parts[0] {
+	case "enabled":
+		if v, ok := value.(bool); ok {
+			r.Enabled = v
+			return nil
+		}
+	case "strict_mode":
+		if v, ok := value.(bool); ok {
+			r.StrictMode = v
+			return nil
+		}
+	case "custom_rules_path":
+		if v, ok := value.(string); ok {
+			r.CustomRulesPath = v
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown rules field: %s", parts[0])
+}
+
+func setRuleValue(r *GovernanceRules, name, field string, value interface{}) error {
+	if r.Rules == nil {
+		r.Rules = make(map[string]RuleConfig)
+	}
+	rule, ok := r.Rules[name]
+	if !ok {
+		rule.Enabled = true
+	}
+
+	switch field {
+	case "enabled":
+		if v, ok := value.(bool); ok {
+			rule.Enabled = v
+			r.Rules[name] = rule
+			return nil
+		}
+	case "severity":
+		if v, ok := value.(string); ok {
+			rule.Severity = v
+			r.Rules[name] = rule
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown rule field: %s", field)
+}
+
 func setGovernanceValue(g *GovernanceConfig, parts []string, value interface{}) error {
 	if len(parts) == 0 {
 		return fmt.Errorf("empty governance path")
 	}
 
+	// Handle nested configs
+	if len(parts) >= 2 && parts[0] == "rules" {
+		return setGovernanceRulesValue(&g.Rules, parts[1:], value)
+	}
+
 	if len(parts) != 1 {
 		return fmt.Errorf("invalid governance path")
 	}
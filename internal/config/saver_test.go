@@ -0,0 +1,124 @@
+package config
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSetValueListOperators(t *testing.T) {
+	tests := []struct {
+		name    string
+		keyPath string
+		value   interface{}
+		want    []string
+		wantErr bool
+	}{
+		{"append new", "backend.auth.methods+=github", nil, []string{"email", "password", "github"}, false},
+		{"append existing", "backend.auth.methods+=email", nil, []string{"email", "password"}, false},
+		{"append separate value", "backend.auth.methods+=", "github", []string{"email", "password", "github"}, false},
+		{"remove existing", "backend.auth.methods-=email", nil, []string{"password"}, false},
+		{"remove absent", "backend.auth.methods-=saml", nil, []string{"email", "password"}, false},
+		{"spaces around operator", "backend.auth.methods += github", nil, []string{"email", "password", "github"}, false},
+		{"replace", "backend.auth.methods", []string{"github"}, []string{"github"}, false},
+		{"not a list", "backend.framework+=gin", nil, []string{"email", "password"}, true},
+		{"unknown field", "backend.nope+=x", nil, []string{"email", "password"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.Backend.Auth.Methods = []string{"email", "password"}
+
+			err := SetValue(cfg, tt.keyPath, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetValue(%q) error = %v, wantErr %v", tt.keyPath, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(cfg.Backend.Auth.Methods, tt.want) {
+				t.Errorf("methods = %v, want %v", cfg.Backend.Auth.Methods, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetConfigValueAppendIdempotent(t *testing.T) {
+	dir := writeProject(t, map[string]string{
+		".clause/config.yaml": "metadata:\n  name: demo\nbackend:\n  auth:\n    methods:\n      - email\n",
+	})
+
+	for i := 0; i < 2; i++ {
+		if err := SetConfigValue(dir, "backend.auth.methods+=github", nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cfg, err := loadProject(t, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"email", "github"}; !reflect.DeepEqual(cfg.Backend.Auth.Methods, want) {
+		t.Errorf("methods = %v, want %v", cfg.Backend.Auth.Methods, want)
+	}
+}
+
+func TestExportTOMLRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(cfg *ProjectConfig)
+	}{
+		{"defaults", func(cfg *ProjectConfig) {}},
+		{"database", func(cfg *ProjectConfig) {
+			cfg.Backend.Database = DatabaseConfig{Primary: "postgresql", ORM: "prisma", Migrations: true, Redis: true}
+		}},
+		{"cors", func(cfg *ProjectConfig) {
+			cfg.Backend.API.CORS.Enabled = true
+			cfg.Backend.API.CORS.Origins = []string{"https://example.com", "http://localhost:3000"}
+		}},
+		{"rules and variables", func(cfg *ProjectConfig) {
+			cfg.Governance.Rules.Rules = map[string]RuleConfig{"infrastructure.docker_compose": {Enabled: true, Severity: "error"}}
+			cfg.Variables = map[string]string{"company": "Acme"}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := LoadPreset("saas")
+			if err != nil {
+				t.Fatal(err)
+			}
+			cfg.Metadata.Name = "demo"
+			tt.mutate(cfg)
+
+			// Loading fills in defaults, so compare with a YAML round trip
+			dir := t.TempDir()
+			if err := ExportTOML(cfg, filepath.Join(dir, "config.toml")); err != nil {
+				t.Fatal(err)
+			}
+			if err := ExportYAML(cfg, filepath.Join(dir, "config.yaml")); err != nil {
+				t.Fatal(err)
+			}
+			fromTOML, err := NewLoader().LoadFromPath(filepath.Join(dir, "config.toml"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			fromYAML, err := NewLoader().LoadFromPath(filepath.Join(dir, "config.yaml"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(fromTOML.Backend.Database, cfg.Backend.Database) {
+				t.Errorf("database = %+v, want %+v", fromTOML.Backend.Database, cfg.Backend.Database)
+			}
+			if !reflect.DeepEqual(fromTOML.Backend.API.CORS.Origins, cfg.Backend.API.CORS.Origins) {
+				t.Errorf("cors origins = %v, want %v", fromTOML.Backend.API.CORS.Origins, cfg.Backend.API.CORS.Origins)
+			}
+			changes, err := withoutTimestamps(fromYAML).Diff(withoutTimestamps(fromTOML))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changes) > 0 {
+				t.Errorf("TOML round trip differs from YAML: %v", changes)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/clause-cli/clause/internal/config/enum"
+)
+
+// JSONSchemaDraft is the JSON Schema draft ExportJSONSchema targets.
+const JSONSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// jsonSchema is the subset of a Draft-07 schema needed to describe
+// ProjectConfig.
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Enum                 []string               `json:"enum,omitempty"`
+	Default              interface{}            `json:"default,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	AdditionalProperties interface{}            `json:"additionalProperties,omitempty"`
+}
+
+// schemaEnums maps field paths to the values the validator accepts. The
+// empty string is always allowed, because fields of disabled sections are
+// saved blank.
+var schemaEnums = map[string][]string{
+	"frontend.framework":       enum.FrontendFrameworks.Strings(),
+	"frontend.styling":         enum.StylingOptions.Strings(),
+	"frontend.package_manager": enum.PackageManagers.Strings(),
+	"frontend.build_tool":      buildToolValues(),
+	"backend.framework":        enum.BackendFrameworks.Strings(),
+	"backend.database.primary": enum.Databases.Strings(),
+	"backend.auth.provider":    enum.AuthProviders.Strings(),
+	"backend.api.style":        enum.APIStyles.Strings(),
+	"backend.api.versioning":   enum.APIVersioningStrategies.Strings(),
+	"infrastructure.ci":        enum.CIProviders.Strings(),
+	"infrastructure.hosting":   enum.HostingProviders.Strings(),
+	"governance.context_level": enum.ContextLevels.Strings(),
+
+	"governance.assistant_targets.*": enum.AssistantTargets.Strings(),
+}
+
+// ExportJSONSchema returns a Draft-07 JSON Schema for ProjectConfig, for
+// editor completion and validation of config files. Property names come
+// from the yaml tags, descriptions from the field comments in config.go and
+// enums from the validator's supported values.
+func ExportJSONSchema() ([]byte, error) {
+	schema := schemaForType(reflect.TypeOf(ProjectConfig{}), "")
+	schema.Schema = JSONSchemaDraft
+	schema.Title = "Clause project configuration"
+
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+// schemaForType builds the schema for a Go type found at path.
+func schemaForType(t reflect.Type, path string) *jsonSchema {
+	schema := &jsonSchema{Description: fieldComments[path]}
+
+	if t == reflect.TypeOf(time.Time{}) {
+		schema.Type = "string"
+		schema.Format = "date-time"
+		return schema
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		schema.Type = "object"
+		schema.Properties = make(map[string]*jsonSchema)
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+			if name == "" || name == "-" {
+				continue
+			}
+
+			fieldPath := name
+			if path != "" {
+				fieldPath = path + "." + name
+			}
+			schema.Properties[name] = schemaForType(field.Type, fieldPath)
+		}
+
+	case reflect.Map:
+		schema.Type = "object"
+		if t.Elem().Kind() == reflect.Interface {
+			schema.AdditionalProperties = true
+		} else {
+			value := schemaForType(t.Elem(), path+".*")
+			value.Description = ""
+			schema.AdditionalProperties = value
+		}
+
+	case reflect.Slice:
+		schema.Type = "array"
+		schema.Items = schemaForType(t.Elem(), path+".*")
+		schema.Items.Description = ""
+
+	case reflect.String:
+		schema.Type = "string"
+		if values, ok := schemaEnums[path]; ok {
+			schema.Enum = append([]string{""}, values...)
+		}
+
+	case reflect.Bool:
+		schema.Type = "boolean"
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		schema.Type = "integer"
+
+	case reflect.Float32, reflect.Float64:
+		schema.Type = "number"
+	}
+
+	if t.Kind() != reflect.Struct && path != "" {
+		if value, err := GetDefaultFor(path); err == nil && value != nil && !reflect.ValueOf(value).IsZero() {
+			schema.Default = value
+		}
+	}
+
+	return schema
+}
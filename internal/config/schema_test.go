@@ -0,0 +1,70 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestSchemaEnumsMatchValidator checks that each value a schema enum lists
+// passes validation, and that a value outside it does not, so the schema and
+// the validator accept the same values.
+func TestSchemaEnumsMatchValidator(t *testing.T) {
+	for path, values := range schemaEnums {
+		// A path ending in .* lists the values of a list's items
+		field, isList := strings.CutSuffix(path, ".*")
+
+		t.Run(path, func(t *testing.T) {
+			for _, value := range append(values, "not-a-value") {
+				cfg := newDefaultConfig()
+				cfg.Metadata.Name = "demo"
+				cfg.Frontend.Enabled = true
+				cfg.Backend.Enabled = true
+
+				if isList {
+					list, err := listFieldByPath(cfg, field)
+					if err != nil {
+						t.Fatal(err)
+					}
+					list.Set(reflect.ValueOf([]string{value}))
+				} else if err := setNestedValue(cfg, field, value); err != nil {
+					t.Fatal(err)
+				}
+
+				var rejected bool
+				for _, err := range NewValidator().Validate(cfg) {
+					if err.Field == field && err.Severity == "error" {
+						rejected = true
+					}
+				}
+				if want := value == "not-a-value"; rejected != want {
+					t.Errorf("%s=%q rejected = %v, want %v", path, value, rejected, want)
+				}
+			}
+		})
+	}
+}
+
+// TestSchemaDeprecatedValues checks that deprecated spellings the validator
+// still accepts, such as the turboPack build tool, are in the schema too.
+func TestSchemaDeprecatedValues(t *testing.T) {
+	for _, opt := range deprecatedOptions {
+		t.Run(opt.field+"="+opt.value, func(t *testing.T) {
+			cfg := newDefaultConfig()
+			cfg.Metadata.Name = "demo"
+			if err := setNestedValue(cfg, opt.field, opt.value); err != nil {
+				t.Fatal(err)
+			}
+
+			accepted := true
+			for _, err := range NewValidator().Validate(cfg) {
+				if err.Field == opt.field && err.Severity == "error" {
+					accepted = false
+				}
+			}
+			if inSchema := contains(schemaEnums[opt.field], opt.value); inSchema != accepted {
+				t.Errorf("%q in schema = %v, accepted by the validator = %v", opt.value, inSchema, accepted)
+			}
+		})
+	}
+}
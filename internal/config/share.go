@@ -0,0 +1,94 @@
+package config
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// shareTokenVersion is the format version stored in the first byte of a
+// share token. Version 1 is the gzip-compressed canonical YAML.
+const shareTokenVersion byte = 1
+
+// maxShareTokenSize limits how much a share token may decompress to, so a
+// crafted token cannot exhaust memory.
+const maxShareTokenSize = 1 << 20
+
+// ErrInvalidShareToken is returned by DecodeConfig for tokens that are
+// malformed or corrupted.
+var ErrInvalidShareToken = errors.New("invalid share token")
+
+// EncodeConfig encodes a configuration as a compact token that can be
+// shared as text, such as in a chat message, and turned back into the same
+// configuration with DecodeConfig. The token is a version byte followed by
+// the gzip-compressed canonical YAML, encoded as unpadded base64url.
+func EncodeConfig(c *ProjectConfig) (string, error) {
+	data, err := c.MarshalCanonical()
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte(shareTokenVersion)
+
+	zw, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return "", fmt.Errorf("failed to compress config: %w", err)
+	}
+	if _, err := zw.Write(data); err != nil {
+		return "", fmt.Errorf("failed to compress config: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return "", fmt.Errorf("failed to compress config: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// DecodeConfig decodes a token created by EncodeConfig. Malformed and
+// corrupted tokens return an error wrapping ErrInvalidShareToken. Only a
+// token whose payload is intact but whose version is newer returns an error
+// asking to upgrade.
+func DecodeConfig(token string) (*ProjectConfig, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidShareToken, err)
+	}
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("%w: empty token", ErrInvalidShareToken)
+	}
+
+	// A corrupted token can have any first byte, so the payload is checked
+	// before the version is trusted
+	zr, err := gzip.NewReader(bytes.NewReader(raw[1:]))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidShareToken, err)
+	}
+	data, err := io.ReadAll(io.LimitReader(zr, maxShareTokenSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidShareToken, err)
+	}
+	if len(data) > maxShareTokenSize {
+		return nil, fmt.Errorf("%w: config is too large", ErrInvalidShareToken)
+	}
+
+	if raw[0] != shareTokenVersion {
+		if raw[0] > shareTokenVersion {
+			return nil, fmt.Errorf("share token version %d needs a newer version of Clause", raw[0])
+		}
+		return nil, fmt.Errorf("%w: unknown version %d", ErrInvalidShareToken, raw[0])
+	}
+
+	// The token holds every field, so it is decoded as is rather than over
+	// the defaults
+	config := &ProjectConfig{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidShareToken, err)
+	}
+	return config, nil
+}
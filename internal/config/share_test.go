@@ -0,0 +1,104 @@
+package config
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestEncodeDecodeConfigRoundTrip(t *testing.T) {
+	for _, name := range PresetNames() {
+		t.Run(name, func(t *testing.T) {
+			cfg, err := LoadPreset(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cfg.Metadata.Name = "demo"
+			cfg.Development.Scripts = map[string]string{"lint": "eslint .", "dev": "vite"}
+
+			token, err := EncodeConfig(cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			decoded, err := DecodeConfig(token)
+			if err != nil {
+				t.Fatalf("DecodeConfig: %v", err)
+			}
+
+			want, _ := cfg.MarshalCanonical()
+			got, _ := decoded.MarshalCanonical()
+			if !bytes.Equal(got, want) {
+				t.Errorf("round trip changed the config:\ngot:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+// shareToken builds a token with the given version byte and payload.
+func shareToken(t *testing.T, version byte, payload string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	buf.WriteByte(version)
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write([]byte(payload)); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf.Bytes())
+}
+
+func TestDecodeConfigInvalidTokens(t *testing.T) {
+	valid, err := EncodeConfig(DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, _ := base64.RawURLEncoding.DecodeString(valid)
+
+	// Flip a byte in the compressed payload
+	corrupted := append([]byte(nil), raw...)
+	corrupted[len(corrupted)/2] ^= 0xff
+
+	// A corrupted first byte on its own must not look like a newer token
+	badVersion := append([]byte(nil), raw...)
+	badVersion[0] = 0xfe
+	badVersion = badVersion[:len(badVersion)-4]
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"empty", ""},
+		{"not base64", "not a token!"},
+		{"truncated", valid[:len(valid)/2]},
+		{"corrupted payload", base64.RawURLEncoding.EncodeToString(corrupted)},
+		{"corrupted version and payload", base64.RawURLEncoding.EncodeToString(badVersion)},
+		{"not gzip", base64.RawURLEncoding.EncodeToString([]byte{shareTokenVersion, 'h', 'i'})},
+		{"unknown old version", shareToken(t, 0, "version: 1.0.0\n")},
+		{"not yaml", shareToken(t, shareTokenVersion, "metadata: [unclosed")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeConfig(tt.token)
+			if !errors.Is(err, ErrInvalidShareToken) {
+				t.Errorf("got %v, want ErrInvalidShareToken", err)
+			}
+		})
+	}
+}
+
+func TestDecodeConfigNewerVersion(t *testing.T) {
+	_, err := DecodeConfig(shareToken(t, shareTokenVersion+1, "version: 9.0.0\n"))
+	if err == nil || errors.Is(err, ErrInvalidShareToken) {
+		t.Fatalf("got %v, want an upgrade error", err)
+	}
+	if !strings.Contains(err.Error(), "newer version of Clause") {
+		t.Errorf("got %q, want it to ask for a newer version", err)
+	}
+}
@@ -2,8 +2,14 @@ package config
 
 import (
 	"fmt"
+	"path"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
+
+	"github.com/clause-cli/clause/internal/config/enum"
+	"github.com/clause-cli/clause/pkg/utils"
 )
 
 // ValidationError represents a single validation error.
@@ -26,6 +32,12 @@ func (e ValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// FieldPath returns the field path that failed validation, so renderers
+// can show it without depending on this package.
+func (e ValidationError) FieldPath() string {
+	return e.Field
+}
+
 // ValidationErrors is a collection of validation errors.
 type ValidationErrors []ValidationError
 
@@ -66,6 +78,11 @@ func (e ValidationErrors) HasWarnings() bool {
 type Validator struct {
 	// Strict enables strict validation (warnings become errors)
 	Strict bool
+
+	// Overrides maps field paths to a replacement severity (error, warning,
+	// info) or "off" to suppress the check. They take precedence over the
+	// overrides configured in governance.rules.rules.
+	Overrides map[string]string
 }
 
 // NewValidator creates a new configuration validator.
@@ -94,14 +111,122 @@ func (v *Validator) Validate(config *ProjectConfig) ValidationErrors {
 
 	// Validate infrastructure
 	errors = append(errors, v.validateInfrastructure(&config.Infrastructure)...)
+	errors = append(errors, v.validateHosting(config)...)
 
 	// Validate governance
 	errors = append(errors, v.validateGovernance(&config.Governance)...)
 
+	// Validate custom variables
+	errors = append(errors, v.validateVariables(config.Variables)...)
+
 	// Validate cross-field dependencies
 	errors = append(errors, v.validateDependencies(config)...)
 
-	return errors
+	return applySeverityOverrides(errors, v.severityOverrides(config))
+}
+
+// ValidateSection runs the checks for one part of the configuration:
+// "metadata", "frontend", "backend", "infrastructure" or "governance".
+// Cross-section checks are left to Validate, so a section can be checked
+// while the others are still being filled in, as the wizard does.
+func (v *Validator) ValidateSection(config *ProjectConfig, section string) ValidationErrors {
+	var errors ValidationErrors
+
+	switch section {
+	case "metadata":
+		errors = v.validateMetadata(&config.Metadata)
+	case "frontend":
+		if config.Frontend.Enabled {
+			errors = v.validateFrontend(&config.Frontend)
+		}
+	case "backend":
+		if config.Backend.Enabled {
+			errors = v.validateBackend(&config.Backend)
+		}
+	case "infrastructure":
+		errors = append(v.validateInfrastructure(&config.Infrastructure), v.validateHosting(config)...)
+	case "governance":
+		errors = v.validateGovernance(&config.Governance)
+	}
+
+	return applySeverityOverrides(errors, v.severityOverrides(config))
+}
+
+// SeverityOff suppresses a validation check when used as an override.
+const SeverityOff = "off"
+
+// SeverityOverride returns the severity the rule sets: its severity, or
+// "off" when it is disabled without one. It reports false when the rule
+// leaves the default severity.
+func (r RuleConfig) SeverityOverride() (string, bool) {
+	switch {
+	case r.Severity != "":
+		return strings.ToLower(r.Severity), true
+	case !r.Enabled:
+		return SeverityOff, true
+	}
+	return "", false
+}
+
+// SeverityOverrides returns the configuration check overrides configured in
+// governance rules, keyed by field path. Keys that are not field paths,
+// such as governance rule names, are left to the governance validator, so
+// the two never override each other's checks.
+func SeverityOverrides(rules GovernanceRules) map[string]string {
+	overrides := make(map[string]string)
+	for key, rule := range rules.Rules {
+		if !IsFieldPath(key) {
+			continue
+		}
+		if severity, ok := rule.SeverityOverride(); ok {
+			overrides[key] = severity
+		}
+	}
+	return overrides
+}
+
+// IsFieldPath reports whether key is a configuration field path, a section
+// containing fields or a custom variable, which take validation severity
+// overrides.
+func IsFieldPath(key string) bool {
+	if strings.HasPrefix(key, "variables.") {
+		return true
+	}
+	for _, doc := range FieldDocs() {
+		if doc.Path == key || strings.HasPrefix(doc.Path, key+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// severityOverrides combines the configured and validator overrides.
+func (v *Validator) severityOverrides(config *ProjectConfig) map[string]string {
+	overrides := SeverityOverrides(config.Governance.Rules)
+	for field, severity := range v.Overrides {
+		overrides[field] = severity
+	}
+	return overrides
+}
+
+// applySeverityOverrides changes the severity of overridden checks and drops
+// suppressed ones.
+func applySeverityOverrides(errors ValidationErrors, overrides map[string]string) ValidationErrors {
+	if len(overrides) == 0 {
+		return errors
+	}
+
+	result := make(ValidationErrors, 0, len(errors))
+	for _, err := range errors {
+		if severity, ok := overrides[err.Field]; ok {
+			if severity == SeverityOff {
+				continue
+			}
+			err.Severity = severity
+		}
+		result = append(result, err)
+	}
+	return result
 }
 
 // validateMetadata validates project metadata.
@@ -134,6 +259,21 @@ func (v *Validator) validateMetadata(m *ProjectMetadata) ValidationErrors {
 		})
 	}
 
+	// Repository should be a URL or owner/repo that can be linked to
+	if m.Repository != "" {
+		if _, _, err := NormalizeRepoURL(m.Repository); err != nil {
+			errors = append(errors, ValidationError{
+				Field:    "metadata.repository",
+				Message:  err.Error(),
+				Value:    m.Repository,
+				Severity: "warning",
+			})
+		}
+	}
+
+	// Keywords should be accepted by package registries
+	errors = append(errors, validateKeywords(m.Keywords)...)
+
 	return errors
 }
 
@@ -151,7 +291,7 @@ func (v *Validator) validateFrontend(f *FrontendConfig) ValidationErrors {
 	} else if !isValidFrontendFramework(f.Framework) {
 		errors = append(errors, ValidationError{
 			Field:    "frontend.framework",
-			Message:  fmt.Sprintf("unsupported frontend framework: %s (supported: react, vue, svelte, angular, nextjs, nuxt, sveltekit)", f.Framework),
+			Message:  fmt.Sprintf("unsupported frontend framework: %s (supported: %s)", f.Framework, enum.FrontendFrameworks),
 			Value:    f.Framework,
 			Severity: "error",
 		})
@@ -161,17 +301,27 @@ func (v *Validator) validateFrontend(f *FrontendConfig) ValidationErrors {
 	if f.Styling != "" && !isValidStyling(f.Styling) {
 		errors = append(errors, ValidationError{
 			Field:    "frontend.styling",
-			Message:  fmt.Sprintf("unsupported styling approach: %s (supported: tailwind, css-modules, styled-components, scss)", f.Styling),
+			Message:  fmt.Sprintf("unsupported styling approach: %s (supported: %s)", f.Styling, enum.StylingOptions),
 			Value:    f.Styling,
 			Severity: "error",
 		})
 	}
 
+	// Node version validation
+	if f.NodeVersion != "" && !nodeVersionRegex.MatchString(f.NodeVersion) {
+		errors = append(errors, ValidationError{
+			Field:    "frontend.node_version",
+			Message:  fmt.Sprintf("invalid Node.js version: %s (expected a version such as 20 or 20.11)", f.NodeVersion),
+			Value:    f.NodeVersion,
+			Severity: "error",
+		})
+	}
+
 	// Package manager validation
 	if f.PackageManager != "" && !isValidPackageManager(f.PackageManager) {
 		errors = append(errors, ValidationError{
 			Field:    "frontend.package_manager",
-			Message:  fmt.Sprintf("unsupported package manager: %s (supported: npm, yarn, pnpm, bun)", f.PackageManager),
+			Message:  fmt.Sprintf("unsupported package manager: %s (supported: %s)", f.PackageManager, enum.PackageManagers),
 			Value:    f.PackageManager,
 			Severity: "error",
 		})
@@ -181,7 +331,7 @@ func (v *Validator) validateFrontend(f *FrontendConfig) ValidationErrors {
 	if f.BuildTool != "" && !isValidBuildTool(f.BuildTool) {
 		errors = append(errors, ValidationError{
 			Field:    "frontend.build_tool",
-			Message:  fmt.Sprintf("unsupported build tool: %s (supported: vite, webpack, esbuild, rollup, turbopack)", f.BuildTool),
+			Message:  fmt.Sprintf("unsupported build tool: %s (supported: %s)", f.BuildTool, enum.BuildTools),
 			Value:    f.BuildTool,
 			Severity: "error",
 		})
@@ -223,7 +373,7 @@ func (v *Validator) validateBackend(b *BackendConfig) ValidationErrors {
 	} else if !isValidBackendFramework(b.Framework) {
 		errors = append(errors, ValidationError{
 			Field:    "backend.framework",
-			Message:  fmt.Sprintf("unsupported backend framework: %s (supported: fastapi, express, nestjs, go-gin, go-fiber, rust-axum, django, rails)", b.Framework),
+			Message:  fmt.Sprintf("unsupported backend framework: %s (supported: %s)", b.Framework, enum.BackendFrameworks),
 			Value:    b.Framework,
 			Severity: "error",
 		})
@@ -256,6 +406,113 @@ func (v *Validator) validateBackend(b *BackendConfig) ValidationErrors {
 		})
 	}
 
+	// Feature prerequisites
+	errors = append(errors, v.validateBackendFeatures(b)...)
+
+	// Framework and ORM combination
+	errors = append(errors, v.validateBackendConsistency(b)...)
+
+	return errors
+}
+
+// featurePrerequisite describes something a backend feature typically
+// depends on.
+type featurePrerequisite struct {
+	field       string
+	requirement string
+	enabled     func(b *BackendConfig) bool
+	satisfied   func(b *BackendConfig) bool
+}
+
+// featurePrerequisites lists the prerequisites checked for enabled backend
+// features. Missing prerequisites are reported as warnings.
+var featurePrerequisites = []featurePrerequisite{
+	{
+		field:       "backend.features.background_jobs",
+		requirement: "background jobs need Redis or another queue backend",
+		enabled:     func(b *BackendConfig) bool { return b.Features.BackgroundJobs },
+		satisfied:   func(b *BackendConfig) bool { return b.Database.Redis },
+	},
+	{
+		field:       "backend.features.rate_limiting",
+		requirement: "rate limiting usually needs Redis to share limits between instances",
+		enabled:     func(b *BackendConfig) bool { return b.Features.RateLimiting },
+		satisfied:   func(b *BackendConfig) bool { return b.Database.Redis },
+	},
+	{
+		field:       "backend.features.email",
+		requirement: "email sending needs an email provider",
+		enabled:     func(b *BackendConfig) bool { return b.Features.Email },
+		satisfied:   func(b *BackendConfig) bool { return b.Features.EmailProvider != "" },
+	},
+}
+
+// validateBackendFeatures warns about enabled features whose typical
+// prerequisites are missing.
+func (v *Validator) validateBackendFeatures(b *BackendConfig) ValidationErrors {
+	var errors ValidationErrors
+
+	for _, prereq := range featurePrerequisites {
+		if !prereq.enabled(b) || prereq.satisfied(b) {
+			continue
+		}
+		errors = append(errors, ValidationError{
+			Field:    prereq.field,
+			Message:  prereq.requirement,
+			Severity: "warning",
+		})
+	}
+
+	return errors
+}
+
+// frameworkORM lists the ORMs that fit a family of backend frameworks.
+type frameworkORM struct {
+	frameworks []string
+	prefix     string
+	orms       []string
+}
+
+// frameworkORMs maps backend frameworks to the ORMs written for their
+// language. Frameworks missing from the table are not checked.
+var frameworkORMs = []frameworkORM{
+	{prefix: "go-", orms: []string{"gorm", "ent", "sqlboiler"}},
+	{frameworks: []string{"fastapi", "django"}, orms: []string{"sqlalchemy", "django-orm", "tortoise"}},
+	{frameworks: []string{"express", "nestjs"}, orms: []string{"prisma", "typeorm", "drizzle", "mongoose"}},
+}
+
+// expectedORMs returns the ORMs that fit framework, or nil when the
+// framework is not in frameworkORMs.
+func expectedORMs(framework string) []string {
+	for _, entry := range frameworkORMs {
+		if contains(entry.frameworks, framework) ||
+			(entry.prefix != "" && strings.HasPrefix(framework, entry.prefix)) {
+			return entry.orms
+		}
+	}
+	return nil
+}
+
+// validateBackendConsistency warns when the ORM is written for a different
+// language than the backend framework.
+func (v *Validator) validateBackendConsistency(b *BackendConfig) ValidationErrors {
+	var errors ValidationErrors
+
+	orm := b.Database.ORM
+	if orm == "" || b.Framework == "" {
+		return errors
+	}
+
+	orms := expectedORMs(b.Framework)
+	if orms != nil && !contains(orms, orm) {
+		errors = append(errors, ValidationError{
+			Field:    "backend.database.orm",
+			Message:  fmt.Sprintf("ORM %s is not used with %s (expected one of: %s)", orm, b.Framework, strings.Join(orms, ", ")),
+			Value:    orm,
+			Severity: "warning",
+		})
+	}
+
 	return errors
 }
 
@@ -267,7 +524,7 @@ func (v *Validator) validateDatabase(d *DatabaseConfig) ValidationErrors {
 	if d.Primary != "" && !isValidDatabase(d.Primary) {
 		errors = append(errors, ValidationError{
 			Field:    "backend.database.primary",
-			Message:  fmt.Sprintf("unsupported database: %s (supported: postgresql, mysql, sqlite, mongodb, mariadb, cockroachdb)", d.Primary),
+			Message:  fmt.Sprintf("unsupported database: %s (supported: %s)", d.Primary, enum.Databases),
 			Value:    d.Primary,
 			Severity: "error",
 		})
@@ -283,6 +540,24 @@ func (v *Validator) validateDatabase(d *DatabaseConfig) ValidationErrors {
 		})
 	}
 
+	// Migrations need a relational schema and a tool to run them
+	if d.Primary != "" && d.Migrations {
+		if isDocumentDatabase(d.Primary) {
+			errors = append(errors, ValidationError{
+				Field:    "backend.database.migrations",
+				Message:  fmt.Sprintf("migrations have no effect with document store %s", d.Primary),
+				Value:    d.Primary,
+				Severity: "warning",
+			})
+		} else if d.ORM == "" {
+			errors = append(errors, ValidationError{
+				Field:    "backend.database.migrations",
+				Message:  "migrations are enabled but no ORM or migration tool is selected",
+				Severity: "error",
+			})
+		}
+	}
+
 	return errors
 }
 
@@ -294,7 +569,7 @@ func (v *Validator) validateAuth(a *AuthConfig) ValidationErrors {
 	if a.Provider != "" && !isValidAuthProvider(a.Provider) {
 		errors = append(errors, ValidationError{
 			Field:    "backend.auth.provider",
-			Message:  fmt.Sprintf("unsupported auth provider: %s (supported: jwt, oauth, clerk, auth0, firebase, nextauth, passport)", a.Provider),
+			Message:  fmt.Sprintf("unsupported auth provider: %s (supported: %s)", a.Provider, enum.AuthProviders),
 			Value:    a.Provider,
 			Severity: "error",
 		})
@@ -310,9 +585,31 @@ func (v *Validator) validateAuth(a *AuthConfig) ValidationErrors {
 		})
 	}
 
+	if a.SessionDuration > maxSessionDuration {
+		errors = append(errors, ValidationError{
+			Field:    "backend.auth.session_duration",
+			Message:  fmt.Sprintf("session duration of %d hours is longer than %d days", a.SessionDuration, maxSessionDuration/24),
+			Value:    a.SessionDuration,
+			Severity: "warning",
+		})
+	}
+
+	if a.SessionDuration == 0 && enum.SessionAuthProviders.Contains(a.Provider) {
+		errors = append(errors, ValidationError{
+			Field:    "backend.auth.session_duration",
+			Message:  fmt.Sprintf("%s issues sessions but the session duration is 0", a.Provider),
+			Value:    a.SessionDuration,
+			Severity: "warning",
+		})
+	}
+
 	return errors
 }
 
+// maxSessionDuration is the longest session, in hours, accepted without a
+// warning.
+const maxSessionDuration = 30 * 24
+
 // validateAPI validates API configuration.
 func (v *Validator) validateAPI(a *APIConfig) ValidationErrors {
 	var errors ValidationErrors
@@ -321,7 +618,7 @@ func (v *Validator) validateAPI(a *APIConfig) ValidationErrors {
 	if a.Style != "" && !isValidAPIStyle(a.Style) {
 		errors = append(errors, ValidationError{
 			Field:    "backend.api.style",
-			Message:  fmt.Sprintf("unsupported API style: %s (supported: rest, graphql, grpc, trpc)", a.Style),
+			Message:  fmt.Sprintf("unsupported API style: %s (supported: %s)", a.Style, enum.APIStyles),
 			Value:    a.Style,
 			Severity: "error",
 		})
@@ -331,7 +628,7 @@ func (v *Validator) validateAPI(a *APIConfig) ValidationErrors {
 	if a.Versioning != "" && !isValidAPIVersioning(a.Versioning) {
 		errors = append(errors, ValidationError{
 			Field:    "backend.api.versioning",
-			Message:  fmt.Sprintf("unsupported API versioning: %s (supported: url, header, query, none)", a.Versioning),
+			Message:  fmt.Sprintf("unsupported API versioning: %s (supported: %s)", a.Versioning, enum.APIVersioningStrategies),
 			Value:    a.Versioning,
 			Severity: "error",
 		})
@@ -348,7 +645,7 @@ func (v *Validator) validateInfrastructure(i *InfrastructureConfig) ValidationEr
 	if i.CI != "" && !isValidCI(i.CI) {
 		errors = append(errors, ValidationError{
 			Field:    "infrastructure.ci",
-			Message:  fmt.Sprintf("unsupported CI platform: %s (supported: github-actions, gitlab-ci, circleci, jenkins, azure-pipelines)", i.CI),
+			Message:  fmt.Sprintf("unsupported CI platform: %s (supported: %s)", i.CI, enum.CIProviders),
 			Value:    i.CI,
 			Severity: "error",
 		})
@@ -358,7 +655,7 @@ func (v *Validator) validateInfrastructure(i *InfrastructureConfig) ValidationEr
 	if i.Hosting != "" && !isValidHosting(i.Hosting) {
 		errors = append(errors, ValidationError{
 			Field:    "infrastructure.hosting",
-			Message:  fmt.Sprintf("unsupported hosting platform: %s (supported: vercel, netlify, aws, gcp, azure, digitalocean, railway, render, fly)", i.Hosting),
+			Message:  fmt.Sprintf("unsupported hosting platform: %s (supported: %s)", i.Hosting, enum.HostingProviders),
 			Value:    i.Hosting,
 			Severity: "error",
 		})
@@ -376,6 +673,65 @@ func (v *Validator) validateInfrastructure(i *InfrastructureConfig) ValidationEr
 	return errors
 }
 
+// hostingPlatform describes what a hosting platform can run.
+type hostingPlatform struct {
+	// containers is true if the platform runs container workloads
+	containers bool
+
+	// servers is true if the platform runs long-lived backend processes
+	servers bool
+}
+
+// hostingPlatforms lists the capabilities of known hosting platforms.
+// Platforms missing from the map are not checked.
+var hostingPlatforms = map[string]hostingPlatform{
+	"vercel":       {},
+	"netlify":      {},
+	"cloudflare":   {},
+	"railway":      {containers: true, servers: true},
+	"render":       {containers: true, servers: true},
+	"fly":          {containers: true, servers: true},
+	"heroku":       {containers: true, servers: true},
+	"aws":          {containers: true, servers: true},
+	"gcp":          {containers: true, servers: true},
+	"azure":        {containers: true, servers: true},
+	"digitalocean": {containers: true, servers: true},
+	"self-hosted":  {containers: true, servers: true},
+}
+
+// validateHosting warns about infrastructure the hosting platform cannot run.
+func (v *Validator) validateHosting(config *ProjectConfig) ValidationErrors {
+	var errors ValidationErrors
+
+	infra := config.Infrastructure
+	platform, ok := hostingPlatforms[infra.Hosting]
+	if !ok {
+		return nil
+	}
+
+	// Docker alone is fine for local development, but Kubernetes manifests
+	// are only useful where containers are deployed
+	if infra.Kubernetes && !platform.containers {
+		errors = append(errors, ValidationError{
+			Field:    "infrastructure.hosting",
+			Message:  fmt.Sprintf("%s does not run containers, so Kubernetes manifests cannot be deployed there", infra.Hosting),
+			Value:    infra.Hosting,
+			Severity: "warning",
+		})
+	}
+
+	if config.Backend.Enabled && !config.Frontend.Enabled && !platform.servers {
+		errors = append(errors, ValidationError{
+			Field:    "infrastructure.hosting",
+			Message:  fmt.Sprintf("%s is serverless and cannot host a standalone backend", infra.Hosting),
+			Value:    infra.Hosting,
+			Severity: "warning",
+		})
+	}
+
+	return errors
+}
+
 // validateGovernance validates governance configuration.
 func (v *Validator) validateGovernance(g *GovernanceConfig) ValidationErrors {
 	var errors ValidationErrors
@@ -384,12 +740,71 @@ func (v *Validator) validateGovernance(g *GovernanceConfig) ValidationErrors {
 	if g.ContextLevel != "" && !isValidContextLevel(g.ContextLevel) {
 		errors = append(errors, ValidationError{
 			Field:    "governance.context_level",
-			Message:  fmt.Sprintf("invalid context level: %s (supported: minimal, standard, comprehensive)", g.ContextLevel),
+			Message:  fmt.Sprintf("invalid context level: %s (supported: %s)", g.ContextLevel, enum.ContextLevels),
 			Value:    g.ContextLevel,
 			Severity: "error",
 		})
 	}
 
+	for _, target := range g.AssistantTargets {
+		if !enum.AssistantTargets.Contains(target) {
+			errors = append(errors, ValidationError{
+				Field:    "governance.assistant_targets",
+				Message:  fmt.Sprintf("invalid assistant target: %s (supported: %s)", target, enum.AssistantTargets),
+				Value:    target,
+				Severity: "error",
+			})
+		}
+	}
+
+	for _, pattern := range g.Rules.ExcludePatterns {
+		if err := utils.ValidateGlob(strings.TrimPrefix(pattern, "!")); err != nil {
+			errors = append(errors, ValidationError{
+				Field:    "governance.rules.exclude_patterns",
+				Message:  err.Error(),
+				Value:    pattern,
+				Severity: "error",
+			})
+		}
+	}
+
+	// Rule severity overrides must use a known severity
+	for field, rule := range g.Rules.Rules {
+		if rule.Severity != "" && !isValidSeverity(strings.ToLower(rule.Severity)) {
+			errors = append(errors, ValidationError{
+				Field:    "governance.rules.rules." + field,
+				Message:  fmt.Sprintf("invalid severity: %s (supported: error, warning, info, off)", rule.Severity),
+				Value:    rule.Severity,
+				Severity: "error",
+			})
+		}
+	}
+
+	return errors
+}
+
+// validateVariables validates custom variable names. Names must be valid
+// template identifiers so they can be used as .Vars.name.
+func (v *Validator) validateVariables(vars map[string]string) ValidationErrors {
+	var errors ValidationErrors
+
+	keys := make([]string, 0, len(vars))
+	for key := range vars {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !variableNameRegex.MatchString(key) {
+			errors = append(errors, ValidationError{
+				Field:    "variables." + key,
+				Message:  fmt.Sprintf("invalid variable name: %q (use letters, digits and underscores, starting with a letter or underscore)", key),
+				Value:    key,
+				Severity: "error",
+			})
+		}
+	}
+
 	return errors
 }
 
@@ -415,6 +830,17 @@ func (v *Validator) validateDependencies(config *ProjectConfig) ValidationErrors
 		})
 	}
 
+	// Frontend and backend must not write into the same directory tree
+	if config.Frontend.Enabled && config.Backend.Enabled &&
+		directoriesOverlap(config.Frontend.Directory, config.Backend.Directory) {
+		errors = append(errors, ValidationError{
+			Field:    "backend.directory",
+			Message:  fmt.Sprintf("backend directory %q overlaps frontend directory %q", config.Backend.Directory, config.Frontend.Directory),
+			Value:    config.Backend.Directory,
+			Severity: "error",
+		})
+	}
+
 	// Docker Compose is useful with backend
 	if config.Backend.Enabled && !config.Infrastructure.DockerCompose {
 		errors = append(errors, ValidationError{
@@ -440,6 +866,10 @@ func (v *Validator) validateDependencies(config *ProjectConfig) ValidationErrors
 
 var projectNameRegex = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
 
+var variableNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+var nodeVersionRegex = regexp.MustCompile(`^\d+(\.\d+){0,2}$`)
+
 func isValidProjectName(name string) bool {
 	return projectNameRegex.MatchString(name) && len(name) <= 100
 }
@@ -451,59 +881,42 @@ func isValidSemver(version string) bool {
 }
 
 func isValidFrontendFramework(framework string) bool {
-	validFrameworks := []string{
-		"react", "vue", "svelte", "angular",
-		"nextjs", "nuxt", "sveltekit", "remix",
-		"astro", "solid",
-	}
-	return contains(validFrameworks, framework)
+	return enum.FrontendFrameworks.Contains(framework)
 }
 
 func isValidBackendFramework(framework string) bool {
-	validFrameworks := []string{
-		"fastapi", "express", "nestjs", "django",
-		"go-gin", "go-fiber", "go-echo",
-		"rust-axum", "rust-actix", "rust-rocket",
-		"rails", "phoenix", "spring",
-	}
-	return contains(validFrameworks, framework)
+	return enum.BackendFrameworks.Contains(framework)
 }
 
 func isValidStyling(styling string) bool {
-	validStyling := []string{
-		"tailwind", "css-modules", "styled-components",
-		"scss", "sass", "less", "emotion", "stitches",
-	}
-	return contains(validStyling, styling)
+	return enum.StylingOptions.Contains(styling)
 }
 
 func isValidPackageManager(pm string) bool {
-	validPM := []string{"npm", "yarn", "pnpm", "bun"}
-	return contains(validPM, pm)
+	return enum.PackageManagers.Contains(pm)
+}
+
+// buildToolValues returns the build tools the validator accepts: the
+// supported ones followed by their deprecated spellings, which Analyze
+// reports separately.
+func buildToolValues() []string {
+	return append(enum.BuildTools.Strings(), deprecatedValues("frontend.build_tool")...)
 }
 
 func isValidBuildTool(tool string) bool {
-	validTools := []string{
-		"vite", "webpack", "esbuild", "rollup",
-		"turbo", "turboPack", "parcel", "swc",
-	}
-	return contains(validTools, tool)
+	return contains(buildToolValues(), tool)
 }
 
 func supportsSSR(framework string) bool {
-	ssrFrameworks := []string{
-		"nextjs", "nuxt", "sveltekit", "remix",
-		"astro", "angular",
-	}
-	return contains(ssrFrameworks, framework)
+	return enum.SSRFrameworks.Contains(framework)
 }
 
 func isValidDatabase(db string) bool {
-	validDB := []string{
-		"postgresql", "mysql", "sqlite", "mongodb",
-		"mariadb", "cockroachdb", "planetscale",
-	}
-	return contains(validDB, db)
+	return enum.Databases.Contains(db)
+}
+
+func isDocumentDatabase(db string) bool {
+	return enum.DocumentDatabases.Contains(db)
 }
 
 func isValidORMForDatabase(orm, db string) bool {
@@ -527,46 +940,48 @@ func isValidORMForDatabase(orm, db string) bool {
 }
 
 func isValidAuthProvider(provider string) bool {
-	validProviders := []string{
-		"jwt", "oauth", "oidc",
-		"clerk", "auth0", "firebase",
-		"nextauth", "passport", "lucia",
-		"supabase", "cognito",
-	}
-	return contains(validProviders, provider)
+	return enum.AuthProviders.Contains(provider)
 }
 
 func isValidAPIStyle(style string) bool {
-	validStyles := []string{"rest", "graphql", "grpc", "trpc", "tsoa"}
-	return contains(validStyles, style)
+	return enum.APIStyles.Contains(style)
 }
 
 func isValidAPIVersioning(versioning string) bool {
-	validVersioning := []string{"url", "header", "query", "none"}
-	return contains(validVersioning, versioning)
+	return enum.APIVersioningStrategies.Contains(versioning)
 }
 
 func isValidCI(ci string) bool {
-	validCI := []string{
-		"github-actions", "gitlab-ci", "circleci",
-		"jenkins", "azure-pipelines", "travis",
-		"bitbucket-pipelines", "buildkite",
-	}
-	return contains(validCI, ci)
+	return enum.CIProviders.Contains(ci)
 }
 
 func isValidHosting(hosting string) bool {
-	validHosting := []string{
-		"vercel", "netlify", "aws", "gcp", "azure",
-		"digitalocean", "railway", "render", "fly",
-		"heroku", "cloudflare", "self-hosted",
-	}
-	return contains(validHosting, hosting)
+	return enum.HostingProviders.Contains(hosting)
 }
 
 func isValidContextLevel(level string) bool {
-	validLevels := []string{"minimal", "standard", "comprehensive"}
-	return contains(validLevels, level)
+	return enum.ContextLevels.Contains(level)
+}
+
+func isValidSeverity(severity string) bool {
+	validSeverities := []string{"error", "warning", "info", SeverityOff}
+	return contains(validSeverities, severity)
+}
+
+// directoriesOverlap reports whether two project-relative directories are
+// equal or one is nested inside the other. The project root only overlaps
+// itself, so a frontend at the root can sit next to a backend in api/.
+func directoriesOverlap(a, b string) bool {
+	a = path.Clean(filepath.ToSlash(a))
+	b = path.Clean(filepath.ToSlash(b))
+
+	if a == b {
+		return true
+	}
+	if a == "." || b == "." {
+		return false
+	}
+	return strings.HasPrefix(a, b+"/") || strings.HasPrefix(b, a+"/")
 }
 
 func contains(slice []string, item string) bool {
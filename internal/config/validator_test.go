@@ -0,0 +1,435 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/clause-cli/clause/internal/config/enum"
+	"gopkg.in/yaml.v3"
+)
+
+func TestDirectoriesOverlap(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"frontend", "backend", false},
+		{"frontend", "frontend", true},
+		{"frontend/", "./frontend", true},
+		{"apps/web", "apps", true},
+		{"apps", "apps/api", true},
+		{"apps/web", "apps/api", false},
+		{"web", "webapp", false},
+		{".", "backend", false},
+		{"frontend", ".", false},
+		{".", "", true},
+		{".", ".", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			if got := directoriesOverlap(tt.a, tt.b); got != tt.want {
+				t.Errorf("directoriesOverlap(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidatorSeverityOverrides(t *testing.T) {
+	const (
+		monitoring = "infrastructure.monitoring.enabled"
+		compose    = "infrastructure.docker_compose"
+	)
+
+	tests := []struct {
+		name      string
+		overrides map[string]string
+		rules     map[string]RuleConfig
+		want      map[string]string // field -> severity, "" when omitted
+	}{
+		{
+			name: "no overrides",
+			want: map[string]string{monitoring: "warning", compose: "warning"},
+		},
+		{
+			name:      "suppress monitoring",
+			overrides: map[string]string{monitoring: SeverityOff},
+			want:      map[string]string{monitoring: "", compose: "warning"},
+		},
+		{
+			name:      "upgrade docker compose",
+			overrides: map[string]string{compose: "error"},
+			want:      map[string]string{monitoring: "warning", compose: "error"},
+		},
+		{
+			name:  "rules suppress monitoring",
+			rules: map[string]RuleConfig{monitoring: {Severity: "off"}},
+			want:  map[string]string{monitoring: "", compose: "warning"},
+		},
+		{
+			name:  "disabled rule suppresses",
+			rules: map[string]RuleConfig{monitoring: {Enabled: false}},
+			want:  map[string]string{monitoring: "", compose: "warning"},
+		},
+		{
+			name:  "rules upgrade docker compose",
+			rules: map[string]RuleConfig{compose: {Enabled: true, Severity: "Error"}},
+			want:  map[string]string{monitoring: "warning", compose: "error"},
+		},
+		{
+			name:      "validator overrides win over rules",
+			overrides: map[string]string{compose: "info"},
+			rules:     map[string]RuleConfig{compose: {Enabled: true, Severity: "error"}},
+			want:      map[string]string{monitoring: "warning", compose: "info"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newDefaultConfig()
+			cfg.Backend.Enabled = true
+			cfg.Infrastructure.DockerCompose = false
+			cfg.Infrastructure.Monitoring.Enabled = false
+			cfg.Governance.Rules.Rules = tt.rules
+
+			v := NewValidator()
+			v.Overrides = tt.overrides
+
+			got := make(map[string]string)
+			for _, err := range v.Validate(cfg) {
+				got[err.Field] = err.Severity
+			}
+			for field, want := range tt.want {
+				if got[field] != want {
+					t.Errorf("%s severity = %q, want %q", field, got[field], want)
+				}
+			}
+		})
+	}
+}
+
+func TestSeverityOverrides(t *testing.T) {
+	rules := GovernanceRules{Rules: map[string]RuleConfig{
+		"infrastructure.monitoring.enabled": {Enabled: true, Severity: "Error"},
+		"infrastructure.docker_compose":     {Enabled: false},
+		"frontend":                          {Enabled: true, Severity: "info"},
+		"variables.company":                 {Enabled: true},
+		"changelog":                         {Enabled: true, Severity: "off"},
+		"no-such-rule":                      {Enabled: false},
+	}}
+
+	want := map[string]string{
+		"infrastructure.monitoring.enabled": "error",
+		"infrastructure.docker_compose":     SeverityOff,
+		"frontend":                          "info",
+	}
+	if got := SeverityOverrides(rules); !reflect.DeepEqual(got, want) {
+		t.Errorf("SeverityOverrides() = %v, want %v", got, want)
+	}
+}
+
+func TestRuleConfigEnabledByDefault(t *testing.T) {
+	tests := []struct {
+		name     string
+		yaml     string
+		json     string
+		want     bool
+		severity string // the override, "" for none
+	}{
+		{"severity only", "severity: error", `{"severity": "error"}`, true, "error"},
+		{"options only", "options: {max: 3}", `{"options": {"max": 3}}`, true, ""},
+		{"disabled", "enabled: false", `{"enabled": false}`, false, SeverityOff},
+		{"enabled", "enabled: true", `{"enabled": true}`, true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fromYAML, fromJSON RuleConfig
+			if err := yaml.Unmarshal([]byte(tt.yaml), &fromYAML); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tt.json), &fromJSON); err != nil {
+				t.Fatal(err)
+			}
+			if fromYAML.Enabled != tt.want || fromJSON.Enabled != tt.want {
+				t.Errorf("enabled = %v (YAML), %v (JSON), want %v", fromYAML.Enabled, fromJSON.Enabled, tt.want)
+			}
+			if severity, _ := fromYAML.SeverityOverride(); severity != tt.severity {
+				t.Errorf("SeverityOverride() = %q, want %q", severity, tt.severity)
+			}
+		})
+	}
+}
+
+func TestValidateVariables(t *testing.T) {
+	tests := []struct {
+		key     string
+		wantErr bool
+	}{
+		{"company", false},
+		{"API_BASE_URL", false},
+		{"_private", false},
+		{"v2", false},
+		{"2fast", true},
+		{"api-url", true},
+		{"company name", true},
+		{"", true},
+		{"{{.}}", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			errs := NewValidator().validateVariables(map[string]string{tt.key: "value"})
+			if got := len(errs) > 0; got != tt.wantErr {
+				t.Fatalf("validateVariables(%q) errors = %v, wantErr %v", tt.key, errs, tt.wantErr)
+			}
+			if tt.wantErr && errs[0].Field != "variables."+tt.key {
+				t.Errorf("error field = %q, want %q", errs[0].Field, "variables."+tt.key)
+			}
+		})
+	}
+}
+
+func TestValidateBackendFeatures(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(b *BackendConfig)
+		want   []string
+	}{
+		{"nothing enabled", func(b *BackendConfig) {}, nil},
+		{
+			"background jobs without redis",
+			func(b *BackendConfig) { b.Features.BackgroundJobs = true },
+			[]string{"backend.features.background_jobs"},
+		},
+		{
+			"background jobs with redis",
+			func(b *BackendConfig) { b.Features.BackgroundJobs = true; b.Database.Redis = true },
+			nil,
+		},
+		{
+			"rate limiting without redis",
+			func(b *BackendConfig) { b.Features.RateLimiting = true },
+			[]string{"backend.features.rate_limiting"},
+		},
+		{
+			"email without provider",
+			func(b *BackendConfig) { b.Features.Email = true },
+			[]string{"backend.features.email"},
+		},
+		{
+			"email with provider",
+			func(b *BackendConfig) { b.Features.Email = true; b.Features.EmailProvider = "resend" },
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b BackendConfig
+			tt.mutate(&b)
+
+			var got []string
+			for _, err := range NewValidator().validateBackendFeatures(&b) {
+				if err.Severity != "warning" {
+					t.Errorf("%s severity = %q, want warning", err.Field, err.Severity)
+				}
+				got = append(got, err.Field)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("warnings for %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateDatabaseMigrations(t *testing.T) {
+	tests := []struct {
+		name         string
+		db           DatabaseConfig
+		wantSeverity string // "" when migrations are not reported
+	}{
+		{"mongodb with migrations", DatabaseConfig{Primary: "mongodb", ORM: "mongoose", Migrations: true}, "warning"},
+		{"mongodb without migrations", DatabaseConfig{Primary: "mongodb", ORM: "mongoose"}, ""},
+		{"postgres with migrations and no orm", DatabaseConfig{Primary: "postgresql", Migrations: true}, "error"},
+		{"postgres with migrations and orm", DatabaseConfig{Primary: "postgresql", ORM: "prisma", Migrations: true}, ""},
+		{"postgres without migrations", DatabaseConfig{Primary: "postgresql"}, ""},
+		{"no database", DatabaseConfig{Migrations: true}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ""
+			for _, err := range NewValidator().validateDatabase(&tt.db) {
+				if err.Field == "backend.database.migrations" {
+					got = err.Severity
+				}
+			}
+			if got != tt.wantSeverity {
+				t.Errorf("migrations severity = %q, want %q", got, tt.wantSeverity)
+			}
+		})
+	}
+}
+
+func TestValidateHosting(t *testing.T) {
+	tests := []struct {
+		name       string
+		hosting    string
+		kubernetes bool
+		frontend   bool
+		backend    bool
+		want       []string
+	}{
+		{"vercel with kubernetes", "vercel", true, true, true, []string{"does not run containers"}},
+		{"aws with kubernetes", "aws", true, true, true, nil},
+		{"vercel without kubernetes", "vercel", false, true, true, nil},
+		{"vercel for api only", "vercel", false, false, true, []string{"cannot host a standalone backend"}},
+		{"netlify api only with kubernetes", "netlify", true, false, true, []string{"does not run containers", "cannot host a standalone backend"}},
+		{"railway for api only", "railway", false, false, true, nil},
+		{"unknown platform", "my-cloud", true, false, true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newDefaultConfig()
+			cfg.Infrastructure.Hosting = tt.hosting
+			cfg.Infrastructure.Kubernetes = tt.kubernetes
+			cfg.Frontend.Enabled = tt.frontend
+			cfg.Backend.Enabled = tt.backend
+
+			errs := NewValidator().validateHosting(cfg)
+			if len(errs) != len(tt.want) {
+				t.Fatalf("got %d warnings %v, want %d", len(errs), errs, len(tt.want))
+			}
+			for i, err := range errs {
+				if err.Severity != "warning" || err.Field != "infrastructure.hosting" {
+					t.Errorf("got %s %s, want a warning on infrastructure.hosting", err.Severity, err.Field)
+				}
+				if !strings.Contains(err.Message, tt.want[i]) {
+					t.Errorf("message %q does not contain %q", err.Message, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateAuthSessionDuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider string
+		duration int
+		want     []string
+	}{
+		{"10000 hours", "jwt", 10000, []string{"warning"}},
+		{"30 days", "jwt", 30 * 24, nil},
+		{"zero with jwt", "jwt", 0, []string{"warning"}},
+		{"zero without provider", "", 0, nil},
+		{"zero with hosted provider", "clerk", 0, nil},
+		{"negative", "jwt", -1, []string{"error"}},
+		{"one day", "jwt", 24, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth := &AuthConfig{Provider: tt.provider, SessionDuration: tt.duration}
+
+			var got []string
+			for _, err := range NewValidator().validateAuth(auth) {
+				if err.Field == "backend.auth.session_duration" {
+					got = append(got, err.Severity)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("session_duration issues = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnumsMatchValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		valid   func(string) bool
+		invalid []string
+	}{
+		{"frontend frameworks", enum.FrontendFrameworks.Strings(), isValidFrontendFramework, []string{"React", "ember"}},
+		{"backend frameworks", enum.BackendFrameworks.Strings(), isValidBackendFramework, []string{"FastAPI", "struts"}},
+		{"styling", enum.StylingOptions.Strings(), isValidStyling, []string{"bootstrap-3"}},
+		{"package managers", enum.PackageManagers.Strings(), isValidPackageManager, []string{"npx"}},
+		{"build tools", enum.BuildTools.Strings(), isValidBuildTool, []string{"TurboPack", "gulp"}},
+		{"databases", enum.Databases.Strings(), isValidDatabase, []string{"postgres", "oracle"}},
+		{"auth providers", enum.AuthProviders.Strings(), isValidAuthProvider, []string{"basic"}},
+		{"api styles", enum.APIStyles.Strings(), isValidAPIStyle, []string{"soap"}},
+		{"api versioning", enum.APIVersioningStrategies.Strings(), isValidAPIVersioning, []string{"date"}},
+		{"ci providers", enum.CIProviders.Strings(), isValidCI, []string{"github"}},
+		{"hosting providers", enum.HostingProviders.Strings(), isValidHosting, []string{"heroku-classic"}},
+		{"context levels", enum.ContextLevels.Strings(), isValidContextLevel, []string{"full"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.values) == 0 {
+				t.Fatal("enum has no values")
+			}
+			for _, value := range tt.values {
+				if !tt.valid(value) {
+					t.Errorf("validator rejects enum value %q", value)
+				}
+			}
+			for _, value := range tt.invalid {
+				if tt.valid(value) {
+					t.Errorf("validator accepts %q, which is not an enum value", value)
+				}
+			}
+		})
+	}
+}
+
+func TestDeprecatedSpellings(t *testing.T) {
+	tests := []struct {
+		field       string
+		value       string
+		replacement string
+		inEnum      func(string) bool
+	}{
+		{"frontend.build_tool", "turboPack", "turbopack", enum.BuildTools.Contains},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if tt.inEnum(tt.value) {
+				t.Errorf("deprecated spelling %q is an enum value", tt.value)
+			}
+			if !tt.inEnum(tt.replacement) {
+				t.Errorf("replacement %q is not an enum value", tt.replacement)
+			}
+			if !isDeprecatedValue(tt.field, tt.value) {
+				t.Errorf("%q is not recorded as deprecated for %s", tt.value, tt.field)
+			}
+
+			cfg := newDefaultConfig()
+			cfg.Metadata.Name = "demo"
+			cfg.Frontend.BuildTool = tt.value
+
+			var found bool
+			for _, issue := range Analyze(cfg).Issues {
+				if issue.Field != tt.field {
+					continue
+				}
+				if issue.Severity == "error" {
+					t.Errorf("deprecated spelling rejected: %s", issue.Message)
+				}
+				if strings.Contains(issue.Message+issue.Suggestion, tt.replacement) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("Analyze does not suggest %q for %q", tt.replacement, tt.value)
+			}
+		})
+	}
+}
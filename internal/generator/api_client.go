@@ -0,0 +1,227 @@
+package generator
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// apiClientStyle returns "rest" or "graphql" for a full-stack project whose
+// backend API the frontend can call, or "" when no client is generated.
+func (g *Generator) apiClientStyle() string {
+	if !g.Config.Frontend.Enabled || !g.Config.Backend.Enabled {
+		return ""
+	}
+
+	switch strings.ToLower(g.Config.Backend.API.Style) {
+	case "rest", "tsoa":
+		return "rest"
+	case "graphql":
+		return "graphql"
+	default:
+		return ""
+	}
+}
+
+// apiClientDependencies returns the package.json dependencies for the API
+// client.
+func (g *Generator) apiClientDependencies() []string {
+	if g.apiClientStyle() != "graphql" {
+		return nil
+	}
+	return []string{`"@urql/core": "^4.2.0"`, `"graphql": "^16.8.0"`}
+}
+
+// createAPIClient writes src/api/client.ts (or client.js) with a client for
+// the backend API.
+func (g *Generator) createAPIClient(frontendDir string) error {
+	var content string
+	switch g.apiClientStyle() {
+	case "rest":
+		content = g.generateRESTClient()
+	case "graphql":
+		content = g.generateGraphQLClient()
+	default:
+		return nil
+	}
+
+	apiDir := filepath.Join(frontendDir, "src", "api")
+	if err := g.createDirectory(apiDir); err != nil {
+		return err
+	}
+	return g.writeFile(filepath.Join(apiDir, "client."+g.frontendScriptExt()), content)
+}
+
+// apiCredentials returns the fetch credentials mode, which sends cookies
+// when the backend allows credentialed CORS requests.
+func (g *Generator) apiCredentials() string {
+	if g.Config.Backend.API.CORS.Credentials {
+		return "include"
+	}
+	return "same-origin"
+}
+
+// generateRESTClient generates a fetch wrapper for the REST API.
+func (g *Generator) generateRESTClient() string {
+	if !g.Config.Frontend.TypeScript {
+		return `// Client for the backend REST API. The base URL comes from src/config.
+
+import { API_BASE_URL } from '../config';
+
+export class ApiError extends Error {
+  constructor(status, body) {
+    super(` + "`Request failed with status ${status}`" + `);
+    this.status = status;
+    this.body = body;
+  }
+}
+
+export async function request(path, options = {}) {
+  const { body, headers, ...init } = options;
+  const response = await fetch(` + "`${API_BASE_URL}${path}`" + `, {
+    ...init,
+    credentials: '` + g.apiCredentials() + `',
+    headers: {
+      Accept: 'application/json',
+      ...(body !== undefined ? { 'Content-Type': 'application/json' } : {}),
+      ...headers,
+    },
+    body: body !== undefined ? JSON.stringify(body) : undefined,
+  });
+
+  const text = await response.text();
+  const data = text ? JSON.parse(text) : undefined;
+  if (!response.ok) {
+    throw new ApiError(response.status, data);
+  }
+  return data;
+}
+
+export const api = {
+  get: (path, options) => request(path, { ...options, method: 'GET' }),
+  post: (path, body, options) => request(path, { ...options, method: 'POST', body }),
+  put: (path, body, options) => request(path, { ...options, method: 'PUT', body }),
+  patch: (path, body, options) => request(path, { ...options, method: 'PATCH', body }),
+  delete: (path, options) => request(path, { ...options, method: 'DELETE' }),
+};
+
+export default api;
+`
+	}
+
+	return `// Client for the backend REST API. The base URL comes from src/config.
+
+import { API_BASE_URL } from '../config';
+
+export class ApiError extends Error {
+  constructor(
+    public readonly status: number,
+    public readonly body: unknown,
+  ) {
+    super(` + "`Request failed with status ${status}`" + `);
+  }
+}
+
+export interface RequestOptions extends Omit<RequestInit, 'body'> {
+  body?: unknown;
+}
+
+export async function request<T>(path: string, options: RequestOptions = {}): Promise<T> {
+  const { body, headers, ...init } = options;
+  const response = await fetch(` + "`${API_BASE_URL}${path}`" + `, {
+    ...init,
+    credentials: '` + g.apiCredentials() + `',
+    headers: {
+      Accept: 'application/json',
+      ...(body !== undefined ? { 'Content-Type': 'application/json' } : {}),
+      ...headers,
+    },
+    body: body !== undefined ? JSON.stringify(body) : undefined,
+  });
+
+  const text = await response.text();
+  const data = text ? JSON.parse(text) : undefined;
+  if (!response.ok) {
+    throw new ApiError(response.status, data);
+  }
+  return data as T;
+}
+
+export const api = {
+  get: <T>(path: string, options?: RequestOptions) =>
+    request<T>(path, { ...options, method: 'GET' }),
+  post: <T>(path: string, body?: unknown, options?: RequestOptions) =>
+    request<T>(path, { ...options, method: 'POST', body }),
+  put: <T>(path: string, body?: unknown, options?: RequestOptions) =>
+    request<T>(path, { ...options, method: 'PUT', body }),
+  patch: <T>(path: string, body?: unknown, options?: RequestOptions) =>
+    request<T>(path, { ...options, method: 'PATCH', body }),
+  delete: <T>(path: string, options?: RequestOptions) =>
+    request<T>(path, { ...options, method: 'DELETE' }),
+};
+
+export default api;
+`
+}
+
+// generateGraphQLClient generates an urql client for the GraphQL API, which
+// the backend serves at /graphql.
+func (g *Generator) generateGraphQLClient() string {
+	var b strings.Builder
+	b.WriteString("// Client for the backend GraphQL API. The base URL comes from src/config.\n\n")
+	b.WriteString("import { Client, cacheExchange, fetchExchange } from '@urql/core';\n")
+	if g.Config.Frontend.TypeScript {
+		b.WriteString("import type { AnyVariables, DocumentInput } from '@urql/core';\n")
+	}
+	b.WriteString("import { API_BASE_URL } from '../config';\n\n")
+
+	b.WriteString("export const client = new Client({\n")
+	b.WriteString("  url: `${API_BASE_URL}/graphql`,\n")
+	b.WriteString("  exchanges: [cacheExchange, fetchExchange],\n")
+	b.WriteString("  fetchOptions: { credentials: '" + g.apiCredentials() + "' },\n")
+	b.WriteString("});\n\n")
+
+	if g.Config.Frontend.TypeScript {
+		b.WriteString(`export async function query<Data, Variables extends AnyVariables = AnyVariables>(
+  document: DocumentInput<Data, Variables>,
+  variables: Variables,
+): Promise<Data> {
+  const result = await client.query(document, variables).toPromise();
+  if (result.error) {
+    throw result.error;
+  }
+  return result.data as Data;
+}
+
+export async function mutate<Data, Variables extends AnyVariables = AnyVariables>(
+  document: DocumentInput<Data, Variables>,
+  variables: Variables,
+): Promise<Data> {
+  const result = await client.mutation(document, variables).toPromise();
+  if (result.error) {
+    throw result.error;
+  }
+  return result.data as Data;
+}
+`)
+	} else {
+		b.WriteString(`export async function query(document, variables) {
+  const result = await client.query(document, variables).toPromise();
+  if (result.error) {
+    throw result.error;
+  }
+  return result.data;
+}
+
+export async function mutate(document, variables) {
+  const result = await client.mutation(document, variables).toPromise();
+  if (result.error) {
+    throw result.error;
+  }
+  return result.data;
+}
+`)
+	}
+
+	b.WriteString("\nexport default client;\n")
+	return b.String()
+}
@@ -0,0 +1,77 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAPIClient(t *testing.T) {
+	tests := []struct {
+		name       string
+		style      string
+		typescript bool
+		backend    bool
+		file       string
+		want       []string
+		notWant    []string
+		deps       bool
+	}{
+		{
+			name: "rest", style: "rest", typescript: true, backend: true,
+			file:    "src/src/api/client.ts",
+			want:    []string{"import { API_BASE_URL } from '../config';", "await fetch(", "export async function request<T>", "export const api = {"},
+			notWant: []string{"@urql/core"},
+		},
+		{
+			name: "rest javascript", style: "rest", backend: true,
+			file:    "src/src/api/client.js",
+			want:    []string{"await fetch(", "export async function request(path, options = {})"},
+			notWant: []string{"RequestOptions"},
+		},
+		{
+			name: "graphql", style: "graphql", typescript: true, backend: true,
+			file:    "src/src/api/client.ts",
+			want:    []string{"from '@urql/core';", "url: `${API_BASE_URL}/graphql`", "export async function query<Data"},
+			notWant: []string{"await fetch("},
+			deps:    true,
+		},
+		{
+			name: "backend disabled", style: "rest", typescript: true,
+			file: "src/src/api/client.ts",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, "saas")
+			cfg.Frontend.TypeScript = tt.typescript
+			cfg.Backend.Enabled = tt.backend
+			cfg.Backend.API.Style = tt.style
+
+			dir := generateProject(t, cfg)
+			if !tt.backend {
+				if projectFileExists(dir, tt.file) {
+					t.Errorf("%s generated without a backend", tt.file)
+				}
+				return
+			}
+
+			client := readProjectFile(t, dir, tt.file)
+			for _, want := range tt.want {
+				if !strings.Contains(client, want) {
+					t.Errorf("%s is missing %q:\n%s", tt.file, want, client)
+				}
+			}
+			for _, s := range tt.notWant {
+				if strings.Contains(client, s) {
+					t.Errorf("%s contains %q", tt.file, s)
+				}
+			}
+
+			pkg := readProjectFile(t, dir, "src/package.json")
+			if got := strings.Contains(pkg, `"@urql/core"`); got != tt.deps {
+				t.Errorf("package.json has @urql/core = %v, want %v", got, tt.deps)
+			}
+		})
+	}
+}
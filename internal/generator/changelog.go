@@ -0,0 +1,42 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// createChangelog writes CHANGELOG.md when
+// governance.documentation.changelog is set.
+func (g *Generator) createChangelog(projectPath string) error {
+	if !g.Config.Governance.Documentation.Changelog {
+		return nil
+	}
+	return g.writeFile(filepath.Join(projectPath, "CHANGELOG.md"), g.generateChangelog())
+}
+
+// generateChangelog generates a CHANGELOG.md in the Keep a Changelog format
+// with an Unreleased section and the initial version.
+func (g *Generator) generateChangelog() string {
+	version := g.Config.Metadata.Version
+	if version == "" {
+		version = "0.1.0"
+	}
+
+	created := g.Config.Metadata.CreatedAt
+	if created.IsZero() {
+		created = time.Now()
+	}
+
+	var b strings.Builder
+	b.WriteString("# Changelog\n\n")
+	b.WriteString("All notable changes to this project are documented in this file.\n\n")
+	b.WriteString("The format is based on [Keep a Changelog](https://keepachangelog.com/en/1.1.0/),\n")
+	b.WriteString("and this project adheres to [Semantic Versioning](https://semver.org/spec/v2.0.0.html).\n\n")
+	b.WriteString("## [Unreleased]\n\n")
+	b.WriteString(fmt.Sprintf("## [%s] - %s\n\n", version, created.Format("2006-01-02")))
+	b.WriteString("### Added\n\n")
+	b.WriteString("- Initial project structure\n")
+	return b.String()
+}
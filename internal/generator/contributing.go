@@ -0,0 +1,144 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// createContributing writes CONTRIBUTING.md when
+// governance.documentation.contributing is set.
+func (g *Generator) createContributing(projectPath string) error {
+	if !g.Config.Governance.Documentation.Contributing {
+		return nil
+	}
+	return g.writeFile(filepath.Join(projectPath, "CONTRIBUTING.md"), g.generateContributing())
+}
+
+// runTask returns the command that runs a task target with the project's
+// task runner.
+func (g *Generator) runTask(name string) string {
+	if strings.ToLower(g.TaskRunner) == TaskRunnerJust {
+		return "just " + name
+	}
+	return "make " + name
+}
+
+// installCommands returns the commands that install the dependencies of the
+// frontend and backend.
+func (g *Generator) installCommands() []string {
+	var commands []string
+
+	if g.Config.Frontend.Enabled {
+		pm := g.Config.Frontend.PackageManager
+		if pm == "" {
+			pm = "npm"
+		}
+		commands = append(commands, fmt.Sprintf("cd %s && %s install", g.Config.Frontend.Directory, pm))
+	}
+
+	if g.Config.Backend.Enabled {
+		dir := g.Config.Backend.Directory
+		switch g.Config.Backend.Language {
+		case "python":
+			commands = append(commands, fmt.Sprintf("cd %s && pip install -r requirements.txt", dir))
+		case "node", "typescript":
+			commands = append(commands, fmt.Sprintf("cd %s && npm install", dir))
+		case "go":
+			commands = append(commands, fmt.Sprintf("cd %s && go mod download", dir))
+		}
+	}
+
+	return commands
+}
+
+// contributingTasks returns the commands for a task, directly and through
+// the task runner, as a shell code block.
+func (g *Generator) contributingTasks(task string) string {
+	var commands []string
+	if g.Config.Frontend.Enabled {
+		if cmd := g.frontendTaskCommands()[task]; cmd != "" {
+			commands = append(commands, cmd)
+		}
+	}
+	if g.Config.Backend.Enabled {
+		if cmd := g.backendTaskCommands()[task]; cmd != "" {
+			commands = append(commands, cmd)
+		}
+	}
+	if len(commands) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("```bash\n%s\n```\n\nor run each part directly:\n\n```bash\n%s\n```\n",
+		g.runTask(task), strings.Join(commands, "\n"))
+}
+
+// generateContributing generates CONTRIBUTING.md content for the stack.
+func (g *Generator) generateContributing() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf("# Contributing to %s\n\n", g.Config.Metadata.Name))
+	b.WriteString("Thanks for helping out! This guide covers setting up the project, running the checks and what we expect from changes.\n")
+
+	b.WriteString("\n## Getting Set Up\n\n")
+	if install := g.installCommands(); len(install) > 0 {
+		b.WriteString("Install the dependencies:\n\n")
+		b.WriteString(fmt.Sprintf("```bash\n%s\n```\n", strings.Join(install, "\n")))
+	} else {
+		b.WriteString("Clone the repository; there are no dependencies to install.\n")
+	}
+
+	if dev := g.contributingTasks("dev"); dev != "" {
+		b.WriteString("\nStart the development environment with\n\n")
+		b.WriteString(dev)
+	}
+
+	b.WriteString("\n## Running Tests\n\n")
+	if test := g.contributingTasks("test"); test != "" {
+		b.WriteString("Run the test suites before opening a pull request:\n\n")
+		b.WriteString(test)
+	} else {
+		b.WriteString("No test runner is configured yet. Add tests alongside new code and document how to run them here.\n")
+	}
+
+	if lint := g.contributingTasks("lint"); lint != "" {
+		b.WriteString("\nLint the code with\n\n")
+		b.WriteString(lint)
+	}
+
+	if g.Config.Development.Hooks.CommitMsg {
+		b.WriteString(`
+## Commit Messages
+
+Commit messages follow the [Conventional Commits](https://www.conventionalcommits.org/) convention:
+
+` + "```" + `
+<type>(<optional scope>): <summary>
+` + "```" + `
+
+Use one of feat, fix, docs, style, refactor, perf, test, build, ci or chore as the type, for example ` + "`feat(api): add pagination to the users endpoint`" + `.
+`)
+	}
+
+	if g.Config.Governance.Enabled {
+		b.WriteString("\n## AI Governance\n\n")
+		b.WriteString("This project is governed by Clause, so changes made with AI assistants are held to the same standards as hand-written code:\n\n")
+		b.WriteString("- Read `ai_prompt_guidelines/architecture.md` before making structural changes, and keep it up to date.\n")
+		if g.Config.Governance.PromptGuidelines {
+			b.WriteString("- Give AI assistants the instructions in `ai_prompt_guidelines/system_prompt.md`.\n")
+		}
+		if g.Config.Governance.BrainstormMd {
+			b.WriteString("- Record open questions and trade-offs in `ai_prompt_guidelines/brainstorm.md`.\n")
+		}
+		if g.Config.Governance.ComponentRegistry {
+			b.WriteString("- Register new reusable components in `ai_prompt_guidelines/component_registry.json` instead of duplicating existing ones.\n")
+		}
+		b.WriteString("- Run `clause validate` and fix any issues before opening a pull request.\n")
+	}
+
+	b.WriteString("\n## Pull Requests\n\n")
+	b.WriteString("Keep pull requests focused on one change, describe what changed and why, and make sure the tests pass.\n")
+
+	return b.String()
+}
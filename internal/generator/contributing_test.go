@@ -0,0 +1,89 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/clause-cli/clause/internal/config"
+)
+
+func TestContributing(t *testing.T) {
+	tests := []struct {
+		name      string
+		preset    string
+		configure func(cfg *config.ProjectConfig)
+		opts      []GeneratorOption
+		want      []string
+		notWant   []string
+	}{
+		{
+			name:   "python and react with commit convention",
+			preset: "saas",
+			configure: func(cfg *config.ProjectConfig) {
+				cfg.Frontend.PackageManager = "pnpm"
+				cfg.Frontend.TestFramework = "vitest"
+				cfg.Backend.Language, cfg.Backend.Framework = "python", "fastapi"
+				cfg.Development.Hooks.CommitMsg = true
+			},
+			want: []string{
+				"make test",
+				"cd src && pnpm exec vitest run",
+				"cd backend && pytest",
+				"cd src && pnpm install",
+				"cd backend && pip install -r requirements.txt",
+				"## Commit Messages",
+				"Conventional Commits",
+			},
+		},
+		{
+			name:   "go api with just and no commit convention",
+			preset: "api-only",
+			configure: func(cfg *config.ProjectConfig) {
+				cfg.Backend.Language, cfg.Backend.Framework = "go", "go-gin"
+				cfg.Development.Hooks.CommitMsg = false
+			},
+			opts:    []GeneratorOption{WithTaskRunner(TaskRunnerJust)},
+			want:    []string{"just test", "cd backend && go test ./...", "cd backend && go mod download"},
+			notWant: []string{"make test", "## Commit Messages", "pytest"},
+		},
+		{
+			name:   "no test runner",
+			preset: "frontend-only",
+			configure: func(cfg *config.ProjectConfig) {
+				cfg.Frontend.TestFramework = ""
+			},
+			want:    []string{"No test runner is configured yet"},
+			notWant: []string{"make test"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, tt.preset)
+			cfg.Governance.Documentation.Contributing = true
+			tt.configure(cfg)
+
+			dir := generateProject(t, cfg, tt.opts...)
+			content := readProjectFile(t, dir, "CONTRIBUTING.md")
+			for _, want := range tt.want {
+				if !strings.Contains(content, want) {
+					t.Errorf("CONTRIBUTING.md does not contain %q:\n%s", want, content)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(content, notWant) {
+					t.Errorf("CONTRIBUTING.md contains %q", notWant)
+				}
+			}
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		cfg := testConfig(t, "saas")
+		cfg.Governance.Documentation.Contributing = false
+
+		if dir := generateProject(t, cfg); projectFileExists(dir, "CONTRIBUTING.md") {
+			t.Error("CONTRIBUTING.md written with contributing disabled")
+		}
+	})
+}
@@ -0,0 +1,180 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// corsEnabled reports whether the backend should be generated with CORS handling.
+func (g *Generator) corsEnabled() bool {
+	return g.Config.Backend.API.CORS.Enabled
+}
+
+// corsDefaultOrigins returns the configured origins as a comma-separated list.
+// These are the effective origins for the active profile and act as the
+// fallback when CORS_ORIGINS is not set at runtime.
+func (g *Generator) corsDefaultOrigins() string {
+	return strings.Join(g.Config.Backend.API.CORS.Origins, ",")
+}
+
+// corsMethods returns the allowed methods, defaulting to the common verbs.
+func (g *Generator) corsMethods() []string {
+	if methods := g.Config.Backend.API.CORS.Methods; len(methods) > 0 {
+		return methods
+	}
+	return []string{"GET", "POST", "PUT", "DELETE", "PATCH"}
+}
+
+// corsEnvVars returns the CORS environment placeholders.
+func (g *Generator) corsEnvVars() []envVar {
+	if !g.corsEnabled() {
+		return nil
+	}
+	return []envVar{{key: "CORS_ORIGINS", value: g.corsDefaultOrigins()}}
+}
+
+// generatePythonCORS generates app/cors.py. Origins are read from the
+// environment so each deployment can set its own list.
+func (g *Generator) generatePythonCORS() string {
+	methods := make([]string, len(g.corsMethods()))
+	for i, m := range g.corsMethods() {
+		methods[i] = fmt.Sprintf("%q", m)
+	}
+
+	credentials := "False"
+	if g.Config.Backend.API.CORS.Credentials {
+		credentials = "True"
+	}
+
+	return fmt.Sprintf(`"""
+CORS configuration.
+
+Allowed origins come from the CORS_ORIGINS environment variable
+(comma-separated), so each environment can set its own list.
+"""
+import os
+
+DEFAULT_ORIGINS = "%s"
+
+ALLOW_METHODS = [%s]
+ALLOW_CREDENTIALS = %s
+
+
+def allowed_origins() -> list[str]:
+    value = os.environ.get("CORS_ORIGINS", DEFAULT_ORIGINS)
+    return [origin.strip() for origin in value.split(",") if origin.strip()]
+`, g.corsDefaultOrigins(), strings.Join(methods, ", "), credentials)
+}
+
+// pythonCORSImport returns the main.py imports for the CORS middleware.
+func (g *Generator) pythonCORSImport() string {
+	if !g.corsEnabled() {
+		return ""
+	}
+	return "from fastapi.middleware.cors import CORSMiddleware\n" +
+		"from app.cors import ALLOW_CREDENTIALS, ALLOW_METHODS, allowed_origins\n"
+}
+
+// pythonCORSSnippet returns the middleware registration placed after the FastAPI app is created.
+func (g *Generator) pythonCORSSnippet() string {
+	if !g.corsEnabled() {
+		return ""
+	}
+	return `app.add_middleware(
+    CORSMiddleware,
+    allow_origins=allowed_origins(),
+    allow_methods=ALLOW_METHODS,
+    allow_headers=["Content-Type", "Authorization"],
+    allow_credentials=ALLOW_CREDENTIALS,
+)
+`
+}
+
+// nodeCORSSnippet returns the CORS middleware placed after the express app is created.
+func (g *Generator) nodeCORSSnippet() string {
+	if !g.corsEnabled() {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("const allowedOrigins = (process.env.CORS_ORIGINS || '%s')\n", g.corsDefaultOrigins()))
+	b.WriteString("  .split(',')\n")
+	b.WriteString("  .map((origin) => origin.trim())\n")
+	b.WriteString("  .filter(Boolean);\n\n")
+	b.WriteString("app.use((req, res, next) => {\n")
+	b.WriteString("  const origin = req.headers.origin;\n")
+	b.WriteString("  if (origin && allowedOrigins.includes(origin)) {\n")
+	b.WriteString("    res.setHeader('Access-Control-Allow-Origin', origin);\n")
+	b.WriteString(fmt.Sprintf("    res.setHeader('Access-Control-Allow-Methods', '%s');\n", strings.Join(g.corsMethods(), ", ")))
+	b.WriteString("    res.setHeader('Access-Control-Allow-Headers', 'Content-Type, Authorization');\n")
+	if g.Config.Backend.API.CORS.Credentials {
+		b.WriteString("    res.setHeader('Access-Control-Allow-Credentials', 'true');\n")
+	}
+	b.WriteString("  }\n")
+	b.WriteString("  if (req.method === 'OPTIONS') {\n")
+	b.WriteString("    return res.sendStatus(204);\n")
+	b.WriteString("  }\n")
+	b.WriteString("  next();\n")
+	b.WriteString("});\n\n")
+	return b.String()
+}
+
+// createGoCORS writes cors.go with a middleware wrapping the default mux.
+func (g *Generator) createGoCORS(backendDir string) error {
+	if !g.corsEnabled() {
+		return nil
+	}
+
+	credentials := ""
+	if g.Config.Backend.API.CORS.Credentials {
+		credentials = "\t\t\tw.Header().Set(\"Access-Control-Allow-Credentials\", \"true\")\n"
+	}
+
+	content := fmt.Sprintf(`package main
+
+import (
+	"net/http"
+	"os"
+	"strings"
+)
+
+// defaultOrigins is used when CORS_ORIGINS is not set.
+const defaultOrigins = "%s"
+
+// allowedOrigins returns the origins allowed for the current environment.
+func allowedOrigins() map[string]bool {
+	value := os.Getenv("CORS_ORIGINS")
+	if value == "" {
+		value = defaultOrigins
+	}
+
+	origins := make(map[string]bool)
+	for _, origin := range strings.Split(value, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins[origin] = true
+		}
+	}
+	return origins
+}
+
+// withCORS adds CORS headers for allowed origins.
+func withCORS(next http.Handler) http.Handler {
+	origins := allowedOrigins()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if origin := r.Header.Get("Origin"); origins[origin] {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Allow-Methods", "%s")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+%s		}
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+`, g.corsDefaultOrigins(), strings.Join(g.corsMethods(), ", "), credentials)
+
+	return g.writeFile(filepath.Join(backendDir, "cors.go"), content)
+}
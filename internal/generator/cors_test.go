@@ -0,0 +1,74 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCORSIntegration(t *testing.T) {
+	tests := []struct {
+		name      string
+		language  string
+		framework string
+		files     map[string][]string
+	}{
+		{
+			name: "python", language: "python", framework: "fastapi",
+			files: map[string][]string{
+				"backend/app/cors.py": {`DEFAULT_ORIGINS = "https://example.com,http://localhost:3000"`, `"GET", "POST"`},
+				"backend/main.py": {
+					"from app.cors import ALLOW_CREDENTIALS, ALLOW_METHODS, allowed_origins",
+					"app.add_middleware(",
+					"allow_origins=allowed_origins()",
+				},
+			},
+		},
+		{
+			name: "node", language: "node", framework: "express",
+			files: map[string][]string{
+				"backend/src/index.js": {"process.env.CORS_ORIGINS || 'https://example.com,http://localhost:3000'", "Access-Control-Allow-Origin"},
+			},
+		},
+		{
+			name: "go", language: "go", framework: "go-gin",
+			files: map[string][]string{
+				"backend/cors.go": {`const defaultOrigins = "https://example.com,http://localhost:3000"`},
+				"backend/main.go": {"withCORS(http.DefaultServeMux)"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, enabled := range []bool{true, false} {
+				cfg := testConfig(t, "saas")
+				cfg.Backend.Language = tt.language
+				cfg.Backend.Framework = tt.framework
+				cfg.Backend.API.CORS.Enabled = enabled
+				cfg.Backend.API.CORS.Origins = []string{"https://example.com", "http://localhost:3000"}
+				cfg.Backend.API.CORS.Methods = []string{"GET", "POST"}
+
+				dir := generateProject(t, cfg)
+				env := readProjectFile(t, dir, "backend/.env.example")
+				if got := strings.Contains(env, "CORS_ORIGINS="); got != enabled {
+					t.Errorf("enabled=%v: .env.example has CORS_ORIGINS = %v", enabled, got)
+				}
+
+				for file, wants := range tt.files {
+					if !projectFileExists(dir, file) {
+						if enabled {
+							t.Errorf("%s was not generated", file)
+						}
+						continue
+					}
+					content := readProjectFile(t, dir, file)
+					for _, want := range wants {
+						if got := strings.Contains(content, want); got != enabled {
+							t.Errorf("enabled=%v: %s contains %q = %v:\n%s", enabled, file, want, got, content)
+						}
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+)
+
+// devContainerImage is the base image of the generated dev container. The
+// language toolchains are added as dev container features.
+const devContainerImage = "mcr.microsoft.com/devcontainers/base:bookworm"
+
+// devContainer is the subset of devcontainer.json that Clause writes.
+type devContainer struct {
+	Name           string                       `json:"name"`
+	Image          string                       `json:"image"`
+	Features       map[string]map[string]string `json:"features,omitempty"`
+	ForwardPorts   []int                        `json:"forwardPorts,omitempty"`
+	Customizations *devContainerCustomizations  `json:"customizations,omitempty"`
+}
+
+// devContainerCustomizations holds the editor settings of a dev container.
+type devContainerCustomizations struct {
+	VSCode struct {
+		Extensions []string `json:"extensions"`
+	} `json:"vscode"`
+}
+
+// devContainerFeatures returns the dev container features that install the
+// toolchains of the frontend and backend, keyed by feature reference.
+func (g *Generator) devContainerFeatures() map[string]map[string]string {
+	features := make(map[string]map[string]string)
+
+	backend := g.Config.Backend
+	if g.Config.Frontend.Enabled || (backend.Enabled && (backend.Language == "node" || backend.Language == "typescript")) {
+		features["ghcr.io/devcontainers/features/node:1"] = map[string]string{"version": g.Config.ResolvedNodeVersion()}
+	}
+
+	if backend.Enabled {
+		switch backend.Language {
+		case "python":
+			features["ghcr.io/devcontainers/features/python:1"] = map[string]string{"version": g.backendLanguageVersion()}
+		case "go":
+			features["ghcr.io/devcontainers/features/go:1"] = map[string]string{"version": g.backendLanguageVersion()}
+		case "rust":
+			features["ghcr.io/devcontainers/features/rust:1"] = map[string]string{}
+		}
+	}
+
+	if g.Config.Infrastructure.Docker {
+		features["ghcr.io/devcontainers/features/docker-in-docker:2"] = map[string]string{}
+	}
+
+	return features
+}
+
+// devContainerPorts returns the dev server ports to forward, frontend first.
+func (g *Generator) devContainerPorts() []int {
+	var ports []int
+	add := func(port string) {
+		n, err := strconv.Atoi(port)
+		if err != nil {
+			return
+		}
+		for _, p := range ports {
+			if p == n {
+				return
+			}
+		}
+		ports = append(ports, n)
+	}
+
+	if g.Config.Frontend.Enabled {
+		add(g.frontendDevPort())
+	}
+	if g.Config.Backend.Enabled {
+		add(g.backendPort())
+	}
+	return ports
+}
+
+// generateDevContainer generates .devcontainer/devcontainer.json content.
+func (g *Generator) generateDevContainer() (string, error) {
+	dc := devContainer{
+		Name:         g.Config.Metadata.Name,
+		Image:        devContainerImage,
+		Features:     g.devContainerFeatures(),
+		ForwardPorts: g.devContainerPorts(),
+	}
+
+	if extensions := g.Config.Development.Editor.Extensions; len(extensions) > 0 {
+		dc.Customizations = &devContainerCustomizations{}
+		dc.Customizations.VSCode.Extensions = extensions
+	}
+
+	data, err := json.MarshalIndent(dc, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode devcontainer.json: %w", err)
+	}
+	return string(data) + "\n", nil
+}
+
+// createDevContainer writes .devcontainer/devcontainer.json when VS Code
+// support is enabled.
+func (g *Generator) createDevContainer(projectPath string) error {
+	if !g.Config.Development.Editor.VSCode {
+		return nil
+	}
+
+	content, err := g.generateDevContainer()
+	if err != nil {
+		return err
+	}
+	return g.writeFile(filepath.Join(projectPath, ".devcontainer", "devcontainer.json"), content)
+}
@@ -0,0 +1,97 @@
+package generator
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/clause-cli/clause/internal/config"
+)
+
+func TestDevContainer(t *testing.T) {
+	const (
+		nodeFeature   = "ghcr.io/devcontainers/features/node:1"
+		pythonFeature = "ghcr.io/devcontainers/features/python:1"
+		goFeature     = "ghcr.io/devcontainers/features/go:1"
+	)
+
+	tests := []struct {
+		name           string
+		preset         string
+		configure      func(cfg *config.ProjectConfig)
+		wantFeatures   []string
+		absent         []string
+		wantPorts      []int
+		wantExtensions []string
+	}{
+		{
+			name:   "python and react",
+			preset: "saas",
+			configure: func(cfg *config.ProjectConfig) {
+				cfg.Frontend.Framework = "react"
+				cfg.Backend.Language, cfg.Backend.Framework = "python", "fastapi"
+				cfg.Development.Editor.Extensions = []string{"ms-python.python", "dbaeumer.vscode-eslint"}
+			},
+			wantFeatures:   []string{nodeFeature, pythonFeature},
+			absent:         []string{goFeature},
+			wantPorts:      []int{5173, 8000},
+			wantExtensions: []string{"ms-python.python", "dbaeumer.vscode-eslint"},
+		},
+		{
+			name:   "go api",
+			preset: "api-only",
+			configure: func(cfg *config.ProjectConfig) {
+				cfg.Backend.Language, cfg.Backend.Framework = "go", "go-gin"
+				cfg.Development.Editor.Extensions = nil
+			},
+			wantFeatures: []string{goFeature},
+			absent:       []string{nodeFeature, pythonFeature},
+			wantPorts:    []int{8080},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, tt.preset)
+			cfg.Development.Editor.VSCode = true
+			tt.configure(cfg)
+
+			dir := generateProject(t, cfg)
+
+			var dc devContainer
+			if err := json.Unmarshal([]byte(readProjectFile(t, dir, ".devcontainer/devcontainer.json")), &dc); err != nil {
+				t.Fatalf("devcontainer.json does not parse: %v", err)
+			}
+			for _, feature := range tt.wantFeatures {
+				if _, ok := dc.Features[feature]; !ok {
+					t.Errorf("features are missing %s: %v", feature, dc.Features)
+				}
+			}
+			for _, feature := range tt.absent {
+				if _, ok := dc.Features[feature]; ok {
+					t.Errorf("features include %s: %v", feature, dc.Features)
+				}
+			}
+			if !reflect.DeepEqual(dc.ForwardPorts, tt.wantPorts) {
+				t.Errorf("forwardPorts = %v, want %v", dc.ForwardPorts, tt.wantPorts)
+			}
+
+			var extensions []string
+			if dc.Customizations != nil {
+				extensions = dc.Customizations.VSCode.Extensions
+			}
+			if !reflect.DeepEqual(extensions, tt.wantExtensions) {
+				t.Errorf("extensions = %v, want %v", extensions, tt.wantExtensions)
+			}
+		})
+	}
+
+	t.Run("vscode disabled", func(t *testing.T) {
+		cfg := testConfig(t, "saas")
+		cfg.Development.Editor.VSCode = false
+
+		if dir := generateProject(t, cfg); projectFileExists(dir, ".devcontainer/devcontainer.json") {
+			t.Error("devcontainer.json written without VS Code support")
+		}
+	})
+}
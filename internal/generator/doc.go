@@ -14,4 +14,105 @@
 //	if err := gen.Generate("/path/to/project"); err != nil {
 //	    log.Fatal(err)
 //	}
+//
+// GenerateWithEvents reports typed progress events (phase started, file
+// written, phase done, error) on a channel, which is closed when generation
+// finishes:
+//
+//	events := make(chan generator.Event)
+//	go gen.GenerateWithEvents("/path/to/project", events)
+//	for event := range events {
+//	    fmt.Println(event.Type, event.Phase)
+//	}
+//
+// GenerateWithProgress reports the step count instead, for progress bars.
+// Each event carries the section of the running step, its position out of
+// the total and the file just written:
+//
+//	progress := make(chan generator.GenerateEvent)
+//	go gen.GenerateWithProgress("/path/to/project", progress)
+//	for event := range progress {
+//	    fmt.Println(renderer.ProgressBar(event.Percent(), 40), event.Phase)
+//	}
+//
+// The .gitignore is composed from the frontend framework's build output,
+// the backend language's dependency and cache directories and the
+// infrastructure in use, with entries shared by several sections written
+// once. It is written before the initial git commit.
+//
+// Projects get a Makefile with dev, build, test, lint and docker targets.
+// Pass WithTaskRunner("just") to write a justfile with the same recipes
+// instead.
+//
+// The frontend entry point, root component and package.json dependencies
+// follow frontend.framework: vue gets src/main.ts and App.vue, svelte gets
+// src/main.ts and App.svelte, and nextjs gets an App Router src/app
+// directory with layout.tsx and page.tsx. Other frameworks get a React
+// scaffold. The .js variants are written when TypeScript is disabled. Vue
+// and Svelte also get a vite.config.ts registering their Vite plugin and,
+// with TypeScript, declarations for their component modules; Svelte gets a
+// svelte.config.js that preprocesses TypeScript.
+//
+// When development.editor.vscode is enabled, .devcontainer/devcontainer.json
+// sets up GitHub Codespaces and VS Code dev containers: the node, python,
+// go or rust features the stack needs, forwarded frontend and backend dev
+// ports and the extensions from development.editor.extensions.
+//
+// When governance.documentation.contributing is enabled, CONTRIBUTING.md
+// explains how to install, run, test and lint the stack with the task
+// runner or directly, the Conventional Commits format when
+// development.hooks.commit_msg is on, and the governance expectations.
+// When governance.documentation.changelog is enabled, CHANGELOG.md starts a
+// Keep a Changelog history with the initial version.
+//
+// When frontend.features.storybook is enabled, the frontend gets a
+// .storybook config for its framework, a sample story and the storybook
+// scripts in package.json.
+//
+// When the repository is set, package.json files get a repository entry
+// with its canonical URL, and governed projects hosted on GitHub get a
+// .github/CODEOWNERS naming the repository owner.
+//
+// Full-stack projects get a client for the backend API in
+// src/api/client.ts: a fetch wrapper for REST and tsoa backends, or an urql
+// client for GraphQL, both reading the base URL from src/config.
+//
+// Generation runs in sections (config, common, frontend, backend,
+// infrastructure, governance and git). WithSections limits a run to the
+// named sections, for example to re-create governance files, and
+// WithSkipSections excludes sections:
+//
+//	gen := generator.NewGenerator(cfg, generator.WithSections(generator.SectionGovernance))
+//
+// In dry run mode nothing is written; each file is logged as a unified diff
+// against the file on disk, or against an empty file when it is new.
+//
+// Existing files are replaced by default. WithOverwritePolicy(SkipExisting)
+// leaves them untouched and Backup copies them first; Summary lists the
+// created, skipped and overwritten paths of the last run.
+//
+// The project is built in a staging directory next to the target and only
+// moved into place once every phase has succeeded, so a failed run leaves
+// the target untouched. A new project directory is moved in one rename;
+// into an existing one each file is renamed over its target, and a merge
+// that fails part way is rolled back: created files and directories are
+// removed and overwritten files get their previous content back.
+// WithKeepOnError(true) keeps the staging directory of a failed run for
+// debugging.
+//
+// After the files are written, every generated YAML and JSON file is parsed
+// and generation fails with the paths of any files that do not parse. A dry
+// run checks the content it would have written the same way.
+//
+// Finally a clause.lock file records the runtime, framework and dependency
+// versions the project was generated with.
+//
+// AddFeature enables a feature such as metrics or storybook in an existing
+// project and writes the files it adds or changes. The returned ChangeSet
+// lists the new files, a unified diff of each modified file and the config
+// changes; in dry run mode nothing is written, so it can be previewed first.
+// Modified files follow the overwrite policy, so Backup keeps a copy of
+// files the user edited:
+//
+//	changes, err := generator.AddFeature(projectDir, "storybook", true, generator.Backup)
 package generator
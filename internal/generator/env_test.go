@@ -0,0 +1,59 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBackendEnvLoader(t *testing.T) {
+	tests := []struct {
+		name      string
+		language  string
+		framework string
+		files     map[string][]string
+	}{
+		{
+			name: "fastapi", language: "python", framework: "fastapi",
+			files: map[string][]string{
+				"backend/app/settings.py":  {"from dotenv import load_dotenv", "load_dotenv()", `database_url: str = os.environ.get("DATABASE_URL"`},
+				"backend/requirements.txt": {"python-dotenv"},
+				"backend/.env.example":     {"DATABASE_URL="},
+			},
+		},
+		{
+			name: "node", language: "node", framework: "express",
+			files: map[string][]string{
+				"backend/src/config.js": {"require('dotenv').config();", "databaseUrl: process.env.DATABASE_URL"},
+				"backend/src/index.js":  {"require('./config')"},
+				"backend/package.json":  {`"dotenv"`},
+				"backend/.env.example":  {"DATABASE_URL="},
+			},
+		},
+		{
+			name: "go", language: "go", framework: "go-gin",
+			files: map[string][]string{
+				"backend/config.go":    {"github.com/kelseyhightower/envconfig", `envconfig:"DATABASE_URL"`, "envconfig.Process"},
+				"backend/go.mod":       {"github.com/kelseyhightower/envconfig"},
+				"backend/.env.example": {"DATABASE_URL="},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, "saas")
+			cfg.Backend.Language = tt.language
+			cfg.Backend.Framework = tt.framework
+
+			dir := generateProject(t, cfg)
+			for file, wants := range tt.files {
+				content := readProjectFile(t, dir, file)
+				for _, want := range wants {
+					if !strings.Contains(content, want) {
+						t.Errorf("%s does not contain %q:\n%s", file, want, content)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package generator
+
+import "os"
+
+// EventType identifies the kind of generation progress event.
+type EventType int
+
+const (
+	// EventPhaseStarted is sent when a generation phase begins
+	EventPhaseStarted EventType = iota
+
+	// EventFileWritten is sent after a file has been written to disk
+	EventFileWritten
+
+	// EventPhaseDone is sent when a generation phase completes
+	EventPhaseDone
+
+	// EventError is sent when a phase fails; generation stops afterwards
+	EventError
+)
+
+// String returns the event type name.
+func (t EventType) String() string {
+	switch t {
+	case EventPhaseStarted:
+		return "phase_started"
+	case EventFileWritten:
+		return "file_written"
+	case EventPhaseDone:
+		return "phase_done"
+	case EventError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is a typed progress event emitted during generation.
+type Event struct {
+	// Type is the kind of event
+	Type EventType
+
+	// Phase is the phase the event belongs to
+	Phase string
+
+	// Path is the written file (EventFileWritten only)
+	Path string
+
+	// Err is the failure (EventError only)
+	Err error
+}
+
+// GenerateEvent reports step progress during GenerateWithProgress. One is
+// sent when a step starts and one after each file is written.
+type GenerateEvent struct {
+	// Phase is the section of the running step, such as "frontend",
+	// "backend" or "governance"
+	Phase string
+
+	// Step is the 1-based position of the running step
+	Step int
+
+	// Total is the number of steps in the run
+	Total int
+
+	// Path is the file just written, or empty when the step starts
+	Path string
+}
+
+// Percent returns the share of steps started, between 0 and 1.
+func (e GenerateEvent) Percent() float64 {
+	if e.Total == 0 {
+		return 0
+	}
+	return float64(e.Step) / float64(e.Total)
+}
+
+// GenerateWithProgress generates the project at the specified path and
+// reports step progress on progress, which may be nil. A non-nil channel is
+// closed when generation finishes, so consumers can range over it. Sends
+// block, so it must be drained.
+//
+// The project is built in a staging directory next to projectPath and only
+// moved into place once every step has succeeded. If a step fails, the
+// staging directory is removed and the project directory is left as it
+// was, unless KeepOnError is set.
+func (g *Generator) GenerateWithProgress(projectPath string, progress chan<- GenerateEvent) error {
+	if progress != nil {
+		g.progressEvents = progress
+		defer func() {
+			g.progressEvents = nil
+			close(progress)
+		}()
+	}
+
+	g.written = nil
+	g.previewed = nil
+	g.summary = Summary{}
+	g.changes = changeLog{}
+	g.step, g.totalSteps = 0, 0
+	g.staging, g.target = "", projectPath
+
+	root := projectPath
+	if !g.DryRun {
+		staging, err := g.createStaging(projectPath)
+		if err != nil {
+			return err
+		}
+		g.staging, root = staging, staging
+	}
+
+	err := g.generate(root, projectPath)
+	switch {
+	case g.staging == "":
+	case err != nil && g.KeepOnError:
+		g.Logger.Warn("Keeping the files of the failed run in %s", g.staging)
+	case err != nil:
+		os.RemoveAll(g.staging)
+		if rbErr := g.rollback(); rbErr != nil {
+			g.Logger.Warn("Failed to roll back generated files: %v", rbErr)
+		}
+	default:
+		os.RemoveAll(g.staging)
+	}
+	return err
+}
+
+// GenerateWithEvents generates the project like Generate and reports
+// progress on ch. The channel is closed when generation finishes, so
+// consumers can range over it. Sends block, so ch must be drained.
+func (g *Generator) GenerateWithEvents(projectPath string, ch chan<- Event) error {
+	g.events = ch
+	defer func() {
+		g.events = nil
+		close(ch)
+	}()
+
+	return g.Generate(projectPath)
+}
+
+// runPhase runs a generation phase, reporting its start and outcome.
+func (g *Generator) runPhase(name string, fn func() error) error {
+	g.phase = name
+	g.emit(Event{Type: EventPhaseStarted, Phase: name})
+	g.progress(name + "...")
+
+	if err := fn(); err != nil {
+		g.emit(Event{Type: EventError, Phase: name, Err: err})
+		return err
+	}
+
+	g.emit(Event{Type: EventPhaseDone, Phase: name})
+	return nil
+}
+
+// emit sends an event to the attached listeners. Step starts and written
+// files are also reported as a GenerateEvent.
+func (g *Generator) emit(event Event) {
+	if g.events != nil {
+		g.events <- event
+	}
+
+	if g.progressEvents != nil && (event.Type == EventPhaseStarted || event.Type == EventFileWritten) {
+		g.progressEvents <- GenerateEvent{Phase: g.section, Step: g.step, Total: g.totalSteps, Path: event.Path}
+	}
+}
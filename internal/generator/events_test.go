@@ -0,0 +1,160 @@
+package generator
+
+import (
+	"io"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/clause-cli/clause/internal/config"
+	"github.com/clause-cli/clause/pkg/output"
+)
+
+func TestGenerateWithEvents(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(cfg *config.ProjectConfig)
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "common files",
+			want: []string{
+				"phase_started Creating project directory structure",
+				"phase_done Creating project directory structure",
+				"phase_started Creating common files",
+				"file_written Creating common files",
+				"phase_done Creating common files",
+				"phase_started Verifying generated files",
+				"phase_done Verifying generated files",
+				"phase_started Moving files into place",
+				"phase_done Moving files into place",
+			},
+		},
+		{
+			name:   "invalid config",
+			mutate: func(cfg *config.ProjectConfig) { cfg.Metadata.Name = "Not A Valid Name" },
+			want: []string{
+				"phase_started Creating project directory structure",
+				"error Creating project directory structure",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, "minimal")
+			if tt.mutate != nil {
+				tt.mutate(cfg)
+			}
+
+			g := NewGenerator(cfg,
+				WithLogger(output.NewLogger(output.WithWriter(io.Discard))),
+				WithSections(SectionCommon))
+
+			ch := make(chan Event)
+			done := make(chan error, 1)
+			go func() {
+				done <- g.GenerateWithEvents(filepath.Join(t.TempDir(), "demo"), ch)
+			}()
+
+			var got []string
+			for event := range ch {
+				entry := event.Type.String() + " " + event.Phase
+				if event.Type == EventFileWritten {
+					if event.Path == "" {
+						t.Errorf("file event without a path: %+v", event)
+					}
+					// Collapse the files of a phase into one entry
+					if len(got) > 0 && got[len(got)-1] == entry {
+						continue
+					}
+				}
+				if event.Type == EventError && event.Err == nil {
+					t.Errorf("error event without an error: %+v", event)
+				}
+				got = append(got, entry)
+			}
+
+			if err := <-done; (err != nil) != tt.wantErr {
+				t.Fatalf("GenerateWithEvents error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("events =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestGenerateWithProgress(t *testing.T) {
+	tests := []struct {
+		name     string
+		sections []string
+		want     []string
+	}{
+		{
+			name:     "frontend and backend",
+			sections: []string{SectionFrontend, SectionBackend},
+			want:     []string{"setup", SectionFrontend, SectionBackend, "verify", "commit"},
+		},
+		{
+			name:     "governance",
+			sections: []string{SectionGovernance},
+			want:     []string{"setup", SectionGovernance, "verify", "commit"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, "saas")
+			g := NewGenerator(cfg,
+				WithLogger(output.NewLogger(output.WithWriter(io.Discard))),
+				WithSections(tt.sections...))
+
+			ch := make(chan GenerateEvent)
+			done := make(chan error, 1)
+			go func() {
+				done <- g.GenerateWithProgress(filepath.Join(t.TempDir(), "demo"), ch)
+			}()
+
+			var phases []string
+			lastStep, files := 0, 0
+			for event := range ch {
+				if event.Step < lastStep || event.Step > event.Total {
+					t.Errorf("step %d of %d after step %d", event.Step, event.Total, lastStep)
+				}
+				lastStep = event.Step
+
+				if event.Path != "" {
+					files++
+				}
+				if len(phases) == 0 || phases[len(phases)-1] != event.Phase {
+					phases = append(phases, event.Phase)
+				}
+			}
+
+			if err := <-done; err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(phases, tt.want) {
+				t.Errorf("phases = %v, want %v", phases, tt.want)
+			}
+			if files == 0 {
+				t.Error("no written files were reported")
+			}
+		})
+	}
+}
+
+func TestGenerateWithoutProgress(t *testing.T) {
+	cfg := testConfig(t, "minimal")
+	g := NewGenerator(cfg,
+		WithLogger(output.NewLogger(output.WithWriter(io.Discard))),
+		WithSections(SectionCommon))
+
+	if err := g.GenerateWithProgress(filepath.Join(t.TempDir(), "demo"), nil); err != nil {
+		t.Fatalf("GenerateWithProgress with a nil channel: %v", err)
+	}
+}
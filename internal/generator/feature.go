@@ -0,0 +1,224 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/clause-cli/clause/internal/config"
+	"github.com/clause-cli/clause/pkg/output"
+	"github.com/clause-cli/clause/pkg/utils"
+)
+
+// featurePaths maps the features accepted by AddFeature to the config key
+// that enables them.
+var featurePaths = map[string]string{
+	"ssr":             "frontend.features.ssr",
+	"ssg":             "frontend.features.ssg",
+	"pwa":             "frontend.features.pwa",
+	"i18n":            "frontend.features.i18n",
+	"dark_mode":       "frontend.features.dark_mode",
+	"storybook":       "frontend.features.storybook",
+	"websocket":       "backend.features.websocket",
+	"background_jobs": "backend.features.background_jobs",
+	"file_upload":     "backend.features.file_upload",
+	"email":           "backend.features.email",
+	"rate_limiting":   "backend.features.rate_limiting",
+	"logging":         "backend.features.logging",
+	"metrics":         "backend.features.metrics",
+}
+
+// FeatureNames returns the features accepted by AddFeature, sorted.
+func FeatureNames() []string {
+	names := make([]string, 0, len(featurePaths))
+	for name := range featurePaths {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// featureKeyPath returns the config key for a feature name. Full key paths
+// such as "backend.features.metrics" are accepted as well.
+func featureKeyPath(feature string) (string, error) {
+	name := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(feature)), "-", "_")
+	if path, ok := featurePaths[name]; ok {
+		return path, nil
+	}
+	for _, path := range featurePaths {
+		if path == name {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("unknown feature: %s (supported: %s)", feature, strings.Join(FeatureNames(), ", "))
+}
+
+// FileChange is an existing file that adding a feature modifies.
+type FileChange struct {
+	// Path is the file path relative to the project directory
+	Path string `json:"path"`
+
+	// Diff is a unified diff of the current and proposed content
+	Diff string `json:"diff"`
+}
+
+// ChangeSet lists what adding a feature changes in a project.
+type ChangeSet struct {
+	// NewFiles lists the files created, relative to the project directory
+	NewFiles []string `json:"new_files"`
+
+	// ModifiedFiles lists the existing files whose content changes
+	ModifiedFiles []FileChange `json:"modified_files"`
+
+	// Skipped lists the modified files left untouched by SkipExisting,
+	// relative to the project directory
+	Skipped []string `json:"skipped,omitempty"`
+
+	// Backups lists the copies made of modified files in Backup mode,
+	// relative to the project directory
+	Backups []string `json:"backups,omitempty"`
+
+	// ConfigChanges lists the configuration fields that change
+	ConfigChanges config.ConfigChanges `json:"config_changes"`
+}
+
+// AddFeature enables feature in the project at projectDir and writes the
+// files the feature adds or changes. With dryRun set nothing is written, so
+// the returned change set can be shown for confirmation first.
+//
+// The affected files are found by rendering the project with the current
+// and the updated configuration and comparing the two, so files the user
+// edited after generation are only touched when the feature changes them.
+// Existing files it changes are handled by policy, as in generation:
+// SkipExisting leaves them as they are and Backup copies them first.
+func AddFeature(projectDir, feature string, dryRun bool, policy OverwritePolicy) (*ChangeSet, error) {
+	keyPath, err := featureKeyPath(feature)
+	if err != nil {
+		return nil, err
+	}
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+
+	configPath := filepath.Join(projectDir, ".clause", "config.yaml")
+	current, err := config.NewLoader().LoadFromPath(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load project config: %w", err)
+	}
+
+	updated := current.Clone()
+	if err := config.SetValue(updated, keyPath, true); err != nil {
+		return nil, fmt.Errorf("failed to enable %s: %w", feature, err)
+	}
+
+	changes, err := current.Diff(updated)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) == 0 {
+		return nil, fmt.Errorf("feature %s is already enabled", feature)
+	}
+
+	before, err := renderProject(current, projectDir)
+	if err != nil {
+		return nil, err
+	}
+	after, err := renderProject(updated, projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	changeSet := &ChangeSet{ConfigChanges: changes}
+	var paths []string
+	for path, content := range after {
+		// The lockfile only differs by its timestamp
+		if content == before[path] || filepath.Base(path) == LockfileName {
+			continue
+		}
+
+		rel := projectRel(projectDir, path)
+
+		existing, err := os.ReadFile(path)
+		switch {
+		case err != nil:
+			changeSet.NewFiles = append(changeSet.NewFiles, rel)
+		case string(existing) != content:
+			changeSet.ModifiedFiles = append(changeSet.ModifiedFiles, FileChange{
+				Path: rel,
+				Diff: utils.UnifiedDiff(rel, rel, string(existing), content),
+			})
+		default:
+			continue
+		}
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	sort.Strings(changeSet.NewFiles)
+	sort.Slice(changeSet.ModifiedFiles, func(i, j int) bool {
+		return changeSet.ModifiedFiles[i].Path < changeSet.ModifiedFiles[j].Path
+	})
+
+	if dryRun {
+		return changeSet, nil
+	}
+
+	for _, path := range paths {
+		if path == configPath {
+			continue
+		}
+		if utils.FileExists(path) {
+			switch policy {
+			case SkipExisting:
+				changeSet.Skipped = append(changeSet.Skipped, projectRel(projectDir, path))
+				continue
+			case Backup:
+				backup, err := utils.BackupFile(path)
+				if err != nil {
+					return nil, fmt.Errorf("failed to back up %s: %w", path, err)
+				}
+				changeSet.Backups = append(changeSet.Backups, projectRel(projectDir, backup))
+			}
+		}
+		if err := utils.EnsureDirectory(filepath.Dir(path)); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(path, []byte(after[path]), 0644); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", path, err)
+		}
+	}
+
+	// Save the configuration last, so a failed write leaves the feature
+	// disabled and the command can be run again
+	if err := config.NewSaver(config.WithBackup(false)).Save(updated, configPath); err != nil {
+		return nil, fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return changeSet, nil
+}
+
+// projectRel returns path relative to projectDir with forward slashes.
+func projectRel(projectDir, path string) string {
+	rel, err := filepath.Rel(projectDir, path)
+	if err != nil {
+		rel = path
+	}
+	return filepath.ToSlash(rel)
+}
+
+// renderProject returns the content of every file generation would write
+// for cfg into projectDir, keyed by path, without writing anything.
+func renderProject(cfg *config.ProjectConfig, projectDir string) (map[string]string, error) {
+	g := NewGenerator(cfg,
+		WithDryRun(true),
+		WithSkipSections(SectionGit),
+		WithLogger(output.NewLogger(output.WithLevel(output.LevelError))),
+	)
+	g.capture = make(map[string]string)
+
+	if err := g.Generate(projectDir); err != nil {
+		return nil, fmt.Errorf("failed to render project: %w", err)
+	}
+	return g.capture, nil
+}
@@ -0,0 +1,164 @@
+package generator
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/clause-cli/clause/internal/config"
+)
+
+func TestAddFeatureDryRun(t *testing.T) {
+	tests := []struct {
+		name         string
+		feature      string
+		disable      func(cfg *config.ProjectConfig)
+		wantNew      []string
+		wantModified []string
+		wantChange   config.ConfigChange
+	}{
+		{
+			// The generator has no metrics scaffolding, so only the config changes
+			name:         "metrics",
+			feature:      "metrics",
+			disable:      func(cfg *config.ProjectConfig) { cfg.Backend.Features.Metrics = false },
+			wantModified: []string{".clause/config.yaml"},
+			wantChange:   config.ConfigChange{Path: "backend.features.metrics", Old: false, New: true},
+		},
+		{
+			name:         "storybook",
+			feature:      "storybook",
+			disable:      func(cfg *config.ProjectConfig) { cfg.Frontend.Features.Storybook = false },
+			wantNew:      []string{"src/.storybook/main.ts", "src/src/App.stories.tsx"},
+			wantModified: []string{".clause/config.yaml", ".gitignore", "src/package.json"},
+			wantChange:   config.ConfigChange{Path: "frontend.features.storybook", Old: false, New: true},
+		},
+		{
+			name:         "full key path",
+			feature:      "backend.features.metrics",
+			disable:      func(cfg *config.ProjectConfig) { cfg.Backend.Features.Metrics = false },
+			wantModified: []string{".clause/config.yaml"},
+			wantChange:   config.ConfigChange{Path: "backend.features.metrics", Old: false, New: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, "saas")
+			tt.disable(cfg)
+			dir := generateProject(t, cfg)
+			before := snapshotFiles(t, dir)
+
+			changes, err := AddFeature(dir, tt.feature, true, Overwrite)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(changes.NewFiles, tt.wantNew) {
+				t.Errorf("NewFiles = %v, want %v", changes.NewFiles, tt.wantNew)
+			}
+			var modified []string
+			for _, file := range changes.ModifiedFiles {
+				modified = append(modified, file.Path)
+				if !strings.HasPrefix(file.Diff, "--- "+file.Path) {
+					t.Errorf("%s diff is not a unified diff:\n%s", file.Path, file.Diff)
+				}
+			}
+			if !reflect.DeepEqual(modified, tt.wantModified) {
+				t.Errorf("ModifiedFiles = %v, want %v", modified, tt.wantModified)
+			}
+			if len(changes.ConfigChanges) != 1 || !reflect.DeepEqual(changes.ConfigChanges[0], tt.wantChange) {
+				t.Errorf("ConfigChanges = %v, want [%v]", changes.ConfigChanges, tt.wantChange)
+			}
+
+			if after := snapshotFiles(t, dir); !reflect.DeepEqual(after, before) {
+				t.Error("dry run changed the project")
+			}
+		})
+	}
+}
+
+func TestAddFeatureOverwritePolicy(t *testing.T) {
+	tests := []struct {
+		policy      OverwritePolicy
+		wantSkipped []string
+		wantBackups []string
+		// wantUpdated reports whether package.json gains the storybook scripts
+		wantUpdated bool
+	}{
+		{Overwrite, nil, nil, true},
+		{SkipExisting, []string{".gitignore", "src/package.json"}, nil, false},
+		{Backup, nil, []string{".gitignore.bak", "src/package.json.bak"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			cfg := testConfig(t, "saas")
+			cfg.Frontend.Features.Storybook = false
+			dir := generateProject(t, cfg)
+			original := readProjectFile(t, dir, "src/package.json")
+
+			changes, err := AddFeature(dir, "storybook", false, tt.policy)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(changes.Skipped, tt.wantSkipped) {
+				t.Errorf("Skipped = %v, want %v", changes.Skipped, tt.wantSkipped)
+			}
+			if !reflect.DeepEqual(changes.Backups, tt.wantBackups) {
+				t.Errorf("Backups = %v, want %v", changes.Backups, tt.wantBackups)
+			}
+			for _, backup := range tt.wantBackups {
+				if !projectFileExists(dir, backup) {
+					t.Errorf("backup %s was not written", backup)
+				}
+			}
+			for _, rel := range changes.NewFiles {
+				if !projectFileExists(dir, rel) {
+					t.Errorf("new file %s was not written", rel)
+				}
+			}
+
+			updated := readProjectFile(t, dir, "src/package.json") != original
+			if updated != tt.wantUpdated {
+				t.Errorf("package.json updated = %v, want %v", updated, tt.wantUpdated)
+			}
+
+			saved, err := config.NewLoader().LoadFromPath(filepath.Join(dir, ".clause", "config.yaml"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !saved.Frontend.Features.Storybook {
+				t.Error("storybook is not enabled in the saved config")
+			}
+		})
+	}
+}
+
+func TestAddFeatureErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		feature string
+		policy  OverwritePolicy
+		want    string
+	}{
+		{"unknown feature", "telemetry", Overwrite, "unknown feature"},
+		{"already enabled", "metrics", Overwrite, "already enabled"},
+		{"invalid policy", "storybook", OverwritePolicy("merge"), "merge"},
+	}
+
+	cfg := testConfig(t, "saas")
+	cfg.Backend.Features.Metrics = true
+	dir := generateProject(t, cfg)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := AddFeature(dir, tt.feature, false, tt.policy)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("AddFeature error = %v, want one containing %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,314 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// frontendFile is a source file of the scaffolded frontend, relative to the
+// frontend directory.
+type frontendFile struct {
+	path    string
+	content string
+}
+
+// frontendScriptExt returns the script extension for the frontend language.
+func (g *Generator) frontendScriptExt() string {
+	if g.Config.Frontend.TypeScript {
+		return "ts"
+	}
+	return "js"
+}
+
+// frontendSourceFiles returns the entry point and root component for the
+// configured framework, with the Vite config and type declarations Vue and
+// Svelte need. Frameworks without their own scaffold get React.
+func (g *Generator) frontendSourceFiles() []frontendFile {
+	ext := g.frontendScriptExt()
+
+	switch g.Config.Frontend.Framework {
+	case "vue":
+		files := []frontendFile{
+			{"vite.config." + ext, g.generateViteConfig("vue", "@vitejs/plugin-vue", "vue()")},
+			{filepath.Join("src", "main."+ext), g.generateVueMain()},
+			{filepath.Join("src", "App.vue"), g.generateVueApp()},
+		}
+		if g.Config.Frontend.TypeScript {
+			files = append(files,
+				frontendFile{"tsconfig.node.json", g.generateTSConfigNode()},
+				frontendFile{filepath.Join("src", "env.d.ts"), g.generateVueEnvTypes()})
+		}
+		return files
+	case "svelte":
+		files := []frontendFile{
+			{"vite.config." + ext, g.generateViteConfig("{ svelte }", "@sveltejs/vite-plugin-svelte", "svelte()")},
+			{"svelte.config.js", g.generateSvelteConfig()},
+			{filepath.Join("src", "main."+ext), g.generateSvelteMain()},
+			{filepath.Join("src", "App.svelte"), g.generateSvelteApp()},
+		}
+		if g.Config.Frontend.TypeScript {
+			files = append(files,
+				frontendFile{"tsconfig.node.json", g.generateTSConfigNode()},
+				frontendFile{filepath.Join("src", "vite-env.d.ts"), g.generateSvelteEnvTypes()})
+		}
+		return files
+	case "nextjs":
+		return []frontendFile{
+			{filepath.Join("src", "app", "layout."+ext+"x"), g.generateNextLayout()},
+			{filepath.Join("src", "app", "page."+ext+"x"), g.generateNextPage()},
+		}
+	default:
+		mainFile, appFile := "index.tsx", "App.tsx"
+		if !g.Config.Frontend.TypeScript {
+			mainFile, appFile = "index.js", "App.js"
+		}
+		return []frontendFile{
+			{filepath.Join("src", mainFile), g.generateFrontendMain()},
+			{filepath.Join("src", appFile), g.generateAppComponent()},
+		}
+	}
+}
+
+// appComponentImport returns the import path of the root component from src.
+func (g *Generator) appComponentImport() string {
+	switch g.Config.Frontend.Framework {
+	case "vue":
+		return "./App.vue"
+	case "svelte":
+		return "./App.svelte"
+	case "nextjs":
+		return "./app/page"
+	default:
+		return "./App"
+	}
+}
+
+// frontendScripts returns the package.json scripts for the framework.
+func (g *Generator) frontendScripts() []string {
+	if g.Config.Frontend.Framework == "nextjs" {
+		return []string{`"dev": "next dev"`, `"build": "next build"`, `"start": "next start"`}
+	}
+	return []string{`"dev": "vite"`, `"build": "vite build"`, `"preview": "vite preview"`}
+}
+
+// frontendDevPort returns the port the frontend dev server listens on.
+func (g *Generator) frontendDevPort() string {
+	if g.Config.Frontend.Framework == "nextjs" {
+		return "3000"
+	}
+	return "5173"
+}
+
+// frontendDependencies returns the package.json dependencies for the
+// framework.
+func (g *Generator) frontendDependencies() []string {
+	switch g.Config.Frontend.Framework {
+	case "vue":
+		return []string{`"vue": "^3.3.0"`}
+	case "svelte":
+		return []string{`"svelte": "^4.0.0"`}
+	case "nextjs":
+		return []string{`"next": "^14.0.0"`, `"react": "^18.2.0"`, `"react-dom": "^18.2.0"`}
+	default:
+		return []string{`"react": "^18.2.0"`, `"react-dom": "^18.2.0"`}
+	}
+}
+
+// frontendDevDependencies returns the package.json devDependencies for the
+// framework, its build tool and TypeScript.
+func (g *Generator) frontendDevDependencies() []string {
+	ts := g.Config.Frontend.TypeScript
+
+	var deps []string
+	switch g.Config.Frontend.Framework {
+	case "vue":
+		deps = []string{`"@vitejs/plugin-vue": "^4.0.0"`, `"vite": "^4.4.0"`}
+		if ts {
+			deps = append(deps, `"vue-tsc": "^1.8.0"`)
+		}
+	case "svelte":
+		deps = []string{`"@sveltejs/vite-plugin-svelte": "^2.4.0"`, `"vite": "^4.4.0"`}
+	case "nextjs":
+		if ts {
+			deps = []string{`"@types/node": "^20.0.0"`, `"@types/react": "^18.2.0"`, `"@types/react-dom": "^18.2.0"`}
+		}
+	default:
+		deps = []string{`"@vitejs/plugin-react": "^4.0.0"`, `"vite": "^4.4.0"`}
+		if ts {
+			deps = append([]string{`"@types/react": "^18.2.0"`}, deps...)
+		}
+	}
+
+	if ts {
+		deps = append(deps, `"typescript": "^5.0.0"`)
+	}
+	return deps
+}
+
+// generateViteConfig generates a Vite config that registers the framework
+// plugin imported as binding from pkg and created by call.
+func (g *Generator) generateViteConfig(binding, pkg, call string) string {
+	return fmt.Sprintf(`import { defineConfig } from 'vite'
+import %s from '%s'
+
+export default defineConfig({
+  plugins: [%s],
+  server: {
+    port: %s,
+  },
+})
+`, binding, pkg, call, g.frontendDevPort())
+}
+
+// generateTSConfigNode generates tsconfig.node.json, the project referenced
+// by tsconfig.json that type-checks vite.config.ts.
+func (g *Generator) generateTSConfigNode() string {
+	return `{
+  "compilerOptions": {
+    "composite": true,
+    "skipLibCheck": true,
+    "module": "ESNext",
+    "moduleResolution": "bundler",
+    "allowSyntheticDefaultImports": true
+  },
+  "include": ["vite.config.ts"]
+}
+`
+}
+
+// generateVueEnvTypes declares the Vite client types and .vue modules for
+// TypeScript.
+func (g *Generator) generateVueEnvTypes() string {
+	return `/// <reference types="vite/client" />
+
+declare module '*.vue' {
+  import type { DefineComponent } from 'vue'
+  const component: DefineComponent<object, object, unknown>
+  export default component
+}
+`
+}
+
+// generateSvelteConfig generates svelte.config.js, which preprocesses
+// TypeScript in components.
+func (g *Generator) generateSvelteConfig() string {
+	return `import { vitePreprocess } from '@sveltejs/vite-plugin-svelte'
+
+export default {
+  preprocess: vitePreprocess(),
+}
+`
+}
+
+// generateSvelteEnvTypes declares the Svelte and Vite client types for
+// TypeScript.
+func (g *Generator) generateSvelteEnvTypes() string {
+	return `/// <reference types="svelte" />
+/// <reference types="vite/client" />
+`
+}
+
+func (g *Generator) generateVueMain() string {
+	return `import { createApp } from 'vue'
+import App from './App.vue'
+
+createApp(App).mount('#app')
+`
+}
+
+func (g *Generator) generateVueApp() string {
+	lang := ""
+	if g.Config.Frontend.TypeScript {
+		lang = ` lang="ts"`
+	}
+	return fmt.Sprintf(`<script setup%s>
+const name = %q
+const description = %q
+</script>
+
+<template>
+  <main>
+    <h1>Welcome to {{ name }}</h1>
+    <p>{{ description }}</p>
+  </main>
+</template>
+`, lang, g.Config.Metadata.Name, g.Config.Metadata.Description)
+}
+
+func (g *Generator) generateSvelteMain() string {
+	target := "document.getElementById('app')"
+	if g.Config.Frontend.TypeScript {
+		target += "!"
+	}
+	return fmt.Sprintf(`import App from './App.svelte'
+
+const app = new App({
+  target: %s,
+})
+
+export default app
+`, target)
+}
+
+func (g *Generator) generateSvelteApp() string {
+	lang := ""
+	if g.Config.Frontend.TypeScript {
+		lang = ` lang="ts"`
+	}
+	return fmt.Sprintf(`<script%s>
+  const name = %q
+  const description = %q
+</script>
+
+<main>
+  <h1>Welcome to {name}</h1>
+  <p>{description}</p>
+</main>
+`, lang, g.Config.Metadata.Name, g.Config.Metadata.Description)
+}
+
+func (g *Generator) generateNextLayout() string {
+	if !g.Config.Frontend.TypeScript {
+		return fmt.Sprintf(`export const metadata = {
+  title: %q,
+  description: %q,
+}
+
+export default function RootLayout({ children }) {
+  return (
+    <html lang="en">
+      <body>{children}</body>
+    </html>
+  )
+}
+`, g.Config.Metadata.Name, g.Config.Metadata.Description)
+	}
+
+	return fmt.Sprintf(`import type { Metadata } from 'next'
+
+export const metadata: Metadata = {
+  title: %q,
+  description: %q,
+}
+
+export default function RootLayout({ children }: { children: React.ReactNode }) {
+  return (
+    <html lang="en">
+      <body>{children}</body>
+    </html>
+  )
+}
+`, g.Config.Metadata.Name, g.Config.Metadata.Description)
+}
+
+func (g *Generator) generateNextPage() string {
+	return fmt.Sprintf(`export default function Page() {
+  return (
+    <main>
+      <h1>Welcome to %s</h1>
+      <p>%s</p>
+    </main>
+  )
+}
+`, g.Config.Metadata.Name, g.Config.Metadata.Description)
+}
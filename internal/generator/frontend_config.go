@@ -0,0 +1,122 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// frontendEnvPrefix returns the prefix a framework requires for environment
+// variables exposed to browser code.
+func (g *Generator) frontendEnvPrefix() string {
+	switch g.Config.Frontend.Framework {
+	case "nextjs":
+		return "NEXT_PUBLIC_"
+	case "nuxt":
+		return "NUXT_PUBLIC_"
+	default:
+		return "VITE_"
+	}
+}
+
+// frontendEnvRef returns the expression that reads a public variable at runtime.
+func (g *Generator) frontendEnvRef(key string) string {
+	switch g.Config.Frontend.Framework {
+	case "nextjs", "nuxt":
+		return "process.env." + g.frontendEnvPrefix() + key
+	default:
+		return "import.meta.env." + g.frontendEnvPrefix() + key
+	}
+}
+
+// frontendEnvVars returns the public placeholders for the frontend .env.example.
+// The API URL points at the generated backend and the app URL at the first
+// allowed CORS origin, which is where the backend expects the frontend to run.
+func (g *Generator) frontendEnvVars() []envVar {
+	apiURL := ""
+	if g.Config.Backend.Enabled {
+		apiURL = "http://localhost:" + g.backendPort()
+	}
+
+	appURL := "http://localhost:3000"
+	if origins := g.Config.Backend.API.CORS.Origins; len(origins) > 0 {
+		appURL = origins[0]
+	}
+
+	return []envVar{
+		{key: "API_URL", value: apiURL},
+		{key: "APP_URL", value: appURL},
+	}
+}
+
+// frontendFeatureFlags returns the runtime feature flags in a stable order.
+func (g *Generator) frontendFeatureFlags() [][2]string {
+	features := g.Config.Frontend.Features
+	return [][2]string{
+		{"darkMode", fmt.Sprint(features.DarkMode)},
+		{"i18n", fmt.Sprint(features.I18n)},
+		{"pwa", fmt.Sprint(features.PWA)},
+	}
+}
+
+// createFrontendConfig writes src/config.ts (or src/config.js) and the
+// frontend .env.example.
+func (g *Generator) createFrontendConfig(frontendDir string) error {
+	var env strings.Builder
+	for _, v := range g.frontendEnvVars() {
+		env.WriteString(g.frontendEnvPrefix() + v.key + "=" + v.value + "\n")
+	}
+	if err := g.writeFile(filepath.Join(frontendDir, ".env.example"), env.String()); err != nil {
+		return err
+	}
+
+	configFile := "config.ts"
+	if !g.Config.Frontend.TypeScript {
+		configFile = "config.js"
+	}
+	return g.writeFile(filepath.Join(frontendDir, "src", configFile), g.generateFrontendConfig())
+}
+
+// generateFrontendConfig generates the typed runtime settings module.
+func (g *Generator) generateFrontendConfig() string {
+	ts := g.Config.Frontend.TypeScript
+	vars := g.frontendEnvVars()
+
+	var b strings.Builder
+	b.WriteString("// Runtime settings for the frontend; see .env.example.\n\n")
+
+	if ts {
+		b.WriteString("export interface AppConfig {\n")
+		b.WriteString("  apiBaseUrl: string;\n")
+		b.WriteString("  appUrl: string;\n")
+		b.WriteString("  features: {\n")
+		for _, flag := range g.frontendFeatureFlags() {
+			b.WriteString(fmt.Sprintf("    %s: boolean;\n", flag[0]))
+		}
+		b.WriteString("  };\n")
+		b.WriteString("}\n\n")
+	}
+
+	typed := func(t string) string {
+		if ts {
+			return ": " + t
+		}
+		return ""
+	}
+
+	b.WriteString(fmt.Sprintf("export const API_BASE_URL%s = %s ?? '%s';\n\n",
+		typed("string"), g.frontendEnvRef(vars[0].key), vars[0].value))
+
+	b.WriteString(fmt.Sprintf("export const config%s = {\n", typed("AppConfig")))
+	b.WriteString("  apiBaseUrl: API_BASE_URL,\n")
+	b.WriteString(fmt.Sprintf("  appUrl: %s ?? '%s',\n", g.frontendEnvRef(vars[1].key), vars[1].value))
+	b.WriteString("  features: {\n")
+	for _, flag := range g.frontendFeatureFlags() {
+		b.WriteString(fmt.Sprintf("    %s: %s,\n", flag[0], flag[1]))
+	}
+	b.WriteString("  },\n")
+	b.WriteString("};\n\n")
+	b.WriteString("export default config;\n")
+
+	return b.String()
+}
@@ -0,0 +1,69 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFrontendConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		framework  string
+		typeScript bool
+		file       string
+		absent     string
+		wants      []string
+		env        []string
+	}{
+		{
+			name: "typescript react", framework: "react", typeScript: true,
+			file: "src/config.ts", absent: "src/config.js",
+			wants: []string{
+				"export interface AppConfig {",
+				"export const API_BASE_URL: string = import.meta.env.VITE_API_URL ?? 'http://localhost:",
+				"export const config: AppConfig = {",
+				"darkMode: boolean;",
+			},
+			env: []string{"VITE_API_URL=http://localhost:", "VITE_APP_URL="},
+		},
+		{
+			name: "javascript react", framework: "react", typeScript: false,
+			file: "src/config.js", absent: "src/config.ts",
+			wants: []string{"export const API_BASE_URL = import.meta.env.VITE_API_URL ??", "export const config = {"},
+			env:   []string{"VITE_API_URL="},
+		},
+		{
+			name: "nextjs", framework: "nextjs", typeScript: true,
+			file: "src/config.ts", absent: "src/config.js",
+			wants: []string{"process.env.NEXT_PUBLIC_API_URL", "process.env.NEXT_PUBLIC_APP_URL"},
+			env:   []string{"NEXT_PUBLIC_API_URL="},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, "saas")
+			cfg.Frontend.Directory = "frontend"
+			cfg.Frontend.Framework = tt.framework
+			cfg.Frontend.TypeScript = tt.typeScript
+
+			dir := generateProject(t, cfg)
+			content := readProjectFile(t, dir, "frontend/"+tt.file)
+			for _, want := range tt.wants {
+				if !strings.Contains(content, want) {
+					t.Errorf("%s does not contain %q:\n%s", tt.file, want, content)
+				}
+			}
+			if projectFileExists(dir, "frontend/"+tt.absent) {
+				t.Errorf("%s was written alongside %s", tt.absent, tt.file)
+			}
+
+			env := readProjectFile(t, dir, "frontend/.env.example")
+			for _, want := range tt.env {
+				if !strings.Contains(env, want) {
+					t.Errorf(".env.example does not contain %q:\n%s", want, env)
+				}
+			}
+		})
+	}
+}
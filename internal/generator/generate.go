@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os/exec"
 	"path/filepath"
+	"strings"
 )
 
 // createFrontend creates the frontend structure.
@@ -41,23 +42,29 @@ func (g *Generator) createFrontend(projectPath string) error {
 		}
 	}
 
-	// Create main entry file
-	mainContent := g.generateFrontendMain()
-	mainFile := "index.tsx"
-	if !g.Config.Frontend.TypeScript {
-		mainFile = "index.js"
+	// Create the entry point and root component for the framework
+	for _, file := range g.frontendSourceFiles() {
+		path := filepath.Join(frontendDir, file.path)
+		if err := g.createDirectory(filepath.Dir(path)); err != nil {
+			return err
+		}
+		if err := g.writeFile(path, file.content); err != nil {
+			return err
+		}
 	}
-	if err := g.writeFile(filepath.Join(srcDir, mainFile), mainContent); err != nil {
+
+	// Create runtime settings read from public environment variables
+	if err := g.createFrontendConfig(frontendDir); err != nil {
 		return err
 	}
 
-	// Create App component
-	appContent := g.generateAppComponent()
-	appFile := "App.tsx"
-	if !g.Config.Frontend.TypeScript {
-		appFile = "App.js"
+	// Create a client for the backend API in full-stack projects
+	if err := g.createAPIClient(frontendDir); err != nil {
+		return err
 	}
-	if err := g.writeFile(filepath.Join(srcDir, appFile), appContent); err != nil {
+
+	// Create Storybook config and a sample story
+	if err := g.createStorybook(frontendDir); err != nil {
 		return err
 	}
 
@@ -92,9 +99,23 @@ func (g *Generator) createPythonBackend(backendDir string) error {
 	mainContent := `"""
 Main entry point for the application.
 """
+import uvicorn
+from fastapi import FastAPI
+
+from app.settings import settings
+` + g.pythonCORSImport() + `
+` + g.pythonMonitoringSnippet() + `
+app = FastAPI(title="{{.Project.Name}}")
+` + g.pythonCORSSnippet() + `
+
+@app.get("/")
+def root():
+    return {"message": "Hello from {{.Project.Name}}!"}
+
 
 def main():
-    print("Hello from {{.Project.Name}}!")
+    uvicorn.run(app, host="0.0.0.0", port=settings.port)
+
 
 if __name__ == "__main__":
     main()
@@ -107,7 +128,8 @@ if __name__ == "__main__":
 	requirements := `fastapi>=0.100.0
 uvicorn>=0.22.0
 pydantic>=2.0.0
-`
+python-dotenv>=1.0.0
+` + g.pythonMonitoringRequirements()
 	if err := g.writeFile(filepath.Join(backendDir, "requirements.txt"), requirements); err != nil {
 		return err
 	}
@@ -129,6 +151,18 @@ pydantic>=2.0.0
 		return err
 	}
 
+	// Create settings.py to load the environment
+	if err := g.writeFile(filepath.Join(appDir, "settings.py"), g.generatePythonSettings()); err != nil {
+		return err
+	}
+
+	// Create cors.py if CORS is enabled
+	if g.corsEnabled() {
+		if err := g.writeFile(filepath.Join(appDir, "cors.py"), g.generatePythonCORS()); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -146,10 +180,18 @@ func (g *Generator) createNodeBackend(backendDir string) error {
 		return err
 	}
 
-	// Create index.js
-	indexContent := "const express = require('express');\n\n" +
+	// Create config.js to load the environment
+	if err := g.writeFile(filepath.Join(srcDir, "config.js"), g.generateNodeConfig()); err != nil {
+		return err
+	}
+
+	// Create index.js; config is loaded first so .env is applied before the SDKs start
+	indexContent := "const config = require('./config');\n\n" +
+		g.nodeMonitoringSnippet() +
+		"const express = require('express');\n\n" +
 		"const app = express();\n" +
-		"const port = process.env.PORT || 3000;\n\n" +
+		"const port = config.port;\n\n" +
+		g.nodeCORSSnippet() +
 		"app.get('/', (req, res) => {\n" +
 		"  res.json({ message: 'Hello from {{.Project.Name}}!' });\n" +
 		"});\n\n" +
@@ -165,6 +207,11 @@ func (g *Generator) createNodeBackend(backendDir string) error {
 
 // createGoBackend creates Go backend structure.
 func (g *Generator) createGoBackend(backendDir string) error {
+	handler := "nil"
+	if g.corsEnabled() {
+		handler = "withCORS(http.DefaultServeMux)"
+	}
+
 	// Create main.go
 	mainContent := `package main
 
@@ -174,23 +221,38 @@ import (
 )
 
 func main() {
+	cfg := loadConfig()
+
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprintf(w, "Hello from {{.Project.Name}}!")
 	})
 
-	fmt.Println("Server starting on :8080")
-	http.ListenAndServe(":8080", nil)
+	addr := fmt.Sprintf(":%d", cfg.Port)
+	fmt.Println("Server starting on", addr)
+	http.ListenAndServe(addr, ` + handler + `)
 }
 `
 	if err := g.writeTemplate(filepath.Join(backendDir, "main.go"), mainContent); err != nil {
 		return err
 	}
 
+	// Create config.go to load the environment
+	if err := g.writeFile(filepath.Join(backendDir, "config.go"), g.generateGoConfig()); err != nil {
+		return err
+	}
+
+	// Create cors.go if CORS is enabled
+	if err := g.createGoCORS(backendDir); err != nil {
+		return err
+	}
+
 	// Create go.mod
 	goMod := fmt.Sprintf(`module %s
 
-go 1.21
-`, g.Config.Metadata.Name)
+go %s
+`, g.Config.Metadata.Name, g.backendLanguageVersion())
+	requires := append([]string{"github.com/kelseyhightower/envconfig v1.4.0"}, g.goMonitoringRequires()...)
+	goMod += "\nrequire (\n\t" + strings.Join(requires, "\n\t") + "\n)\n"
 	if err := g.writeFile(filepath.Join(backendDir, "go.mod"), goMod); err != nil {
 		return err
 	}
@@ -323,51 +385,61 @@ func (g *Generator) initGit(projectPath string) error {
 // Helper functions for content generation
 
 func (g *Generator) generatePackageJSON() string {
+	scripts := append(g.frontendScripts(), g.storybookScripts()...)
+	dependencies := append(g.frontendDependencies(), g.apiClientDependencies()...)
+	devDependencies := append(g.frontendDevDependencies(), g.storybookDependencies()...)
 	return fmt.Sprintf(`{
   "name": "%s",
   "version": "1.0.0",
   "description": "%s",
+  %s"engines": {
+    "node": ">=%s"
+  },
   "scripts": {
-    "dev": "vite",
-    "build": "vite build",
-    "preview": "vite preview"
+    %s
   },
   "dependencies": {
-    "react": "^18.2.0",
-    "react-dom": "^18.2.0"
+    %s
   },
   "devDependencies": {
-    "@types/react": "^18.2.0",
-    "@vitejs/plugin-react": "^4.0.0",
-    "typescript": "^5.0.0",
-    "vite": "^4.4.0"
+    %s
   }
 }
-`, g.Config.Metadata.Name, g.Config.Metadata.Description)
+`, g.Config.Metadata.Name, g.Config.Metadata.Description, g.packageRepository(), g.Config.ResolvedNodeVersion(),
+		strings.Join(scripts, ",\n    "), strings.Join(dependencies, ",\n    "),
+		strings.Join(devDependencies, ",\n    "))
 }
 
 func (g *Generator) generateBackendPackageJSON() string {
+	dependencies := append([]string{`"dotenv": "^16.0.0"`, `"express": "^4.18.0"`}, g.nodeMonitoringDependencies()...)
 	return fmt.Sprintf(`{
   "name": "%s-backend",
   "version": "1.0.0",
   "description": "%s",
   "main": "src/index.js",
+  %s"engines": {
+    "node": ">=%s"
+  },
   "scripts": {
     "start": "node src/index.js",
     "dev": "nodemon src/index.js"
   },
   "dependencies": {
-    "express": "^4.18.0"
+    %s
   },
   "devDependencies": {
     "nodemon": "^3.0.0"
   }
 }
-`, g.Config.Metadata.Name, g.Config.Metadata.Description)
+`, g.Config.Metadata.Name, g.Config.Metadata.Description, g.packageRepository(), g.Config.ResolvedNodeVersion(), strings.Join(dependencies, ",\n    "))
 }
 
 func (g *Generator) generateTSConfig() string {
-	return `{
+	jsx := "react-jsx"
+	if g.Config.Frontend.Framework == "nextjs" {
+		jsx = "preserve"
+	}
+	return fmt.Sprintf(`{
   "compilerOptions": {
     "target": "ES2020",
     "useDefineForClassFields": true,
@@ -379,7 +451,7 @@ func (g *Generator) generateTSConfig() string {
     "resolveJsonModule": true,
     "isolatedModules": true,
     "noEmit": true,
-    "jsx": "react-jsx",
+    "jsx": "%s",
     "strict": true,
     "noUnusedLocals": true,
     "noUnusedParameters": true,
@@ -388,7 +460,7 @@ func (g *Generator) generateTSConfig() string {
   "include": ["src"],
   "references": [{ "path": "./tsconfig.node.json" }]
 }
-`
+`, jsx)
 }
 
 func (g *Generator) generatePyproject() string {
@@ -396,12 +468,12 @@ func (g *Generator) generatePyproject() string {
 name = "%s"
 version = "0.1.0"
 description = "%s"
-requires-python = ">=3.11"
+requires-python = ">=%s"
 
 [build-system]
 requires = ["setuptools>=61.0"]
 build-backend = "setuptools.build_meta"
-`, g.Config.Metadata.Name, g.Config.Metadata.Description)
+`, g.Config.Metadata.Name, g.Config.Metadata.Description, g.backendLanguageVersion())
 }
 
 func (g *Generator) generateFrontendMain() string {
@@ -434,8 +506,8 @@ export default App
 }
 
 func (g *Generator) generateDockerfile() string {
-	return `# Build stage
-FROM node:18-alpine AS builder
+	return fmt.Sprintf(`# Build stage
+FROM node:%s-alpine AS builder
 
 WORKDIR /app
 
@@ -453,7 +525,7 @@ COPY --from=builder /app/dist /usr/share/nginx/html
 EXPOSE 80
 
 CMD ["nginx", "-g", "daemon off;"]
-`
+`, g.Config.ResolvedNodeVersion())
 }
 
 func (g *Generator) generateDockerCompose() string {
@@ -497,7 +569,7 @@ volumes:
 }
 
 func (g *Generator) generateGitHubActionsWorkflow() string {
-	return `name: CI
+	return fmt.Sprintf(`name: CI
 
 on:
   push:
@@ -515,7 +587,7 @@ jobs:
       - name: Setup Node.js
         uses: actions/setup-node@v4
         with:
-          node-version: '18'
+          node-version: '%s'
           cache: 'npm'
 
       - name: Install dependencies
@@ -526,7 +598,7 @@ jobs:
 
       - name: Build
         run: npm run build
-`
+`, g.Config.ResolvedNodeVersion())
 }
 
 func (g *Generator) generateBrainstormMd() string {
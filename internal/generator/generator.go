@@ -31,6 +31,63 @@ type Generator struct {
 
 	// Progress callback
 	OnProgress func(message string)
+
+	// TaskRunner selects the generated task file (make or just)
+	TaskRunner string
+
+	// Sections limits generation to the named sections (empty means all)
+	Sections []string
+
+	// SkipSections excludes the named sections from generation
+	SkipSections []string
+
+	// Overwrite controls how existing files are handled
+	Overwrite OverwritePolicy
+
+	// KeepOnError keeps the staging directory of a failed run
+	KeepOnError bool
+
+	// events receives progress events during GenerateWithEvents
+	events chan<- Event
+
+	// progressEvents receives step progress during GenerateWithProgress
+	progressEvents chan<- GenerateEvent
+
+	// phase is the name of the running generation phase
+	phase string
+
+	// section is the section of the running step
+	section string
+
+	// step is the 1-based position of the running step and totalSteps the
+	// number of steps in the run
+	step, totalSteps int
+
+	// written lists the files written during generation
+	written []string
+
+	// summary records what happened to each file during generation
+	summary Summary
+
+	// changes records what to undo if generation fails
+	changes changeLog
+
+	// staging is the temporary directory the project is built in, and
+	// target the project directory it is moved to; staging is empty in dry
+	// run mode, where nothing is written
+	staging, target string
+
+	// previewed holds, in dry run mode, the content of each file the run
+	// would write, keyed by path
+	previewed map[string]string
+
+	// capture, when set, collects the content of each file a dry run would
+	// write, keyed by path, instead of logging a preview
+	capture map[string]string
+
+	// beforeWrite, when set, is called with the path of each file before it
+	// is written; an error it returns fails the write
+	beforeWrite func(path string) error
 }
 
 // GeneratorOption is a functional option for configuring the generator.
@@ -79,67 +136,150 @@ func WithProgress(callback func(message string)) GeneratorOption {
 	}
 }
 
-// Generate generates the project at the specified path.
+// Generate generates the project at the specified path. It is
+// GenerateWithProgress without a progress channel.
 func (g *Generator) Generate(projectPath string) error {
-	g.progress("Creating project directory structure...")
+	return g.GenerateWithProgress(projectPath, nil)
+}
 
-	// Validate configuration
-	if err := g.validateConfig(); err != nil {
-		return fmt.Errorf("invalid configuration: %w", err)
-	}
+// generationStep is a phase of a generation run.
+type generationStep struct {
+	// section is the generation section the step belongs to, or "setup",
+	// "verify" and "commit" for the steps around them
+	section string
 
-	// Create root directory
-	if err := g.createDirectory(projectPath); err != nil {
-		return fmt.Errorf("failed to create project directory: %w", err)
-	}
+	// name describes the step in progress messages
+	name string
+
+	// run performs the step
+	run func() error
+}
+
+// plan returns the steps a run will perform, in order, given the
+// configuration and section filters. Files are built in root, which is the
+// staging directory outside dry run mode, and moved to projectPath.
+func (g *Generator) plan(root, projectPath string) []generationStep {
+	steps := []generationStep{{"setup", "Creating project directory structure", func() error {
+		// Validate configuration
+		if err := g.validateConfig(); err != nil {
+			return fmt.Errorf("invalid configuration: %w", err)
+		}
+		if err := g.validateSections(); err != nil {
+			return err
+		}
+		if err := g.validateOverwritePolicy(); err != nil {
+			return err
+		}
+
+		// Create root directory
+		if err := g.createDirectory(root); err != nil {
+			return fmt.Errorf("failed to create project directory: %w", err)
+		}
+		return nil
+	}}}
 
 	// Create .clause directory with config
-	g.progress("Creating Clause configuration...")
-	if err := g.createClauseConfig(projectPath); err != nil {
-		return err
+	if g.sectionEnabled(SectionConfig) {
+		steps = append(steps, generationStep{SectionConfig, "Creating Clause configuration", func() error {
+			return g.createClauseConfig(root)
+		}})
 	}
 
 	// Create common files
-	g.progress("Creating common files...")
-	if err := g.createCommonFiles(projectPath); err != nil {
-		return err
+	if g.sectionEnabled(SectionCommon) {
+		steps = append(steps, generationStep{SectionCommon, "Creating common files", func() error {
+			return g.createCommonFiles(root)
+		}})
 	}
 
 	// Create frontend if enabled
-	if g.Config.Frontend.Enabled {
-		g.progress("Creating frontend structure...")
-		if err := g.createFrontend(projectPath); err != nil {
-			return err
-		}
+	if g.Config.Frontend.Enabled && g.sectionEnabled(SectionFrontend) {
+		steps = append(steps, generationStep{SectionFrontend, "Creating frontend structure", func() error {
+			return g.createFrontend(root)
+		}})
 	}
 
 	// Create backend if enabled
-	if g.Config.Backend.Enabled {
-		g.progress("Creating backend structure...")
-		if err := g.createBackend(projectPath); err != nil {
-			return err
-		}
+	if g.Config.Backend.Enabled && g.sectionEnabled(SectionBackend) {
+		steps = append(steps, generationStep{SectionBackend, "Creating backend structure", func() error {
+			return g.createBackend(root)
+		}})
+
+		// Wire up monitoring SDKs when a supported provider is configured and
+		// write environment placeholders for the backend integrations
+		steps = append(steps, generationStep{SectionBackend, "Configuring monitoring integration", func() error {
+			if err := g.generateMonitoring(root); err != nil {
+				return err
+			}
+			return g.createEnvExample(root)
+		}})
 	}
 
 	// Create infrastructure files
-	g.progress("Creating infrastructure files...")
-	if err := g.createInfrastructure(projectPath); err != nil {
-		return err
+	if g.sectionEnabled(SectionInfrastructure) {
+		steps = append(steps, generationStep{SectionInfrastructure, "Creating infrastructure files", func() error {
+			return g.createInfrastructure(root)
+		}})
 	}
 
 	// Create governance files
-	if g.Config.Governance.Enabled {
-		g.progress("Creating governance files...")
-		if err := g.createGovernance(projectPath); err != nil {
-			return err
+	if g.Config.Governance.Enabled && g.sectionEnabled(SectionGovernance) {
+		steps = append(steps, generationStep{SectionGovernance, "Creating governance files", func() error {
+			return g.createGovernance(root)
+		}})
+
+		// Create security policy, dependency update config and code owners
+		steps = append(steps, generationStep{SectionGovernance, "Creating security policy", func() error {
+			if err := g.createSecurityFiles(root); err != nil {
+				return err
+			}
+			return g.createCodeowners(root)
+		}})
+	}
+
+	// Check that the generated YAML and JSON files parse; a dry run checks
+	// the content it would have written
+	steps = append(steps, generationStep{"verify", "Verifying generated files", func() error {
+		if g.DryRun {
+			return g.verifyPreviews(g.previewed)
 		}
+		return g.verifyOutputs(g.written)
+	}})
+
+	// Record the versions the project was generated with
+	if g.sectionEnabled(SectionConfig) {
+		steps = append(steps, generationStep{SectionConfig, "Writing lockfile", func() error {
+			return g.writeLockfile(root)
+		}})
 	}
 
-	// Initialize git if enabled
-	if g.Config.Development.Git {
-		g.progress("Initializing git repository...")
-		if err := g.initGit(projectPath); err != nil {
-			g.Logger.Warn("Failed to initialize git: %v", err)
+	// Move the finished project into place
+	if g.staging != "" {
+		steps = append(steps, generationStep{"commit", "Moving files into place", g.commitStaging})
+	}
+
+	// Initialize git if enabled; failures are not fatal
+	if g.Config.Development.Git && g.sectionEnabled(SectionGit) {
+		steps = append(steps, generationStep{SectionGit, "Initializing git repository", func() error {
+			if err := g.initGit(projectPath); err != nil {
+				g.Logger.Warn("Failed to initialize git: %v", err)
+			}
+			return nil
+		}})
+	}
+
+	return steps
+}
+
+// generate runs the planned steps in order.
+func (g *Generator) generate(root, projectPath string) error {
+	steps := g.plan(root, projectPath)
+	g.totalSteps = len(steps)
+
+	for i, step := range steps {
+		g.step, g.section = i+1, step.section
+		if err := g.runPhase(step.name, step.run); err != nil {
+			return err
 		}
 	}
 
@@ -159,26 +299,80 @@ func (g *Generator) validateConfig() error {
 // createDirectory creates a directory.
 func (g *Generator) createDirectory(path string) error {
 	if g.DryRun {
-		g.Logger.Info("[DRY RUN] Would create directory: %s", path)
+		if g.capture == nil {
+			g.Logger.Info("[DRY RUN] Would create directory: %s", path)
+		}
 		return nil
 	}
-	return utils.EnsureDirectory(path)
+	return g.ensureDirectory(path)
 }
 
-// writeFile writes a file with content.
+// writeFile writes a file with content, applying the overwrite policy to
+// existing files.
 func (g *Generator) writeFile(path, content string) error {
+	write := g.claimPath(path)
+
 	if g.DryRun {
-		g.Logger.Info("[DRY RUN] Would create file: %s", path)
+		if write {
+			g.previewFile(path, content)
+		} else if g.capture == nil {
+			g.Logger.Info("[DRY RUN] Would skip existing file: %s", path)
+		}
+		return nil
+	}
+	if !write {
+		g.Logger.Debug("Skipping existing file: %s", g.targetPath(path))
 		return nil
 	}
 
+	if g.beforeWrite != nil {
+		if err := g.beforeWrite(path); err != nil {
+			return err
+		}
+	}
+
 	// Ensure directory exists
 	dir := filepath.Dir(path)
-	if err := utils.EnsureDirectory(dir); err != nil {
+	if err := g.ensureDirectory(dir); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
 		return err
 	}
 
-	return os.WriteFile(path, []byte(content), 0644)
+	g.written = append(g.written, path)
+	g.emit(Event{Type: EventFileWritten, Phase: g.phase, Path: g.targetPath(path)})
+	return nil
+}
+
+// previewFile logs, in dry run mode, a unified diff of what writing content
+// to path would change. New files are diffed against an empty file. When
+// capturing, the content is recorded instead.
+func (g *Generator) previewFile(path, content string) {
+	if g.previewed == nil {
+		g.previewed = make(map[string]string)
+	}
+	g.previewed[path] = content
+
+	if g.capture != nil {
+		g.capture[path] = content
+		return
+	}
+
+	existing, err := os.ReadFile(path)
+	if err != nil {
+		diff := utils.UnifiedDiff("/dev/null", path, "", content)
+		g.Logger.Info("[DRY RUN] Would create file: %s (new file)\n%s", path, strings.TrimSuffix(diff, "\n"))
+		return
+	}
+
+	diff := utils.UnifiedDiff(path, path, string(existing), content)
+	if diff == "" {
+		g.Logger.Info("[DRY RUN] Would leave unchanged: %s", path)
+		return
+	}
+	g.Logger.Info("[DRY RUN] Would update file: %s\n%s", path, strings.TrimSuffix(diff, "\n"))
 }
 
 // writeTemplate writes a templated file.
@@ -208,12 +402,22 @@ func (g *Generator) createClauseConfig(projectPath string) error {
 		return err
 	}
 
-	// Save configuration
-	saver := config.NewSaver()
-	if !g.DryRun {
-		if err := saver.Save(g.Config, filepath.Join(clauseDir, "config.yaml")); err != nil {
+	// Save configuration; existing files are handled by the overwrite policy
+	saver := config.NewSaver(config.WithBackup(false))
+	configPath := filepath.Join(clauseDir, "config.yaml")
+	write := g.claimPath(configPath)
+	if g.DryRun && write {
+		data, err := g.Config.MarshalCanonical()
+		if err != nil {
+			return fmt.Errorf("failed to encode config: %w", err)
+		}
+		g.previewFile(configPath, string(data))
+	}
+	if !g.DryRun && write {
+		if err := saver.Save(g.Config, configPath); err != nil {
 			return fmt.Errorf("failed to save config: %w", err)
 		}
+		g.emit(Event{Type: EventFileWritten, Phase: g.phase, Path: g.targetPath(configPath)})
 	}
 
 	return nil
@@ -227,6 +431,16 @@ func (g *Generator) createCommonFiles(projectPath string) error {
 		return err
 	}
 
+	// Create CONTRIBUTING.md
+	if err := g.createContributing(projectPath); err != nil {
+		return err
+	}
+
+	// Create CHANGELOG.md
+	if err := g.createChangelog(projectPath); err != nil {
+		return err
+	}
+
 	// Create .gitignore
 	gitignoreContent := g.generateGitignore()
 	if err := g.writeFile(filepath.Join(projectPath, ".gitignore"), gitignoreContent); err != nil {
@@ -239,6 +453,16 @@ func (g *Generator) createCommonFiles(projectPath string) error {
 		return err
 	}
 
+	// Create the Makefile or justfile
+	if err := g.createTaskRunner(projectPath); err != nil {
+		return err
+	}
+
+	// Create the dev container for Codespaces and VS Code
+	if err := g.createDevContainer(projectPath); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -255,37 +479,6 @@ func (g *Generator) generateREADME() string {
 `, g.Config.Metadata.Name, g.Config.Metadata.Description)
 }
 
-// generateGitignore generates .gitignore content.
-func (g *Generator) generateGitignore() string {
-	var content strings.Builder
-
-	content.WriteString("# Dependencies\n")
-	content.WriteString("node_modules/\n")
-	content.WriteString("vendor/\n")
-	content.WriteString("__pycache__/\n")
-	content.WriteString("*.pyc\n\n")
-
-	content.WriteString("# Build outputs\n")
-	content.WriteString("dist/\n")
-	content.WriteString("build/\n")
-	content.WriteString("*.exe\n\n")
-
-	content.WriteString("# Environment\n")
-	content.WriteString(".env\n")
-	content.WriteString(".env.local\n\n")
-
-	content.WriteString("# IDE\n")
-	content.WriteString(".idea/\n")
-	content.WriteString(".vscode/\n")
-	content.WriteString("*.swp\n\n")
-
-	content.WriteString("# OS\n")
-	content.WriteString(".DS_Store\n")
-	content.WriteString("Thumbs.db\n")
-
-	return content.String()
-}
-
 // generateEditorconfig generates .editorconfig content.
 func (g *Generator) generateEditorconfig() string {
 	return `root = true
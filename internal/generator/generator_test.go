@@ -0,0 +1,56 @@
+package generator
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/clause-cli/clause/internal/config"
+	"github.com/clause-cli/clause/pkg/output"
+)
+
+// testConfig returns the named preset with a project name set.
+func testConfig(t *testing.T, preset string) *config.ProjectConfig {
+	t.Helper()
+
+	cfg, err := config.LoadPreset(preset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.Metadata.Name = "demo"
+	return cfg
+}
+
+// generateProject generates cfg into a new directory, without initializing
+// git, and returns the project path.
+func generateProject(t *testing.T, cfg *config.ProjectConfig, opts ...GeneratorOption) string {
+	t.Helper()
+
+	dir := filepath.Join(t.TempDir(), "demo")
+	opts = append([]GeneratorOption{
+		WithLogger(output.NewLogger(output.WithWriter(io.Discard))),
+		WithSkipSections(SectionGit),
+	}, opts...)
+	if err := NewGenerator(cfg, opts...).Generate(dir); err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	return dir
+}
+
+// readProjectFile returns the contents of a generated file.
+func readProjectFile(t *testing.T, dir, rel string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(rel)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+// projectFileExists reports whether a generated file exists.
+func projectFileExists(dir, rel string) bool {
+	_, err := os.Stat(filepath.Join(dir, filepath.FromSlash(rel)))
+	return err == nil
+}
@@ -0,0 +1,141 @@
+package generator
+
+import "strings"
+
+// gitignoreSection is a titled group of .gitignore entries.
+type gitignoreSection struct {
+	title   string
+	entries []string
+}
+
+// nodeIgnores are the entries for any Node.js project.
+var nodeIgnores = gitignoreSection{"Node.js", []string{
+	"node_modules/",
+	"npm-debug.log*",
+	"yarn-error.log*",
+	".pnpm-debug.log*",
+	"coverage/",
+}}
+
+// frontendIgnores lists the build output of each frontend framework.
+var frontendIgnores = map[string][]string{
+	"react":     {"dist/"},
+	"vue":       {"dist/"},
+	"svelte":    {"dist/"},
+	"solid":     {"dist/"},
+	"angular":   {"dist/", ".angular/"},
+	"nextjs":    {".next/", "out/", "next-env.d.ts"},
+	"nuxt":      {".nuxt/", ".output/", "dist/"},
+	"sveltekit": {".svelte-kit/", "build/"},
+	"remix":     {".cache/", "build/", "public/build/"},
+	"astro":     {".astro/", "dist/"},
+}
+
+// backendIgnores lists the dependency and build entries of each backend
+// language.
+var backendIgnores = map[string]gitignoreSection{
+	"python": {"Python", []string{
+		"__pycache__/",
+		"*.py[cod]",
+		".venv/",
+		"venv/",
+		"*.egg-info/",
+		".pytest_cache/",
+		".mypy_cache/",
+		".ruff_cache/",
+	}},
+	"node":       nodeIgnores,
+	"typescript": {"TypeScript", append(append([]string(nil), nodeIgnores.entries...), "dist/")},
+	"go": {"Go", []string{
+		"bin/",
+		"*.exe",
+		"*.test",
+		"coverage.out",
+	}},
+	"rust": {"Rust", []string{
+		"target/",
+	}},
+	"ruby": {"Ruby", []string{
+		".bundle/",
+		"vendor/bundle/",
+		"log/",
+		"tmp/",
+	}},
+	"elixir": {"Elixir", []string{
+		"_build/",
+		"deps/",
+		"*.ez",
+	}},
+	"java": {"Java", []string{
+		"target/",
+		"build/",
+		".gradle/",
+		"*.class",
+	}},
+}
+
+// commonIgnores are written for every project, after the stack entries.
+var commonIgnores = []gitignoreSection{
+	{"Environment", []string{".env", ".env.local", ".env.*.local"}},
+	{"Logs", []string{"*.log"}},
+	{"IDE", []string{".idea/", ".vscode/", "*.swp"}},
+	{"OS", []string{".DS_Store", "Thumbs.db"}},
+}
+
+// gitignoreSections returns the .gitignore sections for the configured
+// frontend framework, backend language and infrastructure.
+func (g *Generator) gitignoreSections() []gitignoreSection {
+	var sections []gitignoreSection
+
+	if fe := g.Config.Frontend; fe.Enabled {
+		sections = append(sections, nodeIgnores)
+		build := frontendIgnores[fe.Framework]
+		if fe.Features.Storybook {
+			build = append(append([]string(nil), build...), "storybook-static/")
+		}
+		if len(build) > 0 {
+			sections = append(sections, gitignoreSection{"Frontend build output", build})
+		}
+	}
+
+	if be := g.Config.Backend; be.Enabled {
+		if section, ok := backendIgnores[be.Language]; ok {
+			sections = append(sections, section)
+		}
+	}
+
+	if g.Config.Infrastructure.DockerCompose {
+		sections = append(sections, gitignoreSection{"Docker", []string{"docker-compose.override.yml"}})
+	}
+
+	return append(sections, commonIgnores...)
+}
+
+// generateGitignore generates .gitignore content. Entries shared by several
+// sections, such as node_modules/ for a Node.js frontend and backend, are
+// written once, under the first section that lists them.
+func (g *Generator) generateGitignore() string {
+	var content strings.Builder
+	seen := make(map[string]bool)
+
+	for _, section := range g.gitignoreSections() {
+		var entries []string
+		for _, entry := range section.entries {
+			if !seen[entry] {
+				seen[entry] = true
+				entries = append(entries, entry)
+			}
+		}
+		if len(entries) == 0 {
+			continue
+		}
+
+		if content.Len() > 0 {
+			content.WriteString("\n")
+		}
+		content.WriteString("# " + section.title + "\n")
+		content.WriteString(strings.Join(entries, "\n") + "\n")
+	}
+
+	return content.String()
+}
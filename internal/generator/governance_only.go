@@ -0,0 +1,70 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/clause-cli/clause/internal/config"
+	"github.com/clause-cli/clause/internal/governance"
+	"github.com/clause-cli/clause/pkg/utils"
+)
+
+// InitGovernanceOnly adds the AI governance layer to an existing project
+// without touching its source files. The stack is detected from the project
+// with config.DetectConfig; cfg, if given, supplies the metadata and
+// governance preferences. The .clause directory and the instruction files
+// of the configured assistants are written. A project that already has a
+// .clause/config.yaml is refused rather than overwritten.
+func InitGovernanceOnly(projectDir string, cfg *config.ProjectConfig) error {
+	return initGovernanceOnly(projectDir, cfg, false)
+}
+
+// InitGovernanceOnlyForce is InitGovernanceOnly with full-overwrite
+// behavior: an existing .clause/config.yaml and context.yaml are replaced
+// instead of kept or merged, and hand-written assistant files are replaced,
+// keeping a backup.
+func InitGovernanceOnlyForce(projectDir string, cfg *config.ProjectConfig) error {
+	return initGovernanceOnly(projectDir, cfg, true)
+}
+
+// initGovernanceOnly adds the governance layer, overwriting existing files
+// when force is set.
+func initGovernanceOnly(projectDir string, cfg *config.ProjectConfig, force bool) error {
+	configPath := filepath.Join(projectDir, ".clause", "config.yaml")
+	if !force && utils.FileExists(configPath) {
+		return fmt.Errorf("%s already exists", configPath)
+	}
+
+	detected, err := config.DetectConfig(projectDir)
+	if err != nil {
+		return fmt.Errorf("failed to detect project stack: %w", err)
+	}
+
+	if cfg != nil {
+		merged := cfg.Clone()
+		merged.Frontend = detected.Frontend
+		merged.Backend = detected.Backend
+		merged.Infrastructure = detected.Infrastructure
+		if merged.Metadata.Name == "" {
+			merged.Metadata.Name = detected.Metadata.Name
+		}
+		if merged.Metadata.Description == "" {
+			merged.Metadata.Description = detected.Metadata.Description
+		}
+		detected = merged
+	}
+	detected.Governance.Enabled = true
+
+	// Save the detected configuration so other commands recognize the project
+	if err := config.Save(detected, configPath); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	gen := governance.NewGenerator(projectDir, detected)
+	gen.Force = force
+	if err := gen.Generate(); err != nil {
+		return fmt.Errorf("failed to generate governance files: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package generator
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// snapshotFiles returns the contents of every file under dir by relative path.
+func snapshotFiles(t *testing.T, dir string) map[string]string {
+	t.Helper()
+
+	files := make(map[string]string)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, path)
+		files[filepath.ToSlash(rel)] = string(data)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+// writeFixture creates the given files under dir.
+func writeFixture(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestInitGovernanceOnly(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture map[string]string
+		want    []string
+	}{
+		{
+			name: "react and fastapi",
+			fixture: map[string]string{
+				"frontend/package.json":    `{"name": "web", "dependencies": {"react": "^18.0.0"}, "devDependencies": {"vite": "^5.0.0"}}`,
+				"frontend/src/App.jsx":     "export default function App() { return null }\n",
+				"backend/requirements.txt": "fastapi\npsycopg2\n",
+				"backend/main.py":          "print('hello')\n",
+			},
+			want: []string{"react (frontend)", "fastapi (backend)", "python (language)"},
+		},
+		{
+			name: "go service",
+			fixture: map[string]string{
+				"go.mod":  "module example.com/svc\n\nrequire github.com/gin-gonic/gin v1.9.0\n",
+				"main.go": "package main\n",
+			},
+			want: []string{"go-gin (backend)", "go (language)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFixture(t, dir, tt.fixture)
+
+			if err := InitGovernanceOnly(dir, nil); err != nil {
+				t.Fatal(err)
+			}
+
+			var context struct {
+				TechStack []string `yaml:"tech_stack"`
+			}
+			if err := yaml.Unmarshal([]byte(readProjectFile(t, dir, ".clause/context.yaml")), &context); err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range tt.want {
+				found := false
+				for _, tech := range context.TechStack {
+					found = found || tech == want
+				}
+				if !found {
+					t.Errorf("tech_stack %q does not list %q", context.TechStack, want)
+				}
+			}
+
+			// Only governance files are added and the sources are untouched
+			after := snapshotFiles(t, dir)
+			var added []string
+			for path, content := range after {
+				if original, ok := tt.fixture[path]; ok {
+					if content != original {
+						t.Errorf("%s was modified", path)
+					}
+					continue
+				}
+				if !isGovernanceOutput(path) {
+					added = append(added, path)
+				}
+			}
+			sort.Strings(added)
+			if len(added) > 0 {
+				t.Errorf("created files outside the governance layer: %q", added)
+			}
+		})
+	}
+}
+
+func TestInitGovernanceOnlyExistingConfig(t *testing.T) {
+	dir := t.TempDir()
+	fixture := map[string]string{
+		".clause/config.yaml": "# Hand-written\nmetadata:\n  name: existing\n",
+		"main.go":             "package main\n",
+	}
+	writeFixture(t, dir, fixture)
+
+	if err := InitGovernanceOnly(dir, nil); err == nil {
+		t.Fatal("expected an error for an existing config")
+	}
+	if after := snapshotFiles(t, dir); len(after) != len(fixture) || after[".clause/config.yaml"] != fixture[".clause/config.yaml"] {
+		t.Errorf("project changed after the refusal: %s", fileNames(after))
+	}
+}
+
+func TestInitGovernanceOnlyForce(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, map[string]string{
+		".clause/config.yaml":  "metadata:\n  name: existing\n",
+		".clause/context.yaml": "conventions:\n  - hand-written convention\n",
+		"go.mod":               "module example.com/svc\n\nrequire github.com/gin-gonic/gin v1.9.0\n",
+		"main.go":              "package main\n",
+	})
+
+	if err := InitGovernanceOnlyForce(dir, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg := readProjectFile(t, dir, ".clause/config.yaml"); strings.Contains(cfg, "name: existing") {
+		t.Errorf("config.yaml was not replaced:\n%s", cfg)
+	}
+	context := readProjectFile(t, dir, ".clause/context.yaml")
+	if strings.Contains(context, "hand-written convention") {
+		t.Errorf("context.yaml was merged instead of overwritten:\n%s", context)
+	}
+	if !strings.Contains(context, "go-gin (backend)") {
+		t.Errorf("context.yaml does not list the detected stack:\n%s", context)
+	}
+}
+
+// isGovernanceOutput reports whether path is written by the governance generator.
+func isGovernanceOutput(path string) bool {
+	switch path {
+	case "Brainstorm.md", "CLAUDE.md", ".cursorrules", ".github/copilot-instructions.md":
+		return true
+	}
+	return strings.HasPrefix(path, ".clause/")
+}
@@ -0,0 +1,184 @@
+package generator
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/clause-cli/clause/internal/config"
+)
+
+// LockfileName is the file that records the versions a project was
+// generated with.
+const LockfileName = "clause.lock"
+
+// Lockfile records the concrete versions used to scaffold a project, so
+// regeneration and audits work from the same inputs.
+type Lockfile struct {
+	// ConfigVersion is the configuration schema version
+	ConfigVersion string `yaml:"config_version"`
+
+	// GeneratedAt is when the project was generated
+	GeneratedAt time.Time `yaml:"generated_at"`
+
+	// Runtimes maps runtimes (node, python, go) to their versions
+	Runtimes map[string]string `yaml:"runtimes,omitempty"`
+
+	// Frameworks maps the frontend and backend frameworks to their versions
+	Frameworks map[string]string `yaml:"frameworks,omitempty"`
+
+	// Dependencies maps each generated manifest to its dependency versions
+	Dependencies map[string]map[string]string `yaml:"dependencies,omitempty"`
+}
+
+// backendLanguageVersion returns the configured backend language version,
+// or the version the generator targets for the language.
+func (g *Generator) backendLanguageVersion() string {
+	if v := g.Config.Backend.LanguageVersion; v != "" {
+		return v
+	}
+
+	switch g.Config.Backend.Language {
+	case "python":
+		return config.DefaultValues.Backend.LanguageVersion
+	case "go":
+		return "1.21"
+	case "node", "typescript":
+		return g.Config.ResolvedNodeVersion()
+	}
+	return ""
+}
+
+// lockVersion returns v, or "unpinned" when no version was configured.
+func lockVersion(v string) string {
+	if v == "" {
+		return "unpinned"
+	}
+	return v
+}
+
+// writeLockfile writes clause.lock to the project at path, reading the
+// dependency versions back from the manifests written during generation.
+func (g *Generator) writeLockfile(path string) error {
+	lock := Lockfile{
+		ConfigVersion: config.ConfigVersion,
+		GeneratedAt:   time.Now().UTC().Truncate(time.Second),
+		Runtimes:      make(map[string]string),
+		Frameworks:    make(map[string]string),
+		Dependencies:  make(map[string]map[string]string),
+	}
+
+	if g.Config.Frontend.Enabled {
+		lock.Runtimes["node"] = g.Config.ResolvedNodeVersion()
+		if framework := g.Config.Frontend.Framework; framework != "" {
+			version := g.Config.Frontend.FrameworkVersion
+			if version == "" && framework == config.DefaultValues.Frontend.Framework {
+				version = config.DefaultValues.Frontend.FrameworkVersion
+			}
+			lock.Frameworks[framework] = lockVersion(version)
+		}
+	}
+
+	if g.Config.Backend.Enabled {
+		runtime := g.Config.Backend.Language
+		if runtime == "typescript" {
+			runtime = "node"
+		}
+		if version := g.backendLanguageVersion(); runtime != "" && version != "" {
+			lock.Runtimes[runtime] = version
+		}
+		if framework := g.Config.Backend.Framework; framework != "" {
+			lock.Frameworks[framework] = lockVersion(g.Config.Backend.FrameworkVersion)
+		}
+	}
+
+	for _, file := range g.written {
+		deps := readManifestVersions(file)
+		if len(deps) == 0 {
+			continue
+		}
+		rel, err := filepath.Rel(path, file)
+		if err != nil {
+			rel = file
+		}
+		lock.Dependencies[filepath.ToSlash(rel)] = deps
+	}
+
+	data, err := yaml.Marshal(&lock)
+	if err != nil {
+		return err
+	}
+
+	header := "# Generated by Clause. Records the versions this project was scaffolded with.\n"
+	return g.writeFile(filepath.Join(path, LockfileName), header+string(data))
+}
+
+// readManifestVersions returns the dependency versions declared in a
+// package.json, requirements.txt or go.mod, or nil for other files.
+func readManifestVersions(path string) map[string]string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	deps := make(map[string]string)
+	switch filepath.Base(path) {
+	case "package.json":
+		var manifest struct {
+			Dependencies    map[string]string `json:"dependencies"`
+			DevDependencies map[string]string `json:"devDependencies"`
+		}
+		if err := json.Unmarshal(data, &manifest); err != nil {
+			return nil
+		}
+		for name, version := range manifest.Dependencies {
+			deps[name] = version
+		}
+		for name, version := range manifest.DevDependencies {
+			deps[name] = version
+		}
+
+	case "requirements.txt":
+		for _, line := range strings.Split(string(data), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			if i := strings.IndexAny(line, "=<>~!"); i > 0 {
+				deps[line[:i]] = line[i:]
+			} else {
+				deps[line] = "*"
+			}
+		}
+
+	case "go.mod":
+		inRequire := false
+		for _, line := range strings.Split(string(data), "\n") {
+			line = strings.TrimSpace(line)
+			switch {
+			case line == "require (":
+				inRequire = true
+				continue
+			case line == ")":
+				inRequire = false
+				continue
+			case strings.HasPrefix(line, "require "):
+				line = strings.TrimPrefix(line, "require ")
+			case !inRequire:
+				continue
+			}
+			if fields := strings.Fields(line); len(fields) >= 2 {
+				deps[fields[0]] = fields[1]
+			}
+		}
+
+	default:
+		return nil
+	}
+
+	return deps
+}
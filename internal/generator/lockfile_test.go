@@ -0,0 +1,89 @@
+package generator
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/clause-cli/clause/internal/config"
+)
+
+func TestWriteLockfile(t *testing.T) {
+	tests := []struct {
+		name      string
+		preset    string
+		configure func(cfg *config.ProjectConfig)
+		want      map[string]string
+		absent    []string
+	}{
+		{
+			name:   "frontend node and python backend",
+			preset: "saas",
+			configure: func(cfg *config.ProjectConfig) {
+				cfg.Frontend.NodeVersion = "22"
+				cfg.Backend.LanguageVersion = "3.12"
+			},
+			want: map[string]string{"node": "22", "python": "3.12"},
+		},
+		{
+			name:   "default versions",
+			preset: "saas",
+			want: map[string]string{
+				"node":   "20",
+				"python": config.DefaultValues.Backend.LanguageVersion,
+			},
+		},
+		{
+			name:   "go backend only",
+			preset: "api-only",
+			configure: func(cfg *config.ProjectConfig) {
+				cfg.Backend.Language = "go"
+				cfg.Backend.Framework = "go-gin"
+				cfg.Backend.LanguageVersion = "1.22"
+			},
+			want:   map[string]string{"go": "1.22"},
+			absent: []string{"node", "python"},
+		},
+		{
+			name:   "node backend uses resolved node version",
+			preset: "api-only",
+			configure: func(cfg *config.ProjectConfig) {
+				cfg.Backend.Language = "node"
+				cfg.Backend.Framework = "express"
+				cfg.Backend.LanguageVersion = ""
+				cfg.Frontend.NodeVersion = "22"
+			},
+			want:   map[string]string{"node": "22"},
+			absent: []string{"python"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, tt.preset)
+			if tt.configure != nil {
+				tt.configure(cfg)
+			}
+
+			dir := generateProject(t, cfg)
+
+			var lock Lockfile
+			if err := yaml.Unmarshal([]byte(readProjectFile(t, dir, LockfileName)), &lock); err != nil {
+				t.Fatalf("%s does not parse: %v", LockfileName, err)
+			}
+			if lock.ConfigVersion != config.ConfigVersion {
+				t.Errorf("config_version = %q, want %q", lock.ConfigVersion, config.ConfigVersion)
+			}
+			for runtime, want := range tt.want {
+				if got := lock.Runtimes[runtime]; got != want {
+					t.Errorf("runtimes[%s] = %q, want %q", runtime, got, want)
+				}
+			}
+			for _, runtime := range tt.absent {
+				if got, ok := lock.Runtimes[runtime]; ok {
+					t.Errorf("runtimes[%s] = %q, want no entry", runtime, got)
+				}
+			}
+		})
+	}
+}
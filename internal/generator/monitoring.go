@@ -0,0 +1,188 @@
+package generator
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// Supported monitoring SDK providers.
+const (
+	monitoringSentry  = "sentry"
+	monitoringDatadog = "datadog"
+)
+
+// monitoringProviders returns the monitoring SDKs that should be wired into
+// the generated backend. Both the metrics provider and the error tracking
+// provider are considered; unsupported providers are ignored.
+func (g *Generator) monitoringProviders() []string {
+	monitoring := g.Config.Infrastructure.Monitoring
+	if !monitoring.Enabled {
+		return nil
+	}
+
+	var providers []string
+	add := func(provider string) {
+		provider = strings.ToLower(provider)
+		if provider != monitoringSentry && provider != monitoringDatadog {
+			return
+		}
+		for _, p := range providers {
+			if p == provider {
+				return
+			}
+		}
+		providers = append(providers, provider)
+	}
+
+	add(monitoring.Provider)
+	if monitoring.ErrorTracking {
+		add(monitoring.ErrorTrackingProvider)
+	}
+
+	return providers
+}
+
+// generateMonitoring writes the monitoring integration files that are not
+// part of the backend entry point. The environment placeholders are written
+// with the backend .env.example.
+func (g *Generator) generateMonitoring(projectPath string) error {
+	providers := g.monitoringProviders()
+	if len(providers) == 0 {
+		return nil
+	}
+
+	if g.Config.Backend.Language == "go" {
+		backendDir := filepath.Join(projectPath, g.Config.Backend.Directory)
+		if err := g.writeFile(filepath.Join(backendDir, "monitoring.go"), g.generateGoMonitoring(providers)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// monitoringEnvVars returns the environment placeholders for the monitoring SDKs.
+func (g *Generator) monitoringEnvVars() []envVar {
+	var vars []envVar
+	for _, provider := range g.monitoringProviders() {
+		switch provider {
+		case monitoringSentry:
+			vars = append(vars, envVar{key: "SENTRY_DSN"})
+		case monitoringDatadog:
+			vars = append(vars,
+				envVar{key: "DD_API_KEY"},
+				envVar{key: "DD_SERVICE", value: g.Config.Metadata.Name + "-backend"},
+				envVar{key: "DD_ENV", value: "development"},
+			)
+		}
+	}
+	return vars
+}
+
+// pythonMonitoringSnippet returns the module-level SDK initialization for main.py.
+func (g *Generator) pythonMonitoringSnippet() string {
+	providers := g.monitoringProviders()
+	if len(providers) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("import os\n\n")
+	for _, provider := range providers {
+		switch provider {
+		case monitoringSentry:
+			b.WriteString("import sentry_sdk\n\n")
+			b.WriteString("sentry_sdk.init(dsn=os.environ.get(\"SENTRY_DSN\"), traces_sample_rate=1.0)\n\n")
+		case monitoringDatadog:
+			b.WriteString("from ddtrace import patch_all\n\n")
+			b.WriteString("patch_all()\n\n")
+		}
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// pythonMonitoringRequirements returns requirements.txt lines for the monitoring SDKs.
+func (g *Generator) pythonMonitoringRequirements() string {
+	var b strings.Builder
+	for _, provider := range g.monitoringProviders() {
+		switch provider {
+		case monitoringSentry:
+			b.WriteString("sentry-sdk>=1.40.0\n")
+		case monitoringDatadog:
+			b.WriteString("ddtrace>=2.0.0\n")
+		}
+	}
+	return b.String()
+}
+
+// nodeMonitoringSnippet returns the SDK initialization placed at the top of src/index.js.
+func (g *Generator) nodeMonitoringSnippet() string {
+	var b strings.Builder
+	for _, provider := range g.monitoringProviders() {
+		switch provider {
+		case monitoringSentry:
+			b.WriteString("const Sentry = require('@sentry/node');\n\n")
+			b.WriteString("Sentry.init({ dsn: process.env.SENTRY_DSN, tracesSampleRate: 1.0 });\n\n")
+		case monitoringDatadog:
+			// dd-trace must be initialized before any instrumented module is loaded.
+			b.WriteString("require('dd-trace').init();\n\n")
+		}
+	}
+	return b.String()
+}
+
+// nodeMonitoringDependencies returns package.json dependency entries for the monitoring SDKs.
+func (g *Generator) nodeMonitoringDependencies() []string {
+	var deps []string
+	for _, provider := range g.monitoringProviders() {
+		switch provider {
+		case monitoringSentry:
+			deps = append(deps, `"@sentry/node": "^7.0.0"`)
+		case monitoringDatadog:
+			deps = append(deps, `"dd-trace": "^5.0.0"`)
+		}
+	}
+	return deps
+}
+
+// goMonitoringRequires returns go.mod require lines for the monitoring SDKs.
+func (g *Generator) goMonitoringRequires() []string {
+	var requires []string
+	for _, provider := range g.monitoringProviders() {
+		switch provider {
+		case monitoringSentry:
+			requires = append(requires, "github.com/getsentry/sentry-go v0.27.0")
+		case monitoringDatadog:
+			requires = append(requires, "gopkg.in/DataDog/dd-trace-go.v1 v1.62.0")
+		}
+	}
+	return requires
+}
+
+// generateGoMonitoring generates monitoring.go, which initializes the SDKs
+// from an init function so main.go stays untouched.
+func (g *Generator) generateGoMonitoring(providers []string) string {
+	var imports, body strings.Builder
+	imports.WriteString("\t\"log\"\n\t\"os\"\n\n")
+	for _, provider := range providers {
+		switch provider {
+		case monitoringSentry:
+			imports.WriteString("\t\"github.com/getsentry/sentry-go\"\n")
+			body.WriteString("\tif err := sentry.Init(sentry.ClientOptions{Dsn: os.Getenv(\"SENTRY_DSN\")}); err != nil {\n")
+			body.WriteString("\t\tlog.Printf(\"sentry initialization failed: %v\", err)\n")
+			body.WriteString("\t}\n")
+		case monitoringDatadog:
+			imports.WriteString("\t\"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer\"\n")
+			body.WriteString("\tif os.Getenv(\"DD_API_KEY\") != \"\" {\n")
+			body.WriteString("\t\ttracer.Start()\n")
+			body.WriteString("\t} else {\n")
+			body.WriteString("\t\tlog.Println(\"DD_API_KEY not set, datadog tracing disabled\")\n")
+			body.WriteString("\t}\n")
+		}
+	}
+
+	return "package main\n\nimport (\n" + imports.String() + ")\n\n" +
+		"// init wires up monitoring before the server starts.\n" +
+		"func init() {\n" + body.String() + "}\n"
+}
@@ -0,0 +1,85 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMonitoringIntegration(t *testing.T) {
+	tests := []struct {
+		name      string
+		language  string
+		framework string
+		provider  string
+		files     map[string][]string
+		notInEnv  []string
+	}{
+		{
+			name: "python sentry", language: "python", framework: "fastapi", provider: "sentry",
+			files: map[string][]string{
+				"backend/main.py":          {"import sentry_sdk", `sentry_sdk.init(dsn=os.environ.get("SENTRY_DSN")`},
+				"backend/requirements.txt": {"sentry-sdk"},
+				"backend/.env.example":     {"SENTRY_DSN="},
+			},
+			notInEnv: []string{"DD_API_KEY"},
+		},
+		{
+			name: "node sentry", language: "node", framework: "express", provider: "sentry",
+			files: map[string][]string{
+				"backend/src/index.js": {"@sentry/node", "process.env.SENTRY_DSN"},
+				"backend/package.json": {"@sentry/node"},
+				"backend/.env.example": {"SENTRY_DSN="},
+			},
+		},
+		{
+			name: "go sentry", language: "go", framework: "go-gin", provider: "sentry",
+			files: map[string][]string{
+				"backend/monitoring.go": {"sentry.Init", `os.Getenv("SENTRY_DSN")`},
+				"backend/.env.example":  {"SENTRY_DSN="},
+			},
+		},
+		{
+			name: "python datadog", language: "python", framework: "fastapi", provider: "datadog",
+			files: map[string][]string{
+				"backend/main.py":      {"patch_all()"},
+				"backend/.env.example": {"DD_API_KEY=", "DD_SERVICE=demo-backend"},
+			},
+			notInEnv: []string{"SENTRY_DSN"},
+		},
+		{
+			name: "unsupported provider", language: "python", framework: "fastapi", provider: "prometheus",
+			files: map[string][]string{
+				"backend/main.py": {"FastAPI("},
+			},
+			notInEnv: []string{"SENTRY_DSN", "DD_API_KEY"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, "saas")
+			cfg.Backend.Language = tt.language
+			cfg.Backend.Framework = tt.framework
+			cfg.Infrastructure.Monitoring.Enabled = true
+			cfg.Infrastructure.Monitoring.Provider = tt.provider
+			cfg.Infrastructure.Monitoring.ErrorTracking = false
+
+			dir := generateProject(t, cfg)
+			for file, wants := range tt.files {
+				content := readProjectFile(t, dir, file)
+				for _, want := range wants {
+					if !strings.Contains(content, want) {
+						t.Errorf("%s does not contain %q:\n%s", file, want, content)
+					}
+				}
+			}
+
+			env := readProjectFile(t, dir, "backend/.env.example")
+			for _, key := range tt.notInEnv {
+				if strings.Contains(env, key) {
+					t.Errorf(".env.example contains %s:\n%s", key, env)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package generator
+
+import (
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestNodeVersionArtifacts(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		want    string
+	}{
+		{"explicit 20", "20", "20"},
+		{"explicit 22", "22", "22"},
+		{"default", "", "20"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, "saas")
+			cfg.Frontend.NodeVersion = tt.version
+			cfg.Infrastructure.Docker = true
+			cfg.Infrastructure.CI = "github-actions"
+
+			dir := generateProject(t, cfg)
+			files := map[string]string{
+				"Dockerfile":                 "FROM node:" + tt.want + "-alpine",
+				".github/workflows/main.yml": "node-version: '" + tt.want + "'",
+				path.Join(cfg.Frontend.Directory, "package.json"): `"node": ">=` + tt.want + `"`,
+			}
+			for file, want := range files {
+				if content := readProjectFile(t, dir, file); !strings.Contains(content, want) {
+					t.Errorf("%s does not contain %q:\n%s", file, want, content)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/clause-cli/clause/pkg/utils"
+)
+
+// OverwritePolicy controls what happens to files that already exist in the
+// project directory.
+type OverwritePolicy string
+
+// Supported overwrite policies.
+const (
+	// Overwrite replaces existing files (the default)
+	Overwrite OverwritePolicy = "overwrite"
+
+	// SkipExisting leaves existing files untouched
+	SkipExisting OverwritePolicy = "skip"
+
+	// Backup copies existing files with utils.BackupFile before replacing them
+	Backup OverwritePolicy = "backup"
+)
+
+// Summary lists what a generation run did with each file.
+type Summary struct {
+	// Created lists files that did not exist before
+	Created []string `json:"created"`
+
+	// Skipped lists existing files left untouched by SkipExisting
+	Skipped []string `json:"skipped"`
+
+	// Overwritten lists existing files that were replaced
+	Overwritten []string `json:"overwritten"`
+
+	// Backups lists the copies made of overwritten files in Backup mode
+	Backups []string `json:"backups"`
+}
+
+// WithOverwritePolicy sets how existing files are handled.
+func WithOverwritePolicy(policy OverwritePolicy) GeneratorOption {
+	return func(g *Generator) {
+		g.Overwrite = policy
+	}
+}
+
+// Summary returns what the last generation run created, skipped and
+// overwrote. In dry run mode it reports what would have happened.
+func (g *Generator) Summary() Summary {
+	return g.summary
+}
+
+// validateOverwritePolicy checks that the overwrite policy is supported.
+func (g *Generator) validateOverwritePolicy() error {
+	return g.Overwrite.validate()
+}
+
+// validate checks that the policy is supported. The empty policy means
+// Overwrite.
+func (p OverwritePolicy) validate() error {
+	switch p {
+	case "", Overwrite, SkipExisting, Backup:
+		return nil
+	default:
+		return fmt.Errorf("unknown overwrite policy %q (supported: overwrite, skip, backup)", p)
+	}
+}
+
+// claimPath applies the overwrite policy to a file about to be written and
+// records the outcome in the summary. It reports whether the file should be
+// written. Staged files are checked against their place in the project
+// directory; backups are made when the staged files are moved there.
+func (g *Generator) claimPath(path string) bool {
+	target := g.targetPath(path)
+	if !utils.FileExists(target) {
+		g.summary.Created = append(g.summary.Created, target)
+		return true
+	}
+
+	if g.Overwrite == SkipExisting {
+		g.summary.Skipped = append(g.summary.Skipped, target)
+		return false
+	}
+
+	g.summary.Overwritten = append(g.summary.Overwritten, target)
+	return true
+}
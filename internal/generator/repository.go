@@ -0,0 +1,54 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/clause-cli/clause/internal/config"
+)
+
+// githubOwner returns the owner of the project's repository when it is
+// hosted on GitHub.
+func (g *Generator) githubOwner() (string, bool) {
+	https, _, err := config.NormalizeRepoURL(g.Config.Metadata.Repository)
+	if err != nil {
+		return "", false
+	}
+	path, ok := strings.CutPrefix(https, "https://github.com/")
+	if !ok {
+		return "", false
+	}
+	return strings.Split(path, "/")[0], true
+}
+
+// createCodeowners writes .github/CODEOWNERS, making the repository owner
+// the default reviewer. It is only generated for repositories on GitHub.
+func (g *Generator) createCodeowners(projectPath string) error {
+	owner, ok := g.githubOwner()
+	if !ok {
+		return nil
+	}
+
+	content := fmt.Sprintf(`# Code owners are requested for review on pull requests that change
+# matching files. See https://docs.github.com/articles/about-code-owners
+
+* @%s
+`, owner)
+	return g.writeFile(filepath.Join(projectPath, ".github", "CODEOWNERS"), content)
+}
+
+// packageRepository returns the package.json repository entry, followed by
+// a comma and the indentation of the next key, or "" when the repository
+// is not set or cannot be parsed.
+func (g *Generator) packageRepository() string {
+	https, _, err := config.NormalizeRepoURL(g.Config.Metadata.Repository)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf(`"repository": {
+    "type": "git",
+    "url": "git+%s.git"
+  },
+  `, https)
+}
@@ -0,0 +1,112 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/clause-cli/clause/pkg/utils"
+)
+
+// changeLog records the changes a generation run made to disk, so a failed
+// run can be undone.
+type changeLog struct {
+	// dirs lists the directories created, parents first
+	dirs []string
+
+	// created lists the files and backups added to the project directory
+	created []string
+
+	// originals holds the previous content of overwritten files
+	originals map[string]originalFile
+}
+
+// originalFile is the content and permissions of a file before it was
+// overwritten.
+type originalFile struct {
+	data []byte
+	mode os.FileMode
+}
+
+// WithKeepOnError keeps the staging directory of a failed generation
+// instead of removing it, for debugging.
+func WithKeepOnError(keep bool) GeneratorOption {
+	return func(g *Generator) {
+		g.KeepOnError = keep
+	}
+}
+
+// ensureDirectory creates path and any missing parents, recording the
+// directories that did not exist.
+func (g *Generator) ensureDirectory(path string) error {
+	var missing []string
+	for dir := filepath.Clean(path); !utils.IsDirectory(dir); dir = filepath.Dir(dir) {
+		missing = append(missing, dir)
+		if filepath.Dir(dir) == dir {
+			break
+		}
+	}
+
+	if err := utils.EnsureDirectory(path); err != nil {
+		return err
+	}
+
+	for i := len(missing) - 1; i >= 0; i-- {
+		g.changes.dirs = append(g.changes.dirs, missing[i])
+	}
+	return nil
+}
+
+// rememberOriginal keeps the content and permissions of an existing file
+// before it is overwritten.
+func (g *Generator) rememberOriginal(path string) error {
+	if _, ok := g.changes.originals[path]; ok {
+		return nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if g.changes.originals == nil {
+		g.changes.originals = make(map[string]originalFile)
+	}
+	g.changes.originals[path] = originalFile{data: data, mode: info.Mode().Perm()}
+	return nil
+}
+
+// rollback undoes a failed run: overwritten files get their previous
+// content and permissions back, and the files, backups and directories it created are
+// removed. Directories that are not empty afterwards are left in place.
+// The staging directory must be removed first.
+func (g *Generator) rollback() error {
+	var errs []error
+
+	for path, original := range g.changes.originals {
+		if err := os.WriteFile(path, original.data, original.mode); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		// WriteFile keeps the mode of a file that already exists
+		if err := os.Chmod(path, original.mode); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, path := range g.changes.created {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	for i := len(g.changes.dirs) - 1; i >= 0; i-- {
+		os.Remove(g.changes.dirs[i])
+	}
+
+	return errors.Join(errs...)
+}
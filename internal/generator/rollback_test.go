@@ -0,0 +1,87 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRollbackRestoresOriginals(t *testing.T) {
+	tests := []struct {
+		name string
+		mode os.FileMode
+	}{
+		{"regular", 0644},
+		{"executable", 0755},
+		{"private", 0600},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "script.sh")
+			if err := os.WriteFile(path, []byte("original"), tt.mode); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.Chmod(path, tt.mode); err != nil {
+				t.Fatal(err)
+			}
+
+			g := &Generator{}
+			if err := g.rememberOriginal(path); err != nil {
+				t.Fatal(err)
+			}
+
+			// Replace the file the way a failed run would
+			if err := os.Remove(path); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, []byte("generated"), 0666); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := g.rollback(); err != nil {
+				t.Fatal(err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != "original" {
+				t.Errorf("content = %q, want %q", data, "original")
+			}
+			info, err := os.Stat(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if info.Mode().Perm() != tt.mode {
+				t.Errorf("mode = %v, want %v", info.Mode().Perm(), tt.mode)
+			}
+		})
+	}
+}
+
+func TestRollbackRemovesCreatedFiles(t *testing.T) {
+	root := t.TempDir()
+	g := &Generator{}
+
+	dir := filepath.Join(root, "a", "b")
+	if err := g.ensureDirectory(dir); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dir, "main.go")
+	if err := os.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	g.changes.created = append(g.changes.created, file)
+
+	if err := g.rollback(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "a")); !os.IsNotExist(err) {
+		t.Errorf("created directories were not removed: %v", err)
+	}
+	if _, err := os.Stat(root); err != nil {
+		t.Errorf("existing directory was removed: %v", err)
+	}
+}
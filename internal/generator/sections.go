@@ -0,0 +1,79 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Generation sections that can be selected with WithSections or excluded
+// with WithSkipSections.
+const (
+	SectionConfig         = "config"
+	SectionCommon         = "common"
+	SectionFrontend       = "frontend"
+	SectionBackend        = "backend"
+	SectionInfrastructure = "infrastructure"
+	SectionGovernance     = "governance"
+	SectionGit            = "git"
+)
+
+// allSections lists every section in generation order.
+var allSections = []string{
+	SectionConfig,
+	SectionCommon,
+	SectionFrontend,
+	SectionBackend,
+	SectionInfrastructure,
+	SectionGovernance,
+	SectionGit,
+}
+
+// WithSections limits generation to the named sections, for example to
+// re-create governance files after editing the context level.
+func WithSections(sections ...string) GeneratorOption {
+	return func(g *Generator) {
+		g.Sections = append(g.Sections, sections...)
+	}
+}
+
+// WithSkipSections excludes the named sections from generation.
+func WithSkipSections(sections ...string) GeneratorOption {
+	return func(g *Generator) {
+		g.SkipSections = append(g.SkipSections, sections...)
+	}
+}
+
+// sectionEnabled reports whether the named section should be generated.
+func (g *Generator) sectionEnabled(section string) bool {
+	if len(g.Sections) > 0 && !containsSection(g.Sections, section) {
+		return false
+	}
+	return !containsSection(g.SkipSections, section)
+}
+
+// validateSections checks that the section filters name known sections and
+// leave at least one section to generate.
+func (g *Generator) validateSections() error {
+	for _, section := range append(append([]string{}, g.Sections...), g.SkipSections...) {
+		if !containsSection(allSections, section) {
+			return fmt.Errorf("unknown section: %s (supported: %s)", section, strings.Join(allSections, ", "))
+		}
+	}
+
+	for _, section := range allSections {
+		if g.sectionEnabled(section) {
+			return nil
+		}
+	}
+	return fmt.Errorf("section filters exclude every section")
+}
+
+// containsSection reports whether sections contains name, ignoring case.
+func containsSection(sections []string, name string) bool {
+	for _, section := range sections {
+		if strings.EqualFold(section, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package generator
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestSectionFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []GeneratorOption
+		want    []string // files or directories that must exist
+		notWant []string // files or directories that must not exist
+	}{
+		{
+			name:    "governance only",
+			opts:    []GeneratorOption{WithSections(SectionGovernance)},
+			want:    []string{"ai_prompt_guidelines/architecture.md", "SECURITY.md"},
+			notWant: []string{"frontend", "backend", "Dockerfile", "docker-compose.yml", ".github/workflows", "README.md", ".clause/config.yaml"},
+		},
+		{
+			name:    "governance and infrastructure",
+			opts:    []GeneratorOption{WithSections(SectionGovernance, SectionInfrastructure)},
+			want:    []string{"ai_prompt_guidelines/architecture.md", "docker-compose.yml"},
+			notWant: []string{"frontend", "backend", "README.md"},
+		},
+		{
+			name:    "skip frontend and backend",
+			opts:    []GeneratorOption{WithSkipSections(SectionFrontend, SectionBackend)},
+			want:    []string{"README.md", ".clause/config.yaml", "ai_prompt_guidelines/architecture.md", "docker-compose.yml"},
+			notWant: []string{"frontend", "backend"},
+		},
+		{
+			name:    "case insensitive",
+			opts:    []GeneratorOption{WithSections("Frontend")},
+			want:    []string{"frontend/package.json"},
+			notWant: []string{"backend", "ai_prompt_guidelines"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, "saas")
+			cfg.Frontend.Directory = "frontend"
+			cfg.Backend.Directory = "backend"
+			cfg.Infrastructure.DockerCompose = true
+
+			dir := generateProject(t, cfg, tt.opts...)
+			for _, path := range tt.want {
+				if !projectFileExists(dir, path) {
+					t.Errorf("%s was not generated", path)
+				}
+			}
+			for _, path := range tt.notWant {
+				if projectFileExists(dir, path) {
+					t.Errorf("%s was generated", path)
+				}
+			}
+		})
+	}
+}
+
+func TestSectionFiltersGovernanceFilesOnly(t *testing.T) {
+	cfg := testConfig(t, "saas")
+	dir := generateProject(t, cfg, WithSections(SectionGovernance))
+
+	var unexpected []string
+	for path := range snapshotFiles(t, dir) {
+		if !isGovernanceFile(path) {
+			unexpected = append(unexpected, path)
+		}
+	}
+	sort.Strings(unexpected)
+	if len(unexpected) > 0 {
+		t.Errorf("governance-only generation wrote other files: %v", unexpected)
+	}
+}
+
+// isGovernanceFile reports whether path is written by the governance section.
+func isGovernanceFile(path string) bool {
+	switch path {
+	case "SECURITY.md", "CODEOWNERS", ".github/CODEOWNERS", ".github/dependabot.yml", "Brainstorm.md":
+		return true
+	}
+	return strings.HasPrefix(path, "ai_prompt_guidelines/") || strings.HasPrefix(path, ".clause/")
+}
+
+func TestSectionFiltersInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []GeneratorOption
+		wantErr string
+	}{
+		{"unknown section", []GeneratorOption{WithSections("docs")}, "unknown section: docs"},
+		{"unknown skipped section", []GeneratorOption{WithSkipSections("docs")}, "unknown section: docs"},
+		{"nothing left", []GeneratorOption{WithSections(SectionGovernance), WithSkipSections(SectionGovernance)}, "exclude every section"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewGenerator(testConfig(t, "saas"), tt.opts...).validateSections()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validateSections error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/clause-cli/clause/internal/config"
+)
+
+// createSecurityFiles writes SECURITY.md and .github/dependabot.yml. Both are
+// only generated for governed projects that use GitHub Actions.
+func (g *Generator) createSecurityFiles(projectPath string) error {
+	if !g.Config.Governance.Enabled || g.Config.Infrastructure.CI != "github-actions" {
+		return nil
+	}
+
+	if err := g.writeFile(filepath.Join(projectPath, "SECURITY.md"), g.generateSecurityMd()); err != nil {
+		return err
+	}
+
+	dependabot := g.generateDependabotConfig()
+	if err := g.writeFile(filepath.Join(projectPath, ".github", "dependabot.yml"), dependabot); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var emailRegex = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+
+// securityContact describes how vulnerabilities should be reported, derived
+// from the author email or the repository URL.
+func (g *Generator) securityContact() string {
+	if email := emailRegex.FindString(g.Config.Metadata.Author); email != "" {
+		return fmt.Sprintf("email **%s**", email)
+	}
+
+	repo := g.Config.Metadata.Repository
+	if https, _, err := config.NormalizeRepoURL(repo); err == nil {
+		repo = https
+	}
+	if strings.HasPrefix(repo, "https://github.com/") {
+		return fmt.Sprintf("open a private advisory at %s/security/advisories/new", repo)
+	}
+	if repo != "" {
+		return fmt.Sprintf("contact the maintainers through %s", repo)
+	}
+
+	return "contact the project maintainers privately"
+}
+
+// generateSecurityMd generates SECURITY.md content.
+func (g *Generator) generateSecurityMd() string {
+	return fmt.Sprintf(`# Security Policy
+
+## Supported Versions
+
+Security updates are provided for the latest release of **%s**.
+
+## Reporting a Vulnerability
+
+Please do not report security vulnerabilities through public issues.
+
+To report a vulnerability, %s. Include a description of the issue,
+steps to reproduce it, and any known impact.
+
+You should receive a response within 72 hours. Once the issue is confirmed,
+a fix will be prepared and released as soon as possible, and you will be
+credited in the release notes unless you prefer otherwise.
+`, g.Config.Metadata.Name, g.securityContact())
+}
+
+// dependabotEcosystem is a package ecosystem entry in dependabot.yml.
+type dependabotEcosystem struct {
+	name      string
+	directory string
+}
+
+// dependabotEcosystems returns the package ecosystems detected from the
+// enabled frontend and backend.
+func (g *Generator) dependabotEcosystems() []dependabotEcosystem {
+	var ecosystems []dependabotEcosystem
+
+	if g.Config.Frontend.Enabled {
+		ecosystems = append(ecosystems, dependabotEcosystem{"npm", "/" + g.Config.Frontend.Directory})
+	}
+
+	if g.Config.Backend.Enabled {
+		dir := "/" + g.Config.Backend.Directory
+		switch g.Config.Backend.Language {
+		case "python":
+			ecosystems = append(ecosystems, dependabotEcosystem{"pip", dir})
+		case "node", "typescript":
+			ecosystems = append(ecosystems, dependabotEcosystem{"npm", dir})
+		case "go":
+			ecosystems = append(ecosystems, dependabotEcosystem{"gomod", dir})
+		}
+	}
+
+	// Keep workflow actions up to date as well
+	ecosystems = append(ecosystems, dependabotEcosystem{"github-actions", "/"})
+
+	return ecosystems
+}
+
+// generateDependabotConfig generates .github/dependabot.yml content.
+func (g *Generator) generateDependabotConfig() string {
+	var content strings.Builder
+
+	content.WriteString("version: 2\n")
+	content.WriteString("updates:\n")
+	for _, eco := range g.dependabotEcosystems() {
+		content.WriteString(fmt.Sprintf("  - package-ecosystem: \"%s\"\n", eco.name))
+		content.WriteString(fmt.Sprintf("    directory: \"%s\"\n", eco.directory))
+		content.WriteString("    schedule:\n")
+		content.WriteString("      interval: \"weekly\"\n")
+		content.WriteString("    open-pull-requests-limit: 5\n")
+	}
+
+	return content.String()
+}
@@ -0,0 +1,111 @@
+package generator
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestDependabotEcosystems(t *testing.T) {
+	tests := []struct {
+		name     string
+		frontend bool
+		backend  bool
+		language string
+		want     []string
+	}{
+		{"python and react", true, true, "python", []string{"npm /frontend", "pip /backend", "github-actions /"}},
+		{"node backend", true, true, "node", []string{"npm /frontend", "npm /backend", "github-actions /"}},
+		{"go backend only", false, true, "go", []string{"gomod /backend", "github-actions /"}},
+		{"frontend only", true, false, "python", []string{"npm /frontend", "github-actions /"}},
+		{"unknown language", false, true, "elixir", []string{"github-actions /"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, "saas")
+			cfg.Frontend.Enabled, cfg.Frontend.Directory = tt.frontend, "frontend"
+			cfg.Backend.Enabled, cfg.Backend.Directory = tt.backend, "backend"
+			cfg.Backend.Language = tt.language
+
+			var parsed struct {
+				Version int `yaml:"version"`
+				Updates []struct {
+					Ecosystem string `yaml:"package-ecosystem"`
+					Directory string `yaml:"directory"`
+				} `yaml:"updates"`
+			}
+			if err := yaml.Unmarshal([]byte(NewGenerator(cfg).generateDependabotConfig()), &parsed); err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, update := range parsed.Updates {
+				got = append(got, update.Ecosystem+" "+update.Directory)
+			}
+			if parsed.Version != 2 || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got version %d with %q, want version 2 with %q", parsed.Version, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSecurityFilesGating(t *testing.T) {
+	tests := []struct {
+		name       string
+		ci         string
+		governance bool
+		want       bool
+	}{
+		{"github actions", "github-actions", true, true},
+		{"gitlab", "gitlab-ci", true, false},
+		{"ungoverned", "github-actions", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, "saas")
+			cfg.Infrastructure.CI = tt.ci
+			cfg.Governance.Enabled = tt.governance
+
+			g := NewGenerator(cfg)
+			dir := t.TempDir()
+			if err := g.createSecurityFiles(dir); err != nil {
+				t.Fatal(err)
+			}
+			for _, file := range []string{"SECURITY.md", ".github/dependabot.yml"} {
+				if got := projectFileExists(dir, file); got != tt.want {
+					t.Errorf("%s exists = %v, want %v", file, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestSecurityContact(t *testing.T) {
+	tests := []struct {
+		name       string
+		author     string
+		repository string
+		want       string
+	}{
+		{"author email", "Jane Doe <jane@example.com>", "acme/shop", "email **jane@example.com**"},
+		{"github repository", "Jane Doe", "git@github.com:acme/shop.git", "https://github.com/acme/shop/security/advisories/new"},
+		{"other repository", "", "https://gitlab.com/acme/shop", "contact the maintainers through https://gitlab.com/acme/shop"},
+		{"nothing known", "", "", "contact the project maintainers privately"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, "saas")
+			cfg.Metadata.Author = tt.author
+			cfg.Metadata.Repository = tt.repository
+
+			if got := NewGenerator(cfg).securityContact(); !strings.Contains(got, tt.want) {
+				t.Errorf("securityContact() = %q, want it to contain %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package generator
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/clause-cli/clause/pkg/utils"
+)
+
+// createStaging creates the temporary directory a run builds the project
+// in. It sits next to the project directory, so moving files into place is
+// a rename on the same file system.
+func (g *Generator) createStaging(projectPath string) (string, error) {
+	projectPath = filepath.Clean(projectPath)
+	parent := filepath.Dir(projectPath)
+	if err := g.ensureDirectory(parent); err != nil {
+		return "", err
+	}
+
+	staging, err := os.MkdirTemp(parent, "."+filepath.Base(projectPath)+".clause-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create staging directory: %w", err)
+	}
+	return staging, nil
+}
+
+// targetPath maps a path in the staging directory to where it ends up in
+// the project directory. Other paths are returned unchanged.
+func (g *Generator) targetPath(path string) string {
+	if g.staging == "" {
+		return path
+	}
+	rel, err := filepath.Rel(g.staging, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return path
+	}
+	return filepath.Join(g.target, rel)
+}
+
+// commitStaging moves the staged project into the project directory. A
+// project directory that does not exist yet is renamed into place in one
+// step. Otherwise each staged file is renamed over its target, so every
+// file holds either its old or its new content; the files replaced are
+// recorded so a failed merge can be rolled back.
+func (g *Generator) commitStaging() error {
+	if _, err := os.Stat(g.target); os.IsNotExist(err) {
+		if err := os.Rename(g.staging, g.target); err != nil {
+			return fmt.Errorf("failed to move project into place: %w", err)
+		}
+		return nil
+	}
+
+	return filepath.WalkDir(g.staging, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		target := g.targetPath(path)
+
+		if d.IsDir() {
+			return g.ensureDirectory(target)
+		}
+
+		if utils.FileExists(target) {
+			if err := g.rememberOriginal(target); err != nil {
+				return err
+			}
+			if g.Overwrite == Backup {
+				backup, err := utils.BackupFile(target)
+				if err != nil {
+					return fmt.Errorf("failed to back up %s: %w", target, err)
+				}
+				g.changes.created = append(g.changes.created, backup)
+				g.summary.Backups = append(g.summary.Backups, backup)
+			}
+		} else {
+			g.changes.created = append(g.changes.created, target)
+		}
+
+		if err := os.Rename(path, target); err != nil {
+			return fmt.Errorf("failed to move %s into place: %w", target, err)
+		}
+		return nil
+	})
+}
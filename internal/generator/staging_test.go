@@ -0,0 +1,121 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/clause-cli/clause/pkg/output"
+)
+
+// snapshotProject returns the files under dir, or none when dir does not
+// exist.
+func snapshotProject(t *testing.T, dir string) map[string]string {
+	t.Helper()
+
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return map[string]string{}
+	}
+	return snapshotFiles(t, dir)
+}
+
+func TestGenerateStagesProject(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing map[string]string
+		// failPhase is the phase that is made to fail, or empty to succeed
+		failPhase string
+	}{
+		{name: "new project"},
+		{name: "existing project", existing: map[string]string{"notes.txt": "keep me", "docs/plan.md": "plan"}},
+		{name: "new project fails", failPhase: "Creating backend structure"},
+		{
+			name:      "existing project fails",
+			existing:  map[string]string{"notes.txt": "keep me", "README.md": "my readme"},
+			failPhase: "Creating backend structure",
+		},
+		{
+			name:      "failure after most files",
+			existing:  map[string]string{"notes.txt": "keep me"},
+			failPhase: "Creating security policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parent := t.TempDir()
+			dir := filepath.Join(parent, "demo")
+			writeFixture(t, dir, tt.existing)
+			before := snapshotProject(t, dir)
+
+			cfg := testConfig(t, "saas")
+			g := NewGenerator(cfg,
+				WithLogger(output.NewLogger(output.WithWriter(io.Discard))),
+				WithSkipSections(SectionGit),
+			)
+
+			g.beforeWrite = func(path string) error {
+				if g.phase == tt.failPhase {
+					return fmt.Errorf("injected failure writing %s", path)
+				}
+				return nil
+			}
+			err := g.Generate(dir)
+
+			if leftovers, _ := filepath.Glob(filepath.Join(parent, ".demo.clause-*")); len(leftovers) > 0 {
+				t.Errorf("staging directories left behind: %v", leftovers)
+			}
+
+			after := snapshotProject(t, dir)
+			if tt.failPhase != "" {
+				if err == nil {
+					t.Fatal("expected generation to fail")
+				}
+				if !reflect.DeepEqual(after, before) {
+					t.Errorf("project directory changed by a failed run:\nbefore %v\nafter  %v", fileNames(before), fileNames(after))
+				}
+				if len(tt.existing) == 0 {
+					if _, statErr := os.Stat(dir); !os.IsNotExist(statErr) {
+						t.Errorf("project directory created by a failed run: %v", statErr)
+					}
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, rel := range []string{
+				".clause/config.yaml",
+				"README.md",
+				cfg.Frontend.Directory + "/package.json",
+				cfg.Backend.Directory + "/requirements.txt",
+				LockfileName,
+			} {
+				if _, ok := after[rel]; !ok {
+					t.Errorf("generated project is missing %s", rel)
+				}
+			}
+			for name, content := range tt.existing {
+				if after[name] != content {
+					t.Errorf("%s = %q, want the existing %q", name, after[name], content)
+				}
+			}
+		})
+	}
+}
+
+// fileNames returns the sorted names of files, for readable failures.
+func fileNames(files map[string]string) string {
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
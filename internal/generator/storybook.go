@@ -0,0 +1,138 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// storybookVersion is the Storybook release the scaffolded config targets.
+const storybookVersion = "^8.0.0"
+
+// storybookFramework returns the Storybook framework package for the
+// configured frontend framework.
+func (g *Generator) storybookFramework() string {
+	switch g.Config.Frontend.Framework {
+	case "nextjs":
+		return "@storybook/nextjs"
+	case "vue", "nuxt":
+		return "@storybook/vue3-vite"
+	case "svelte", "sveltekit":
+		return "@storybook/svelte-vite"
+	case "angular":
+		return "@storybook/angular"
+	default:
+		return "@storybook/react-vite"
+	}
+}
+
+// storybookScripts returns the package.json scripts for Storybook.
+func (g *Generator) storybookScripts() []string {
+	if !g.Config.Frontend.Features.Storybook {
+		return nil
+	}
+	return []string{
+		`"storybook": "storybook dev -p 6006"`,
+		`"build-storybook": "storybook build"`,
+	}
+}
+
+// storybookDependencies returns the package.json devDependencies for Storybook.
+func (g *Generator) storybookDependencies() []string {
+	if !g.Config.Frontend.Features.Storybook {
+		return nil
+	}
+	return []string{
+		fmt.Sprintf(`"%s": "%s"`, g.storybookFramework(), storybookVersion),
+		fmt.Sprintf(`"@storybook/addon-essentials": "%s"`, storybookVersion),
+		fmt.Sprintf(`"storybook": "%s"`, storybookVersion),
+	}
+}
+
+// createStorybook writes the .storybook config and a sample story when
+// Storybook is enabled.
+func (g *Generator) createStorybook(frontendDir string) error {
+	if !g.Config.Frontend.Features.Storybook {
+		return nil
+	}
+
+	ext := "ts"
+	if !g.Config.Frontend.TypeScript {
+		ext = "js"
+	}
+
+	storybookDir := filepath.Join(frontendDir, ".storybook")
+	if err := g.createDirectory(storybookDir); err != nil {
+		return err
+	}
+	if err := g.writeFile(filepath.Join(storybookDir, "main."+ext), g.generateStorybookMain()); err != nil {
+		return err
+	}
+
+	storyFile := "App.stories.tsx"
+	if !g.Config.Frontend.TypeScript {
+		storyFile = "App.stories.jsx"
+	}
+	return g.writeFile(filepath.Join(frontendDir, "src", storyFile), g.generateSampleStory())
+}
+
+// generateStorybookMain generates .storybook/main.ts.
+func (g *Generator) generateStorybookMain() string {
+	framework := g.storybookFramework()
+
+	if !g.Config.Frontend.TypeScript {
+		return fmt.Sprintf(`/** @type { import('%s').StorybookConfig } */
+const config = {
+  stories: ['../src/**/*.stories.@(js|jsx)'],
+  addons: ['@storybook/addon-essentials'],
+  framework: {
+    name: '%s',
+    options: {},
+  },
+};
+
+export default config;
+`, framework, framework)
+	}
+
+	return fmt.Sprintf(`import type { StorybookConfig } from '%s';
+
+const config: StorybookConfig = {
+  stories: ['../src/**/*.stories.@(ts|tsx)'],
+  addons: ['@storybook/addon-essentials'],
+  framework: {
+    name: '%s',
+    options: {},
+  },
+};
+
+export default config;
+`, framework, framework)
+}
+
+// generateSampleStory generates a story for the App component.
+func (g *Generator) generateSampleStory() string {
+	if !g.Config.Frontend.TypeScript {
+		return fmt.Sprintf(`import App from '%s';
+
+export default {
+  title: 'App',
+  component: App,
+};
+
+export const Default = {};
+`, g.appComponentImport())
+	}
+
+	return fmt.Sprintf(`import type { Meta, StoryObj } from '%s';
+import App from '%s';
+
+const meta: Meta<typeof App> = {
+  title: 'App',
+  component: App,
+};
+
+export default meta;
+
+export const Default: StoryObj<typeof App> = {};
+`, g.storybookFramework(), g.appComponentImport())
+}
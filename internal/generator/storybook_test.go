@@ -0,0 +1,78 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStorybook(t *testing.T) {
+	tests := []struct {
+		name       string
+		framework  string
+		typeScript bool
+		enabled    bool
+		main       string
+		story      string
+		wantMain   []string
+	}{
+		{
+			name: "react", framework: "react", typeScript: true, enabled: true,
+			main: ".storybook/main.ts", story: "src/App.stories.tsx",
+			wantMain: []string{"import type { StorybookConfig } from '@storybook/react-vite';", "name: '@storybook/react-vite'"},
+		},
+		{
+			name: "react javascript", framework: "react", typeScript: false, enabled: true,
+			main: ".storybook/main.js", story: "src/App.stories.jsx",
+			wantMain: []string{"stories: ['../src/**/*.stories.@(js|jsx)']"},
+		},
+		{
+			name: "vue", framework: "vue", typeScript: true, enabled: true,
+			main: ".storybook/main.ts", story: "src/App.stories.tsx",
+			wantMain: []string{"name: '@storybook/vue3-vite'"},
+		},
+		{
+			name: "disabled", framework: "react", typeScript: true, enabled: false,
+			main: ".storybook/main.ts", story: "src/App.stories.tsx",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, "saas")
+			cfg.Frontend.Directory = "frontend"
+			cfg.Frontend.Framework = tt.framework
+			cfg.Frontend.TypeScript = tt.typeScript
+			cfg.Frontend.Features.Storybook = tt.enabled
+
+			dir := generateProject(t, cfg)
+			pkg := readProjectFile(t, dir, "frontend/package.json")
+
+			if !tt.enabled {
+				for _, file := range []string{".storybook", tt.story} {
+					if projectFileExists(dir, "frontend/"+file) {
+						t.Errorf("%s generated with Storybook disabled", file)
+					}
+				}
+				if strings.Contains(pkg, "storybook") {
+					t.Errorf("package.json mentions storybook with it disabled:\n%s", pkg)
+				}
+				return
+			}
+
+			main := readProjectFile(t, dir, "frontend/"+tt.main)
+			for _, want := range tt.wantMain {
+				if !strings.Contains(main, want) {
+					t.Errorf("%s does not contain %q:\n%s", tt.main, want, main)
+				}
+			}
+			if !projectFileExists(dir, "frontend/"+tt.story) {
+				t.Errorf("sample story %s not generated", tt.story)
+			}
+			for _, want := range []string{`"storybook": "storybook dev -p 6006"`, `"build-storybook": "storybook build"`, `"@storybook/addon-essentials"`} {
+				if !strings.Contains(pkg, want) {
+					t.Errorf("package.json does not contain %s:\n%s", want, pkg)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Supported task runners.
+const (
+	TaskRunnerMake = "make"
+	TaskRunnerJust = "just"
+)
+
+// WithTaskRunner selects the task runner file written to the project root:
+// "make" (the default) writes a Makefile and "just" writes a justfile.
+func WithTaskRunner(runner string) GeneratorOption {
+	return func(g *Generator) {
+		g.TaskRunner = runner
+	}
+}
+
+// taskTarget is a named task shared by every task runner.
+type taskTarget struct {
+	name        string
+	description string
+
+	// commands run in order from the project root
+	commands []string
+
+	// parallel names targets that run concurrently instead of commands
+	parallel []string
+}
+
+// createTaskRunner writes the Makefile or justfile for the project.
+func (g *Generator) createTaskRunner(projectPath string) error {
+	targets := g.taskTargets()
+	if len(targets) == 0 {
+		return nil
+	}
+
+	switch strings.ToLower(g.TaskRunner) {
+	case "", TaskRunnerMake:
+		return g.writeFile(filepath.Join(projectPath, "Makefile"), generateMakefile(targets))
+	case TaskRunnerJust:
+		return g.writeFile(filepath.Join(projectPath, "justfile"), generateJustfile(targets))
+	default:
+		return fmt.Errorf("unsupported task runner: %s (supported: make, just)", g.TaskRunner)
+	}
+}
+
+// taskTargets returns the dev, build, test, lint and docker targets for the
+// enabled parts of the stack.
+func (g *Generator) taskTargets() []taskTarget {
+	var frontend, backend map[string]string
+	if g.Config.Frontend.Enabled {
+		frontend = g.frontendTaskCommands()
+	}
+	if g.Config.Backend.Enabled {
+		backend = g.backendTaskCommands()
+	}
+
+	var targets []taskTarget
+	for _, task := range []struct{ name, description string }{
+		{"dev", "Start the development servers"},
+		{"build", "Build the project"},
+		{"test", "Run the test suites"},
+		{"lint", "Lint the code"},
+	} {
+		var commands []string
+		if cmd := frontend[task.name]; cmd != "" {
+			commands = append(commands, cmd)
+		}
+		if cmd := backend[task.name]; cmd != "" {
+			commands = append(commands, cmd)
+		}
+		if len(commands) == 0 {
+			continue
+		}
+
+		// Both dev servers block, so they run side by side
+		if task.name == "dev" && len(commands) == 2 {
+			targets = append(targets,
+				taskTarget{name: "dev-frontend", description: "Start the frontend development server", commands: commands[:1]},
+				taskTarget{name: "dev-backend", description: "Start the backend development server", commands: commands[1:]},
+				taskTarget{name: "dev", description: task.description, parallel: []string{"dev-frontend", "dev-backend"}},
+			)
+			continue
+		}
+
+		targets = append(targets, taskTarget{name: task.name, description: task.description, commands: commands})
+	}
+
+	switch {
+	case g.Config.Infrastructure.DockerCompose:
+		targets = append(targets, taskTarget{name: "docker", description: "Start the stack with Docker Compose", commands: []string{"docker compose up --build"}})
+	case g.Config.Infrastructure.Docker:
+		targets = append(targets, taskTarget{name: "docker", description: "Build the Docker image", commands: []string{fmt.Sprintf("docker build -t %s .", g.Config.Metadata.Name)}})
+	}
+
+	return targets
+}
+
+// frontendTaskCommands returns the frontend command for each task.
+func (g *Generator) frontendTaskCommands() map[string]string {
+	pm := g.Config.Frontend.PackageManager
+	if pm == "" {
+		pm = "npm"
+	}
+	in := func(cmd string) string {
+		return fmt.Sprintf("cd %s && %s", g.Config.Frontend.Directory, cmd)
+	}
+
+	commands := map[string]string{
+		"dev":   in(packageManagerRun(pm, "dev")),
+		"build": in(packageManagerRun(pm, "build")),
+	}
+	switch g.Config.Frontend.TestFramework {
+	case "vitest":
+		commands["test"] = in(packageManagerExec(pm, "vitest run"))
+	case "jest":
+		commands["test"] = in(packageManagerExec(pm, "jest"))
+	}
+	if g.Config.Frontend.Linter != "" {
+		commands["lint"] = in(packageManagerExec(pm, g.Config.Frontend.Linter+" ."))
+	}
+	return commands
+}
+
+// backendTaskCommands returns the backend command for each task.
+func (g *Generator) backendTaskCommands() map[string]string {
+	in := func(cmd string) string {
+		return fmt.Sprintf("cd %s && %s", g.Config.Backend.Directory, cmd)
+	}
+
+	switch g.Config.Backend.Language {
+	case "python":
+		return map[string]string{
+			"dev":  in("python main.py"),
+			"test": in("pytest"),
+			"lint": in("ruff check ."),
+		}
+	case "node", "typescript":
+		return map[string]string{
+			"dev":  in(packageManagerRun("npm", "dev")),
+			"test": in("npm test"),
+		}
+	case "go":
+		return map[string]string{
+			"dev":   in("go run ."),
+			"build": in("go build ./..."),
+			"test":  in("go test ./..."),
+			"lint":  in("go vet ./..."),
+		}
+	}
+	return nil
+}
+
+// packageManagerRun returns the command that runs a package.json script.
+func packageManagerRun(pm, script string) string {
+	switch pm {
+	case "yarn", "pnpm":
+		return pm + " " + script
+	default:
+		return pm + " run " + script
+	}
+}
+
+// packageManagerExec returns the command that runs a locally installed binary.
+func packageManagerExec(pm, command string) string {
+	switch pm {
+	case "yarn":
+		return "yarn " + command
+	case "pnpm":
+		return "pnpm exec " + command
+	case "bun":
+		return "bunx " + command
+	default:
+		return "npx " + command
+	}
+}
+
+// generateMakefile renders the targets as a Makefile.
+func generateMakefile(targets []taskTarget) string {
+	var b strings.Builder
+
+	names := make([]string, len(targets))
+	for i, t := range targets {
+		names[i] = t.name
+	}
+	b.WriteString(fmt.Sprintf(".PHONY: %s\n", strings.Join(names, " ")))
+
+	for _, t := range targets {
+		b.WriteString(fmt.Sprintf("\n# %s\n%s:\n", t.description, t.name))
+		if len(t.parallel) > 0 {
+			b.WriteString(fmt.Sprintf("\t$(MAKE) -j %d %s\n", len(t.parallel), strings.Join(t.parallel, " ")))
+			continue
+		}
+		for _, cmd := range t.commands {
+			b.WriteString("\t" + cmd + "\n")
+		}
+	}
+
+	return b.String()
+}
+
+// generateJustfile renders the targets as a justfile.
+func generateJustfile(targets []taskTarget) string {
+	var b strings.Builder
+
+	b.WriteString("# List the available recipes\ndefault:\n    @just --list\n")
+
+	for _, t := range targets {
+		b.WriteString(fmt.Sprintf("\n# %s\n%s:\n", t.description, t.name))
+		if len(t.parallel) > 0 {
+			recipes := make([]string, len(t.parallel))
+			for i, name := range t.parallel {
+				recipes[i] = "just " + name + " &"
+			}
+			b.WriteString(fmt.Sprintf("    %s wait\n", strings.Join(recipes, " ")))
+			continue
+		}
+		for _, cmd := range t.commands {
+			b.WriteString("    " + cmd + "\n")
+		}
+	}
+
+	return b.String()
+}
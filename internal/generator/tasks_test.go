@@ -0,0 +1,61 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTaskRunner(t *testing.T) {
+	tests := []struct {
+		name           string
+		runner         string
+		packageManager string
+		file           string
+		absent         string
+		wants          []string
+	}{
+		{
+			name: "default makefile", runner: "", packageManager: "npm",
+			file: "Makefile", absent: "justfile",
+			wants: []string{".PHONY:", "\ndev-frontend:\n\tcd frontend && npm run dev\n", "\ndev:\n\t$(MAKE) -j 2 dev-frontend dev-backend\n"},
+		},
+		{
+			name: "just with pnpm", runner: TaskRunnerJust, packageManager: "pnpm",
+			file: "justfile", absent: "Makefile",
+			wants: []string{"default:\n    @just --list\n", "\ndev-frontend:\n    cd frontend && pnpm dev\n", "\ndev:\n    just dev-frontend & just dev-backend & wait\n"},
+		},
+		{
+			name: "just with yarn", runner: "Just", packageManager: "yarn",
+			file: "justfile", absent: "Makefile",
+			wants: []string{"\ndev-frontend:\n    cd frontend && yarn dev\n", "\nbuild:\n    cd frontend && yarn build\n"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, "saas")
+			cfg.Frontend.Directory = "frontend"
+			cfg.Frontend.PackageManager = tt.packageManager
+
+			dir := generateProject(t, cfg, WithTaskRunner(tt.runner))
+			content := readProjectFile(t, dir, tt.file)
+			for _, want := range tt.wants {
+				if !strings.Contains(content, want) {
+					t.Errorf("%s does not contain %q:\n%s", tt.file, want, content)
+				}
+			}
+			if projectFileExists(dir, tt.absent) {
+				t.Errorf("%s was written alongside %s", tt.absent, tt.file)
+			}
+		})
+	}
+}
+
+func TestTaskRunnerUnsupported(t *testing.T) {
+	cfg := testConfig(t, "saas")
+	err := NewGenerator(cfg, WithTaskRunner("rake"), WithSkipSections(SectionGit)).
+		createTaskRunner(t.TempDir())
+	if err == nil || !strings.Contains(err.Error(), "unsupported task runner: rake") {
+		t.Errorf("createTaskRunner error = %v, want unsupported task runner", err)
+	}
+}
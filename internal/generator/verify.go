@@ -0,0 +1,97 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// verifyOutputs parses every YAML and JSON file among paths and reports the
+// files with syntax errors. Generated files are assembled from strings and
+// templates, so a bad interpolation is caught here instead of by the tool
+// that later reads the file. Failures name the files by their path in the
+// project, not in the staging directory.
+func (g *Generator) verifyOutputs(paths []string) error {
+	var failures []string
+	for _, path := range paths {
+		if !verifiable(path) {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err == nil {
+			err = verifyContent(path, data)
+		}
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", g.targetPath(path), err))
+		}
+	}
+	return verifyFailures(failures)
+}
+
+// verifyPreviews runs the same checks as verifyOutputs against the content
+// a dry run would have written, keyed by path.
+func (g *Generator) verifyPreviews(files map[string]string) error {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var failures []string
+	for _, path := range paths {
+		if !verifiable(path) {
+			continue
+		}
+		if err := verifyContent(path, []byte(files[path])); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", g.targetPath(path), err))
+		}
+	}
+	return verifyFailures(failures)
+}
+
+// verifyFailures joins the failures of a verification into one error.
+func verifyFailures(failures []string) error {
+	if len(failures) > 0 {
+		return fmt.Errorf("generated files are invalid:\n  %s", strings.Join(failures, "\n  "))
+	}
+	return nil
+}
+
+// verifiable reports whether the file at path is checked: YAML and JSON
+// files are, files of other types are not.
+func verifiable(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
+
+// verifyContent parses the content of a single file according to its
+// extension.
+func verifyContent(path string, data []byte) error {
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		var v interface{}
+		return json.Unmarshal(data, &v)
+	}
+
+	// YAML files may hold several documents, as Kubernetes manifests do
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var v interface{}
+		if err := decoder.Decode(&v); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package generator
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/clause-cli/clause/pkg/output"
+)
+
+func TestVerifyOutputsReportsProjectPaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		wantErr bool
+	}{
+		{"valid json", "package.json", `{"name": "demo"}`, false},
+		{"invalid json", "package.json", `{"name": }`, true},
+		{"valid yaml", "ci.yml", "on: push\n---\nkind: Service\n", false},
+		{"invalid yaml", "ci.yml", "on: [push\n", true},
+		{"other files skipped", "main.py", "{not json", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			staging := t.TempDir()
+			target := filepath.Join(t.TempDir(), "demo")
+			path := filepath.Join(staging, "config", tt.file)
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			g := &Generator{staging: staging, target: target}
+			err := g.verifyOutputs([]string{path})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				return
+			}
+			if want := filepath.Join(target, "config", tt.file); !strings.Contains(err.Error(), want) {
+				t.Errorf("error %q does not name %s", err, want)
+			}
+			if strings.Contains(err.Error(), staging) {
+				t.Errorf("error %q names the staging directory", err)
+			}
+		})
+	}
+}
+
+func TestVerifyOutputsCatchesCorruptTemplates(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		wantErr  []string
+		wantPass []string
+	}{
+		{
+			name: "broken compose file",
+			files: map[string]string{
+				"docker-compose.yml":    "services:\n  api:\n    image: {{ .Image\n    ports: [\"8000:8000\"\n",
+				"frontend/package.json": `{"name": "demo"}`,
+			},
+			wantErr:  []string{"docker-compose.yml"},
+			wantPass: []string{"package.json"},
+		},
+		{
+			name: "unescaped quote in json",
+			files: map[string]string{
+				"frontend/package.json":      `{"name": "demo", "description": "a "quoted" app"}`,
+				".github/workflows/main.yml": "on: push\n",
+			},
+			wantErr:  []string{"frontend/package.json"},
+			wantPass: []string{"main.yml"},
+		},
+		{
+			name: "several broken files",
+			files: map[string]string{
+				"a.json": "{",
+				"b.yaml": "key: [",
+			},
+			wantErr: []string{"a.json", "b.yaml"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			staging := t.TempDir()
+			target := filepath.Join(t.TempDir(), "demo")
+			g := NewGenerator(testConfig(t, "saas"))
+			g.staging, g.target = staging, target
+
+			for name, content := range tt.files {
+				if err := g.writeFile(filepath.Join(staging, filepath.FromSlash(name)), content); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := g.verifyOutputs(g.written)
+			if err == nil {
+				t.Fatal("verification passed for corrupt files")
+			}
+			for _, name := range tt.wantErr {
+				if want := filepath.Join(target, filepath.FromSlash(name)); !strings.Contains(err.Error(), want) {
+					t.Errorf("error does not name %s:\n%v", want, err)
+				}
+			}
+			for _, name := range tt.wantPass {
+				if strings.Contains(err.Error(), name) {
+					t.Errorf("error names valid file %s:\n%v", name, err)
+				}
+			}
+		})
+	}
+}
+
+func TestVerifyDryRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		wantErr     bool
+	}{
+		{"valid project", "A demo app", false},
+		{"unescaped quote in package.json", `a "quoted" app`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, "saas")
+			cfg.Metadata.Description = tt.description
+			dir := filepath.Join(t.TempDir(), "demo")
+
+			g := NewGenerator(cfg,
+				WithDryRun(true),
+				WithSkipSections(SectionGit),
+				WithLogger(output.NewLogger(output.WithWriter(io.Discard))),
+			)
+			err := g.Generate(dir)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), "package.json") {
+				t.Errorf("error does not name package.json:\n%v", err)
+			}
+			if _, err := os.Stat(dir); !os.IsNotExist(err) {
+				t.Errorf("dry run created %s", dir)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package governance
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/clause-cli/clause/internal/config/enum"
+	"github.com/clause-cli/clause/pkg/utils"
+)
+
+// assistantFiles maps each AI assistant to the file it reads project
+// instructions from, relative to the project root.
+var assistantFiles = map[enum.AssistantTarget]string{
+	enum.AssistantCursor:  ".cursorrules",
+	enum.AssistantCopilot: filepath.Join(".github", "copilot-instructions.md"),
+	enum.AssistantClaude:  "CLAUDE.md",
+}
+
+// assistantMarker is the line that marks an instructions file as written by
+// Clause.
+const assistantMarker = "These instructions are generated by Clause from the project configuration."
+
+// generateAssistantFiles writes the instructions file of each assistant in
+// governance.assistant_targets. An existing file without assistantMarker
+// was written by hand and is skipped, unless Force is set, in which case it
+// is backed up before being replaced.
+func (g *Generator) generateAssistantFiles() error {
+	for _, target := range g.Config.Governance.AssistantTargets {
+		name, ok := assistantFiles[enum.AssistantTarget(target)]
+		if !ok {
+			return fmt.Errorf("unknown assistant target: %s (supported: %s)", target, enum.AssistantTargets)
+		}
+
+		path := filepath.Join(g.ProjectPath, name)
+		if existing, err := os.ReadFile(path); err == nil && !strings.Contains(string(existing), assistantMarker) {
+			if !g.Force {
+				g.Logger.Warn("Skipping %s: it was not generated by Clause (use --force to replace it)", name)
+				continue
+			}
+			if _, err := utils.BackupFile(path); err != nil {
+				return fmt.Errorf("failed to back up %s: %w", name, err)
+			}
+		}
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("failed to create directory for %s: %w", name, err)
+		}
+		if err := os.WriteFile(path, []byte(g.assistantInstructions(enum.AssistantTarget(target))), 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// assistantInstructions builds the instructions for an assistant from the
+// same sections as prompt-guidelines.md.
+func (g *Generator) assistantInstructions(target enum.AssistantTarget) string {
+	var content strings.Builder
+
+	switch target {
+	case enum.AssistantCursor:
+		content.WriteString(fmt.Sprintf("# Cursor Rules for %s\n\n", g.Config.Metadata.Name))
+	case enum.AssistantCopilot:
+		content.WriteString(fmt.Sprintf("# Copilot Instructions for %s\n\n", g.Config.Metadata.Name))
+	default:
+		content.WriteString(fmt.Sprintf("# %s\n\n", g.Config.Metadata.Name))
+	}
+
+	content.WriteString(assistantMarker + "\n")
+	content.WriteString("Regenerate them after changing .clause/config.yaml instead of editing them by hand.\n\n")
+
+	g.writeProjectContext(&content)
+	g.writeTechStack(&content)
+	g.writeCodeStyle(&content)
+	g.writeComponentGuidelines(&content)
+
+	content.WriteString("## More Context\n\n")
+	content.WriteString("- `.clause/context.yaml` describes the project and its conventions\n")
+	if g.Config.Governance.PromptGuidelines {
+		content.WriteString("- `.clause/prompt-guidelines.md` has the full guidelines for AI assistants\n")
+	}
+	if g.Config.Governance.ComponentRegistry {
+		content.WriteString("- `.clause/registry.yaml` lists the components in the project\n")
+	}
+
+	return content.String()
+}
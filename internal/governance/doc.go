@@ -12,4 +12,39 @@
 //	if err := gov.Initialize(); err != nil {
 //	    log.Fatal(err)
 //	}
+//
+// Regenerating merges .clause/context.yaml with the existing file: the tech
+// stack and architecture follow the configuration, while components,
+// conventions and other entries added by hand are kept. Set Generator.Force,
+// or use WithForce, to overwrite the file instead.
+//
+// Assistant instruction files such as CLAUDE.md are only replaced when
+// Clause wrote them; a hand-written file is left alone unless Force is set,
+// in which case a .bak copy is kept.
+//
+// IsContextStale reports where .clause/context.yaml has drifted from the
+// project configuration, for example after the framework was changed
+// without regenerating:
+//
+//	stale, reasons, err := governance.NewGenerator(projectPath, cfg).IsContextStale()
+//
+// PromptGuidelines returns the .clause/prompt-guidelines.md content without
+// writing it, which the wizard shows as a preview.
+//
+// ScanComponents finds React, Vue and Svelte components and backend services
+// in the source tree. UpdateRegistry writes them to .clause/registry.yaml,
+// keeping descriptions and tags already there, and RegistryDrift lists the
+// differences between the registry and the source tree.
+//
+// Generate also writes .cursorrules, .github/copilot-instructions.md and
+// CLAUDE.md for the assistants listed in governance.assistant_targets.
+//
+// Validator runs the governance rules, such as requiring a README or
+// registered component paths that exist, with the severities, exclusions
+// and strict mode set under governance.rules:
+//
+//	violations := governance.NewValidator(cfg).Validate(projectPath)
+//	if governance.HasErrors(violations) {
+//	    os.Exit(1)
+//	}
 package governance
@@ -0,0 +1,56 @@
+package governance
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// IsContextStale compares .clause/context.yaml with the context the current
+// configuration would generate. It reports whether the file is out of date
+// and describes each mismatch, such as a frontend framework that changed
+// since the file was written. Tech stack entries added by hand are ignored.
+func (g *Generator) IsContextStale() (bool, []string, error) {
+	contextFile := filepath.Join(g.ProjectPath, ".clause", "context.yaml")
+	data, err := os.ReadFile(contextFile)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to read context: %w", err)
+	}
+
+	var current AIContext
+	if err := yaml.Unmarshal(data, &current); err != nil {
+		return false, nil, fmt.Errorf("failed to parse context: %w", err)
+	}
+
+	var expected AIContext
+	if err := yaml.Unmarshal([]byte(g.contextContent()), &expected); err != nil {
+		return false, nil, fmt.Errorf("failed to build context: %w", err)
+	}
+
+	var reasons []string
+
+	fields := []struct {
+		name              string
+		current, expected string
+	}{
+		{"architecture.style", current.Architecture.Style, expected.Architecture.Style},
+		{"architecture.frontend", current.Architecture.Frontend, expected.Architecture.Frontend},
+		{"architecture.backend", current.Architecture.Backend, expected.Architecture.Backend},
+		{"architecture.database", current.Architecture.Database, expected.Architecture.Database},
+	}
+	for _, f := range fields {
+		if f.current != f.expected {
+			reasons = append(reasons, fmt.Sprintf("%s is %q, config has %q", f.name, f.current, f.expected))
+		}
+	}
+
+	for _, tech := range expected.TechStack {
+		if !contains(current.TechStack, tech) {
+			reasons = append(reasons, fmt.Sprintf("tech_stack is missing %q", tech))
+		}
+	}
+
+	return len(reasons) > 0, reasons, nil
+}
@@ -0,0 +1,119 @@
+package governance
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/clause-cli/clause/internal/config"
+)
+
+func TestIsContextStale(t *testing.T) {
+	tests := []struct {
+		name string
+		// change edits the config after context.yaml was written
+		change func(cfg *config.ProjectConfig)
+		// edit rewrites context.yaml after it was written
+		edit        func(content string) string
+		wantStale   bool
+		wantReasons []string
+	}{
+		{
+			name: "unchanged",
+		},
+		{
+			name:      "frontend framework changed",
+			change:    func(cfg *config.ProjectConfig) { cfg.Frontend.Framework = "vue" },
+			wantStale: true,
+			wantReasons: []string{
+				`architecture.frontend is "react", config has "vue"`,
+				`tech_stack is missing "vue (frontend)"`,
+			},
+		},
+		{
+			name:      "database changed",
+			change:    func(cfg *config.ProjectConfig) { cfg.Backend.Database.Primary = "mysql" },
+			wantStale: true,
+			wantReasons: []string{
+				`architecture.database is "postgresql", config has "mysql"`,
+				`tech_stack is missing "mysql (database)"`,
+			},
+		},
+		{
+			name:      "backend disabled",
+			change:    func(cfg *config.ProjectConfig) { cfg.Backend.Enabled = false },
+			wantStale: true,
+			wantReasons: []string{
+				`architecture.style is "full-stack", config has "frontend"`,
+				`architecture.backend is "fastapi (python)", config has ""`,
+			},
+		},
+		{
+			name: "hand-added tech stack entry",
+			edit: func(content string) string {
+				return strings.Replace(content, "tech_stack:\n", "tech_stack:\n  - Redis (cache)\n", 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadPreset("saas")
+			if err != nil {
+				t.Fatal(err)
+			}
+			cfg.Frontend.Framework = "react"
+			cfg.Backend.Framework = "fastapi"
+			cfg.Backend.Language = "python"
+			cfg.Backend.Database.Primary = "postgresql"
+
+			dir := t.TempDir()
+			g := NewGenerator(dir, cfg)
+			clauseDir := filepath.Join(dir, ".clause")
+			if err := os.MkdirAll(clauseDir, 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := g.generateContextFile(clauseDir); err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.edit != nil {
+				path := filepath.Join(clauseDir, "context.yaml")
+				data, err := os.ReadFile(path)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(tt.edit(string(data))), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.change != nil {
+				tt.change(cfg)
+			}
+
+			stale, reasons, err := g.IsContextStale()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stale != tt.wantStale {
+				t.Errorf("stale = %v, want %v", stale, tt.wantStale)
+			}
+			if !reflect.DeepEqual(reasons, tt.wantReasons) {
+				t.Errorf("reasons = %q, want %q", reasons, tt.wantReasons)
+			}
+		})
+	}
+}
+
+func TestIsContextStaleMissingContext(t *testing.T) {
+	cfg, err := config.LoadPreset("minimal")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := NewGenerator(t.TempDir(), cfg).IsContextStale(); err == nil {
+		t.Error("expected an error without context.yaml")
+	}
+}
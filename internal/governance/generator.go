@@ -8,6 +8,7 @@ import (
 
 	"github.com/clause-cli/clause/internal/config"
 	"github.com/clause-cli/clause/pkg/output"
+	"github.com/clause-cli/clause/pkg/utils"
 )
 
 // Generator generates governance files for a project.
@@ -20,6 +21,15 @@ type Generator struct {
 
 	// Logger for output
 	Logger *output.Logger
+
+	// Force overwrites context.yaml instead of merging it with the
+	// existing file, and replaces assistant instruction files that Clause
+	// did not write, keeping a backup
+	Force bool
+
+	// freshContext marks a context.yaml that was just created and has no
+	// entries to keep
+	freshContext bool
 }
 
 // NewGenerator creates a new governance generator.
@@ -58,6 +68,11 @@ func (g *Generator) Generate() error {
 		}
 	}
 
+	// Generate instructions for the configured AI assistants
+	if err := g.generateAssistantFiles(); err != nil {
+		return err
+	}
+
 	// Generate Brainstorm.md in project root if enabled
 	if g.Config.Governance.BrainstormMd {
 		if err := g.generateBrainstormMd(); err != nil {
@@ -68,11 +83,26 @@ func (g *Generator) Generate() error {
 	return nil
 }
 
-// generateContextFile generates the context.yaml file.
+// generateContextFile generates the context.yaml file. An existing file is
+// merged with the regenerated content, keeping entries added by hand,
+// unless Force is set or the file was just created.
 func (g *Generator) generateContextFile(clauseDir string) error {
 	contextFile := filepath.Join(clauseDir, "context.yaml")
+	content := []byte(g.contextContent())
+
+	if existing, err := os.ReadFile(contextFile); err == nil && !g.Force && !g.freshContext {
+		merged, err := mergeContext(existing, content)
+		if err != nil {
+			return fmt.Errorf("failed to update %s (use --force to overwrite it): %w", contextFile, err)
+		}
+		content = merged
+	}
+
+	return os.WriteFile(contextFile, content, 0644)
+}
 
-	// Build context content
+// contextContent builds the context.yaml content from the configuration.
+func (g *Generator) contextContent() string {
 	var content strings.Builder
 
 	content.WriteString("# AI Context\n")
@@ -116,7 +146,7 @@ func (g *Generator) generateContextFile(clauseDir string) error {
 			lang = "unknown"
 		}
 		content.WriteString(fmt.Sprintf("  - %s (backend)\n", g.Config.Backend.Framework))
-		content.WriteString(fmt.Sprintf("  - %s\n", strings.Title(lang)))
+		content.WriteString(fmt.Sprintf("  - %s (language)\n", lang))
 
 		if g.Config.Backend.Database.Primary != "" {
 			content.WriteString(fmt.Sprintf("  - %s (database)\n", g.Config.Backend.Database.Primary))
@@ -153,6 +183,9 @@ func (g *Generator) generateContextFile(clauseDir string) error {
 	}
 	content.WriteString("\"\n")
 
+	if stack := g.Config.StackString(); stack != "" {
+		content.WriteString(fmt.Sprintf("  stack: \"%s\"\n", stack))
+	}
 	if g.Config.Frontend.Enabled {
 		content.WriteString(fmt.Sprintf("  frontend: \"%s\"\n", g.Config.Frontend.Framework))
 	}
@@ -196,129 +229,143 @@ func (g *Generator) generateContextFile(clauseDir string) error {
 	// Conventions placeholder
 	content.WriteString("\nconventions: []\n")
 
-	return os.WriteFile(contextFile, []byte(content.String()), 0644)
+	return content.String()
 }
 
 // generatePromptGuidelines generates the prompt-guidelines.md file.
 func (g *Generator) generatePromptGuidelines(clauseDir string) error {
 	guidelinesFile := filepath.Join(clauseDir, "prompt-guidelines.md")
+	return os.WriteFile(guidelinesFile, []byte(g.PromptGuidelines()), 0644)
+}
 
+// PromptGuidelines returns the content of .clause/prompt-guidelines.md for
+// the configuration.
+func (g *Generator) PromptGuidelines() string {
 	var content strings.Builder
 
 	content.WriteString("# AI Prompt Guidelines\n\n")
 	content.WriteString("This document provides guidelines for working with AI assistants on this project.\n\n")
 
-	// Project Context
-	content.WriteString("## Project Context\n\n")
+	g.writeProjectContext(&content)
+	g.writeTechStack(&content)
+	g.writeCodeStyle(&content)
+
+	// When asking for help
+	content.WriteString("## When asking for help\n\n")
+	content.WriteString("1. Provide context about what you're trying to achieve\n")
+	content.WriteString("2. Share relevant code snippets\n")
+	content.WriteString("3. Describe any errors you're seeing\n")
+	content.WriteString("4. Explain what you've already tried\n")
+	content.WriteString("\n")
+
+	g.writeComponentGuidelines(&content)
+
+	// The guidelines grow with the stack, so link the sections up front
+	return utils.GenerateTOC(content.String())
+}
+
+// writeProjectContext writes the Project Context section of the assistant
+// guidelines.
+func (g *Generator) writeProjectContext(b *strings.Builder) {
+	b.WriteString("## Project Context\n\n")
 
-	content.WriteString(fmt.Sprintf("- **Name**: %s\n", g.Config.Metadata.Name))
+	b.WriteString(fmt.Sprintf("- **Name**: %s\n", g.Config.Metadata.Name))
 	if g.Config.Metadata.Description != "" {
-		content.WriteString(fmt.Sprintf("- **Description**: %s\n", g.Config.Metadata.Description))
+		b.WriteString(fmt.Sprintf("- **Description**: %s\n", g.Config.Metadata.Description))
+	}
+	if stack := g.Config.StackString(); stack != "" {
+		b.WriteString(fmt.Sprintf("- **Stack**: %s\n", stack))
 	}
 	if g.Config.Metadata.Author != "" {
-		content.WriteString(fmt.Sprintf("- **Author**: %s\n", g.Config.Metadata.Author))
+		b.WriteString(fmt.Sprintf("- **Author**: %s\n", g.Config.Metadata.Author))
 	}
 	if g.Config.Metadata.License != "" {
-		content.WriteString(fmt.Sprintf("- **License**: %s\n", g.Config.Metadata.License))
+		b.WriteString(fmt.Sprintf("- **License**: %s\n", g.Config.Metadata.License))
 	}
 
-	content.WriteString("\n")
+	b.WriteString("\n")
+}
 
-	// Technology Stack
-	content.WriteString("## Technology Stack\n\n")
+// writeTechStack writes the Technology Stack section of the assistant
+// guidelines.
+func (g *Generator) writeTechStack(b *strings.Builder) {
+	b.WriteString("## Technology Stack\n\n")
 
 	if g.Config.Frontend.Enabled {
-		content.WriteString("### Frontend\n\n")
-		content.WriteString(fmt.Sprintf("- **Framework**: %s\n", g.Config.Frontend.Framework))
+		b.WriteString("### Frontend\n\n")
+		b.WriteString(fmt.Sprintf("- **Framework**: %s\n", g.Config.Frontend.Framework))
 		if g.Config.Frontend.TypeScript {
-			content.WriteString("- **Language**: TypeScript\n")
+			b.WriteString("- **Language**: TypeScript\n")
 		}
 		if g.Config.Frontend.Styling != "" {
-			content.WriteString(fmt.Sprintf("- **Styling**: %s\n", g.Config.Frontend.Styling))
+			b.WriteString(fmt.Sprintf("- **Styling**: %s\n", g.Config.Frontend.Styling))
 		}
 		if g.Config.Frontend.TestFramework != "" {
-			content.WriteString(fmt.Sprintf("- **Testing**: %s\n", g.Config.Frontend.TestFramework))
+			b.WriteString(fmt.Sprintf("- **Testing**: %s\n", g.Config.Frontend.TestFramework))
 		}
 		if g.Config.Frontend.PackageManager != "" {
-			content.WriteString(fmt.Sprintf("- **Package Manager**: %s\n", g.Config.Frontend.PackageManager))
+			b.WriteString(fmt.Sprintf("- **Package Manager**: %s\n", g.Config.Frontend.PackageManager))
 		}
-		content.WriteString("\n")
+		b.WriteString("\n")
 	}
 
 	if g.Config.Backend.Enabled {
-		content.WriteString("### Backend\n\n")
-		content.WriteString(fmt.Sprintf("- **Framework**: %s\n", g.Config.Backend.Framework))
+		b.WriteString("### Backend\n\n")
+		b.WriteString(fmt.Sprintf("- **Framework**: %s\n", g.Config.Backend.Framework))
 		if g.Config.Backend.Language != "" {
-			content.WriteString(fmt.Sprintf("- **Language**: %s\n", g.Config.Backend.Language))
+			b.WriteString(fmt.Sprintf("- **Language**: %s\n", g.Config.Backend.Language))
 		}
 		if g.Config.Backend.Database.Primary != "" {
-			content.WriteString(fmt.Sprintf("- **Database**: %s\n", g.Config.Backend.Database.Primary))
+			b.WriteString(fmt.Sprintf("- **Database**: %s\n", g.Config.Backend.Database.Primary))
 		}
 		if g.Config.Backend.Database.ORM != "" {
-			content.WriteString(fmt.Sprintf("- **ORM**: %s\n", g.Config.Backend.Database.ORM))
+			b.WriteString(fmt.Sprintf("- **ORM**: %s\n", g.Config.Backend.Database.ORM))
 		}
-		content.WriteString("\n")
+		b.WriteString("\n")
 	}
+}
 
-	// Code Style
-	content.WriteString("## Code Style\n\n")
-	content.WriteString("- Follow the existing code patterns in the project\n")
-	content.WriteString("- Keep functions small and focused\n")
-	content.WriteString("- Write clear, descriptive variable names\n")
-	content.WriteString("- Add comments for complex logic\n")
-	content.WriteString("- Use consistent formatting\n")
-	content.WriteString("\n")
+// writeCodeStyle writes the Code Style and Best Practices sections of the
+// assistant guidelines.
+func (g *Generator) writeCodeStyle(b *strings.Builder) {
+	b.WriteString("## Code Style\n\n")
+	b.WriteString("- Follow the existing code patterns in the project\n")
+	b.WriteString("- Keep functions small and focused\n")
+	b.WriteString("- Write clear, descriptive variable names\n")
+	b.WriteString("- Add comments for complex logic\n")
+	b.WriteString("- Use consistent formatting\n")
+	b.WriteString("\n")
 
 	// Best Practices
-	content.WriteString("## Best Practices\n\n")
-	content.WriteString("1. Always validate inputs at system boundaries\n")
-	content.WriteString("2. Handle errors gracefully with meaningful messages\n")
-	content.WriteString("3. Write tests for new features\n")
-	content.WriteString("4. Update documentation when needed\n")
-	content.WriteString("5. Follow the principle of least surprise\n")
-	content.WriteString("\n")
-
-	// When asking for help
-	content.WriteString("## When asking for help\n\n")
-	content.WriteString("1. Provide context about what you're trying to achieve\n")
-	content.WriteString("2. Share relevant code snippets\n")
-	content.WriteString("3. Describe any errors you're seeing\n")
-	content.WriteString("4. Explain what you've already tried\n")
-	content.WriteString("\n")
+	b.WriteString("## Best Practices\n\n")
+	b.WriteString("1. Always validate inputs at system boundaries\n")
+	b.WriteString("2. Handle errors gracefully with meaningful messages\n")
+	b.WriteString("3. Write tests for new features\n")
+	b.WriteString("4. Update documentation when needed\n")
+	b.WriteString("5. Follow the principle of least surprise\n")
+	b.WriteString("\n")
+}
 
-	// Component Guidelines
+// writeComponentGuidelines writes the Component Guidelines section when the
+// component registry is enabled.
+func (g *Generator) writeComponentGuidelines(b *strings.Builder) {
 	if g.Config.Governance.ComponentRegistry {
-		content.WriteString("## Component Guidelines\n\n")
-		content.WriteString("- Register new components in `.clause/registry.yaml`\n")
-		content.WriteString("- Document component dependencies\n")
-		content.WriteString("- Keep component interfaces stable\n")
-		content.WriteString("\n")
+		b.WriteString("## Component Guidelines\n\n")
+		b.WriteString("- Register new components in `.clause/registry.yaml`\n")
+		b.WriteString("- Document component dependencies\n")
+		b.WriteString("- Keep component interfaces stable\n")
+		b.WriteString("\n")
 	}
-
-	return os.WriteFile(guidelinesFile, []byte(content.String()), 0644)
 }
 
-// generateComponentRegistry generates the component registry file.
+// generateComponentRegistry generates the component registry file from the
+// components found in the source tree.
 func (g *Generator) generateComponentRegistry(clauseDir string) error {
-	registryFile := filepath.Join(clauseDir, "registry.yaml")
-
-	var content strings.Builder
-
-	content.WriteString("# Component Registry\n")
-	content.WriteString("# This file tracks all components in the project.\n\n")
-
-	content.WriteString("components: []\n")
-	content.WriteString("\n")
-	content.WriteString("# Example component:\n")
-	content.WriteString("# - name: \"user-auth\"\n")
-	content.WriteString("#   type: \"service\"\n")
-	content.WriteString("#   path: \"backend/services/auth\"\n")
-	content.WriteString("#   description: \"Authentication service\"\n")
-	content.WriteString("#   dependencies: []\n")
-	content.WriteString("#   tags: [\"auth\", \"security\"]\n")
-	content.WriteString("#   tech_stack: [\"go\", \"jwt\"]\n")
-
-	return os.WriteFile(registryFile, []byte(content.String()), 0644)
+	components, err := g.ScanComponents()
+	if err != nil {
+		return fmt.Errorf("failed to scan components: %w", err)
+	}
+	return writeRegistryFile(filepath.Join(clauseDir, "registry.yaml"), components)
 }
 
 // generateBrainstormMd generates the Brainstorm.md file in project root.
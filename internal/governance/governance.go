@@ -27,6 +27,10 @@ type Governance struct {
 	// Logger for output
 	Logger *output.Logger
 
+	// Force overwrites context.yaml instead of merging it, and replaces
+	// assistant instruction files that Clause did not write
+	Force bool
+
 	// mu protects concurrent access
 	mu sync.RWMutex
 }
@@ -61,6 +65,15 @@ func WithConfig(cfg *config.ProjectConfig) GovernanceOption {
 	}
 }
 
+// WithForce overwrites context.yaml on Initialize instead of merging it
+// with the existing file, and replaces assistant instruction files that
+// Clause did not write, keeping a backup.
+func WithForce(force bool) GovernanceOption {
+	return func(g *Governance) {
+		g.Force = force
+	}
+}
+
 // WithLogger sets the logger.
 func WithLogger(logger *output.Logger) GovernanceOption {
 	return func(g *Governance) {
@@ -79,6 +92,10 @@ func (g *Governance) Initialize() error {
 		return fmt.Errorf("failed to create .clause directory: %w", err)
 	}
 
+	// A context file created here has nothing to keep when it is generated
+	_, statErr := os.Stat(g.ContextManager.contextFile)
+	freshContext := os.IsNotExist(statErr)
+
 	// Initialize context
 	if err := g.ContextManager.Initialize(); err != nil {
 		return fmt.Errorf("failed to initialize context: %w", err)
@@ -87,6 +104,8 @@ func (g *Governance) Initialize() error {
 	// Create governance files if enabled
 	if g.Config != nil && g.Config.Governance.Enabled {
 		gen := NewGenerator(g.ProjectPath, g.Config)
+		gen.Force = g.Force
+		gen.freshContext = freshContext
 		if err := gen.Generate(); err != nil {
 			return fmt.Errorf("failed to generate governance files: %w", err)
 		}
@@ -0,0 +1,213 @@
+package governance
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// generatedTechRoles are the suffixes of the tech_stack entries written from
+// the configuration, such as "react (frontend)".
+var generatedTechRoles = []string{
+	"(frontend)", "(backend)", "(language)", "(styling)", "(testing)",
+	"(database)", "(ORM)", "(CI/CD)",
+}
+
+// generatedTechNames are the tech_stack entries written from the
+// configuration without a role. The backend languages are listed as older
+// versions wrote them, before they had the (language) role.
+var generatedTechNames = []string{
+	"TypeScript", "Docker", "Docker Compose", "Kubernetes",
+	"Python", "Node", "Go", "Rust", "Ruby", "Java", "Elixir", "Unknown",
+}
+
+// mergeContext merges a regenerated context.yaml into the existing one.
+// Values from the configuration replace the existing ones, and keys the
+// configuration does not set are kept, so hand-written sections survive.
+// Lists keep the entries added by hand after the generated entries, except
+// that tech_stack drops entries that were generated from an earlier
+// configuration. Comments on existing keys are kept.
+func mergeContext(existing, generated []byte) ([]byte, error) {
+	var current, fresh yaml.Node
+	if err := yaml.Unmarshal(existing, &current); err != nil {
+		return nil, fmt.Errorf("failed to parse context: %w", err)
+	}
+	if err := yaml.Unmarshal(generated, &fresh); err != nil {
+		return nil, fmt.Errorf("failed to build context: %w", err)
+	}
+
+	if len(current.Content) == 0 || current.Content[0].Kind != yaml.MappingNode {
+		return generated, nil
+	}
+
+	merged := mergeMapping(current.Content[0], fresh.Content[0], "")
+	doc := &yaml.Node{
+		Kind:        yaml.DocumentNode,
+		HeadComment: fresh.HeadComment,
+		Content:     []*yaml.Node{merged},
+	}
+	if doc.HeadComment == "" {
+		doc.HeadComment = current.HeadComment
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return nil, fmt.Errorf("failed to encode context: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode context: %w", err)
+	}
+	return separateSections(buf.Bytes()), nil
+}
+
+// separateSections puts a blank line before each top-level key after the
+// first, and before the comments above it, as in the generated file.
+func separateSections(data []byte) []byte {
+	var out []string
+	keys, comments := 0, 0
+	for _, line := range strings.Split(string(data), "\n") {
+		switch {
+		case strings.HasPrefix(line, "#"):
+			comments++
+		case line != "" && !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "-"):
+			at := len(out) - comments
+			if keys > 0 && at > 0 && out[at-1] != "" {
+				out = append(out[:at], append([]string{""}, out[at:]...)...)
+			}
+			keys++
+			comments = 0
+		default:
+			comments = 0
+		}
+		out = append(out, line)
+	}
+	return []byte(strings.Join(out, "\n"))
+}
+
+// mergeMapping returns the generated mapping with the merged value of each
+// key, followed by the existing keys it does not have. Existing keys with
+// empty values are dropped.
+func mergeMapping(existing, generated *yaml.Node, path string) *yaml.Node {
+	merged := &yaml.Node{Kind: yaml.MappingNode, Style: generated.Style}
+	seen := make(map[string]bool)
+
+	for i := 0; i+1 < len(generated.Content); i += 2 {
+		key, value := generated.Content[i], generated.Content[i+1]
+		seen[key.Value] = true
+
+		if oldKey, oldValue := mappingValue(existing, key.Value); oldValue != nil {
+			key = oldKey
+			value = mergeValue(oldValue, value, joinKey(path, key.Value))
+		}
+		merged.Content = append(merged.Content, key, value)
+	}
+
+	for i := 0; i+1 < len(existing.Content); i += 2 {
+		key, value := existing.Content[i], existing.Content[i+1]
+		if seen[key.Value] || isEmptyNode(value) {
+			continue
+		}
+		merged.Content = append(merged.Content, key, value)
+	}
+	return merged
+}
+
+// mergeValue merges an existing value with the generated value at path.
+func mergeValue(existing, generated *yaml.Node, path string) *yaml.Node {
+	switch {
+	case existing.Kind == yaml.MappingNode && generated.Kind == yaml.MappingNode:
+		return mergeMapping(existing, generated, path)
+	case existing.Kind == yaml.SequenceNode && generated.Kind == yaml.SequenceNode:
+		return mergeSequence(existing, generated, path)
+	default:
+		return generated
+	}
+}
+
+// mergeSequence returns the generated entries followed by the existing
+// entries that are not among them.
+func mergeSequence(existing, generated *yaml.Node, path string) *yaml.Node {
+	merged := &yaml.Node{Kind: yaml.SequenceNode, Tag: generated.Tag}
+	merged.Content = append(merged.Content, generated.Content...)
+
+	for _, item := range existing.Content {
+		if path == "tech_stack" && isGeneratedTech(item.Value) {
+			continue
+		}
+		if !containsNode(merged.Content, item) {
+			merged.Content = append(merged.Content, item)
+		}
+	}
+
+	// A flow style [] only suits an empty list
+	if len(merged.Content) > 0 {
+		merged.Style = 0
+	} else {
+		merged.Style = generated.Style
+	}
+	return merged
+}
+
+// isGeneratedTech reports whether a tech_stack entry is one that the
+// configuration writes, as opposed to one added by hand.
+func isGeneratedTech(entry string) bool {
+	for _, role := range generatedTechRoles {
+		if strings.HasSuffix(entry, " "+role) {
+			return true
+		}
+	}
+	return contains(generatedTechNames, entry)
+}
+
+// mappingValue returns the key and value nodes for key in a mapping, or nil
+// when the key is missing.
+func mappingValue(mapping *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i], mapping.Content[i+1]
+		}
+	}
+	return nil, nil
+}
+
+// containsNode reports whether nodes holds a node with the same value as
+// node.
+func containsNode(nodes []*yaml.Node, node *yaml.Node) bool {
+	var want interface{}
+	if err := node.Decode(&want); err != nil {
+		return false
+	}
+	for _, n := range nodes {
+		var got interface{}
+		if err := n.Decode(&got); err == nil && reflect.DeepEqual(got, want) {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmptyNode reports whether a node is null, an empty string or an empty
+// collection.
+func isEmptyNode(node *yaml.Node) bool {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return node.Tag == "!!null" || (node.Tag == "!!str" && node.Value == "")
+	case yaml.MappingNode, yaml.SequenceNode:
+		return len(node.Content) == 0
+	default:
+		return false
+	}
+}
+
+// joinKey joins a parent path and a key with a dot.
+func joinKey(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -2,16 +2,56 @@ package governance
 
 import (
 	"fmt"
+	"os"
 	"sort"
+	"strings"
 	"sync"
+
+	"gopkg.in/yaml.v3"
 )
 
+// ComponentTypes lists the valid component types in registry.yaml.
+var ComponentTypes = []string{"component", "service", "page", "layout", "util"}
+
+// RegistryError describes an invalid entry in registry.yaml.
+type RegistryError struct {
+	// Index is the position of the entry in the components list
+	Index int `json:"index"`
+
+	// Field is the offending field
+	Field string `json:"field"`
+
+	// Message describes the problem
+	Message string `json:"message"`
+}
+
+// Error implements the error interface.
+func (e RegistryError) Error() string {
+	return fmt.Sprintf("components[%d].%s: %s", e.Index, e.Field, e.Message)
+}
+
+// RegistryErrors is a collection of registry entry errors.
+type RegistryErrors []RegistryError
+
+// Error implements the error interface.
+func (e RegistryErrors) Error() string {
+	if len(e) == 0 {
+		return ""
+	}
+
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("invalid component registry:\n  - %s", strings.Join(msgs, "\n  - "))
+}
+
 // Component represents a project component in the registry.
 type Component struct {
 	// Name is the unique component identifier
 	Name string `yaml:"name" json:"name"`
 
-	// Type is the component type (frontend, backend, service, library, etc.)
+	// Type is the component type (component, service, page, layout, util)
 	Type string `yaml:"type" json:"type"`
 
 	// Path is the component's file path relative to project root
@@ -310,6 +350,78 @@ func (r *ComponentRegistry) ToMap() map[string]interface{} {
 	return result
 }
 
+// registryFile is the on-disk layout of registry.yaml.
+type registryFile struct {
+	Components []Component `yaml:"components"`
+}
+
+// Load reads a registry.yaml file and registers its components. Every entry
+// is checked before anything is registered; if any entry is invalid the
+// registry is left unchanged and a RegistryErrors is returned.
+func (r *ComponentRegistry) Load(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read registry: %w", err)
+	}
+
+	var file registryFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to parse registry: %w", err)
+	}
+
+	if errs := validateEntries(file.Components); len(errs) > 0 {
+		return errs
+	}
+
+	for _, comp := range file.Components {
+		if err := r.Register(comp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateEntries checks each registry entry for a name and a valid type.
+func validateEntries(components []Component) RegistryErrors {
+	var errs RegistryErrors
+	seen := make(map[string]int)
+
+	for i, comp := range components {
+		if strings.TrimSpace(comp.Name) == "" {
+			errs = append(errs, RegistryError{Index: i, Field: "name", Message: "name is required"})
+		} else if prev, exists := seen[comp.Name]; exists {
+			errs = append(errs, RegistryError{
+				Index:   i,
+				Field:   "name",
+				Message: fmt.Sprintf("duplicate component %q (first defined at index %d)", comp.Name, prev),
+			})
+		} else {
+			seen[comp.Name] = i
+		}
+
+		if !isValidComponentType(comp.Type) {
+			errs = append(errs, RegistryError{
+				Index:   i,
+				Field:   "type",
+				Message: fmt.Sprintf("invalid type %q (must be one of: %s)", comp.Type, strings.Join(ComponentTypes, ", ")),
+			})
+		}
+	}
+
+	return errs
+}
+
+// isValidComponentType checks if a component type is supported.
+func isValidComponentType(compType string) bool {
+	for _, t := range ComponentTypes {
+		if t == compType {
+			return true
+		}
+	}
+	return false
+}
+
 // LoadFromMap loads the registry from a map.
 func (r *ComponentRegistry) LoadFromMap(data map[string]interface{}) error {
 	r.mu.Lock()
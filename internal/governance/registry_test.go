@@ -0,0 +1,113 @@
+package governance
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeRegistry writes a registry.yaml with the given content.
+func writeRegistry(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "registry.yaml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestComponentRegistryLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    RegistryErrors
+	}{
+		{
+			name: "valid",
+			content: `components:
+  - name: Button
+    type: component
+  - name: api
+    type: service
+`,
+		},
+		{
+			name: "missing name",
+			content: `components:
+  - name: Button
+    type: component
+  - type: page
+`,
+			want: RegistryErrors{{Index: 1, Field: "name", Message: "name is required"}},
+		},
+		{
+			name: "invalid type",
+			content: `components:
+  - name: Button
+    type: widget
+`,
+			want: RegistryErrors{{Index: 0, Field: "type", Message: `invalid type "widget" (must be one of: component, service, page, layout, util)`}},
+		},
+		{
+			name: "duplicate name",
+			content: `components:
+  - name: Button
+    type: component
+  - name: Button
+    type: layout
+`,
+			want: RegistryErrors{{Index: 1, Field: "name", Message: `duplicate component "Button" (first defined at index 0)`}},
+		},
+		{
+			name: "several errors",
+			content: `components:
+  - name: " "
+    type: ""
+`,
+			want: RegistryErrors{
+				{Index: 0, Field: "name", Message: "name is required"},
+				{Index: 0, Field: "type", Message: `invalid type "" (must be one of: component, service, page, layout, util)`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewComponentRegistry()
+			err := r.Load(writeRegistry(t, tt.content))
+
+			if tt.want == nil {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(r.List()) != 2 {
+					t.Errorf("registered %d components, want 2", len(r.List()))
+				}
+				return
+			}
+
+			var errs RegistryErrors
+			if !errors.As(err, &errs) {
+				t.Fatalf("got %v, want RegistryErrors", err)
+			}
+			if !reflect.DeepEqual(errs, tt.want) {
+				t.Errorf("got %+v, want %+v", errs, tt.want)
+			}
+			if len(r.List()) != 0 {
+				t.Errorf("an invalid registry registered %d components", len(r.List()))
+			}
+		})
+	}
+}
+
+func TestComponentRegistryLoadMalformed(t *testing.T) {
+	err := NewComponentRegistry().Load(writeRegistry(t, "components: [unclosed"))
+
+	var errs RegistryErrors
+	if err == nil || errors.As(err, &errs) {
+		t.Errorf("got %v, want a parse error", err)
+	}
+}
@@ -0,0 +1,392 @@
+package governance
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/clause-cli/clause/pkg/utils"
+)
+
+// scanSkipDirs are directories that hold dependencies, build output or
+// caches rather than project source.
+var scanSkipDirs = map[string]bool{
+	"node_modules":     true,
+	"vendor":           true,
+	"dist":             true,
+	"build":            true,
+	"coverage":         true,
+	"target":           true,
+	"venv":             true,
+	"__pycache__":      true,
+	"storybook-static": true,
+}
+
+// serviceDirs are backend directories whose source files are services.
+var serviceDirs = map[string]bool{
+	"services":    true,
+	"service":     true,
+	"routers":     true,
+	"routes":      true,
+	"handlers":    true,
+	"controllers": true,
+	"api":         true,
+}
+
+// backendExts are the source file extensions of the supported backend
+// languages.
+var backendExts = map[string]bool{
+	".py": true, ".go": true, ".js": true, ".ts": true, ".rs": true,
+	".rb": true, ".java": true, ".ex": true,
+}
+
+// exportedComponentPattern matches exported functions and constants, whose
+// names are checked for PascalCase separately.
+var exportedComponentPattern = regexp.MustCompile(
+	`export\s+(?:default\s+)?(?:async\s+)?(?:function\s+|const\s+)([A-Za-z_$][\w$]*)`)
+
+// ScanComponents walks the frontend and backend directories and returns the
+// components found in the source tree, sorted by path. Frontend components
+// are Vue and Svelte files with PascalCase names and exported PascalCase
+// functions and constants in JSX and TSX files; files under pages or named
+// page become pages, and files under layouts or named layout become
+// layouts. Backend source files in directories such as services, routes or
+// handlers become services. Tests, stories and dependency directories are
+// skipped.
+func (g *Generator) ScanComponents() ([]Component, error) {
+	var components []Component
+	seen := make(map[[2]string]bool)
+
+	// Components in different files may share a name, so they are told
+	// apart by path as well
+	add := func(comp Component) {
+		key := [2]string{comp.Path, comp.Name}
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		components = append(components, comp)
+	}
+
+	if g.Config.Frontend.Enabled {
+		err := g.walkSource(g.Config.Frontend.Directory, func(path, rel string) error {
+			found, err := g.frontendComponents(path, rel)
+			for _, comp := range found {
+				add(comp)
+			}
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if g.Config.Backend.Enabled {
+		err := g.walkSource(g.Config.Backend.Directory, func(path, rel string) error {
+			if comp, ok := g.backendService(rel); ok {
+				add(comp)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(components, func(i, j int) bool {
+		return components[i].Path < components[j].Path
+	})
+	return components, nil
+}
+
+// walkSource calls visit for each source file under dir, relative to the
+// project, with its full path and its slash-separated path from the project
+// root. A missing directory has no files.
+func (g *Generator) walkSource(dir string, visit func(path, rel string) error) error {
+	root := filepath.Join(g.ProjectPath, dir)
+	if !utils.IsDirectory(root) {
+		return nil
+	}
+
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := d.Name()
+		if d.IsDir() {
+			if path != root && (scanSkipDirs[name] || strings.HasPrefix(name, ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if isTestOrStory(name) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(g.ProjectPath, path)
+		if err != nil {
+			return err
+		}
+		return visit(path, filepath.ToSlash(rel))
+	})
+}
+
+// frontendComponents returns the components defined in a frontend file.
+func (g *Generator) frontendComponents(path, rel string) ([]Component, error) {
+	ext := filepath.Ext(rel)
+	base := strings.TrimSuffix(filepath.Base(rel), ext)
+
+	var names []string
+	switch ext {
+	case ".vue", ".svelte":
+		if isPascalCase(base) {
+			names = []string{base}
+		}
+	case ".jsx", ".tsx":
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", rel, err)
+		}
+		for _, match := range exportedComponentPattern.FindAllStringSubmatch(string(data), -1) {
+			if isPascalCase(match[1]) {
+				names = append(names, match[1])
+			}
+		}
+		// A component declared first and exported as the default later
+		if len(names) == 0 && isPascalCase(base) && strings.Contains(string(data), "export default "+base) {
+			names = []string{base}
+		}
+	}
+
+	techStack := []string{g.Config.Frontend.Framework}
+	if ext == ".tsx" {
+		techStack = append(techStack, "typescript")
+	}
+
+	components := make([]Component, len(names))
+	for i, name := range names {
+		components[i] = Component{
+			Name:      name,
+			Type:      frontendComponentType(rel),
+			Path:      rel,
+			TechStack: techStack,
+		}
+	}
+	return components, nil
+}
+
+// backendService returns the service defined by a backend file in one of
+// the serviceDirs.
+func (g *Generator) backendService(rel string) (Component, bool) {
+	ext := filepath.Ext(rel)
+	if !backendExts[ext] || !serviceDirs[filepath.Base(filepath.Dir(rel))] {
+		return Component{}, false
+	}
+
+	base := strings.TrimSuffix(filepath.Base(rel), ext)
+	switch base {
+	case "__init__", "index", "main", "mod":
+		return Component{}, false
+	}
+
+	var techStack []string
+	for _, tech := range []string{g.Config.Backend.Language, g.Config.Backend.Framework} {
+		if tech != "" && !contains(techStack, tech) {
+			techStack = append(techStack, tech)
+		}
+	}
+
+	return Component{
+		Name:      utils.KebabCase(base),
+		Type:      "service",
+		Path:      rel,
+		TechStack: techStack,
+	}, true
+}
+
+// frontendComponentType infers the registry type of a frontend file from
+// its path.
+func frontendComponentType(rel string) string {
+	base := strings.TrimSuffix(filepath.Base(rel), filepath.Ext(rel))
+	segments := strings.Split(filepath.ToSlash(filepath.Dir(rel)), "/")
+
+	switch {
+	case base == "page" || contains(segments, "pages"):
+		return "page"
+	case base == "layout" || contains(segments, "layouts"):
+		return "layout"
+	default:
+		return "component"
+	}
+}
+
+// isPascalCase reports whether name starts with an uppercase letter and
+// contains a lowercase one, which rules out constants such as API_URL.
+func isPascalCase(name string) bool {
+	if name == "" || !unicode.IsUpper(rune(name[0])) || strings.ContainsAny(name, "_-.$") {
+		return false
+	}
+	return strings.IndexFunc(name, unicode.IsLower) >= 0
+}
+
+// isTestOrStory reports whether a file name is a test, spec or story.
+func isTestOrStory(name string) bool {
+	return strings.Contains(name, ".test.") || strings.Contains(name, ".spec.") ||
+		strings.Contains(name, ".stories.") || strings.HasSuffix(name, "_test.go") ||
+		strings.HasPrefix(name, "test_")
+}
+
+// UpdateRegistry rescans the source tree and rewrites registry.yaml with the
+// components found. Entries already in the registry keep their description,
+// dependencies, tags and API contracts, and hand-written entries whose path
+// still exists are kept. It returns the components written.
+func (g *Generator) UpdateRegistry() ([]Component, error) {
+	scanned, err := g.ScanComponents()
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan components: %w", err)
+	}
+
+	registryPath := filepath.Join(g.ProjectPath, ".clause", "registry.yaml")
+	existing, err := readRegistryFile(registryPath)
+	if err != nil {
+		return nil, err
+	}
+
+	components := mergeRegistry(existing, scanned, func(path string) bool {
+		return utils.FileExists(filepath.Join(g.ProjectPath, filepath.FromSlash(path)))
+	})
+	if err := writeRegistryFile(registryPath, components); err != nil {
+		return nil, err
+	}
+	return components, nil
+}
+
+// RegistryDrift compares registry.yaml with the source tree. It describes
+// each component found by ScanComponents that is not registered and each
+// registered component whose path no longer exists.
+func (g *Generator) RegistryDrift() ([]string, error) {
+	registered, err := readRegistryFile(filepath.Join(g.ProjectPath, ".clause", "registry.yaml"))
+	if err != nil {
+		return nil, err
+	}
+
+	scanned, err := g.ScanComponents()
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan components: %w", err)
+	}
+
+	var drift []string
+	for _, comp := range scanned {
+		if findComponent(registered, comp) < 0 {
+			drift = append(drift, fmt.Sprintf("%s (%s) is not registered", comp.Name, comp.Path))
+		}
+	}
+	for _, comp := range registered {
+		if comp.Path != "" && !utils.FileExists(filepath.Join(g.ProjectPath, filepath.FromSlash(comp.Path))) {
+			drift = append(drift, fmt.Sprintf("%s is registered at %s, which no longer exists", comp.Name, comp.Path))
+		}
+	}
+	return drift, nil
+}
+
+// mergeRegistry returns the scanned components, with the hand-written
+// fields of matching existing entries, followed by the existing entries
+// that were not scanned but whose path still exists.
+func mergeRegistry(existing, scanned []Component, exists func(path string) bool) []Component {
+	merged := make([]Component, 0, len(scanned))
+	used := make(map[int]bool)
+
+	for _, comp := range scanned {
+		if i := findComponent(existing, comp); i >= 0 {
+			used[i] = true
+			old := existing[i]
+			comp.Description = old.Description
+			comp.Dependencies = old.Dependencies
+			comp.Tags = old.Tags
+			comp.APIContracts = old.APIContracts
+			comp.LastModified = old.LastModified
+			if len(old.TechStack) > 0 {
+				comp.TechStack = old.TechStack
+			}
+		}
+		merged = append(merged, comp)
+	}
+
+	for i, comp := range existing {
+		if !used[i] && (comp.Path == "" || exists(comp.Path)) {
+			merged = append(merged, comp)
+		}
+	}
+	return merged
+}
+
+// findComponent returns the index of the entry in components with the same
+// name and path as comp, or -1.
+func findComponent(components []Component, comp Component) int {
+	for i, c := range components {
+		if c.Name == comp.Name && c.Path == comp.Path {
+			return i
+		}
+	}
+	return -1
+}
+
+// readRegistryFile reads the components in a registry file. A missing file
+// has no components.
+func readRegistryFile(path string) ([]Component, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read registry: %w", err)
+	}
+
+	var file registryFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse registry: %w", err)
+	}
+	return file.Components, nil
+}
+
+// writeRegistryFile writes registry.yaml with the given components, or with
+// an empty list and a commented example when there are none.
+func writeRegistryFile(path string, components []Component) error {
+	var content strings.Builder
+
+	content.WriteString("# Component Registry\n")
+	content.WriteString("# This file tracks all components in the project.\n")
+	content.WriteString("# Clause adds the components it finds in the source tree; descriptions,\n")
+	content.WriteString("# dependencies and tags written here are kept when it rescans.\n\n")
+
+	if len(components) == 0 {
+		content.WriteString("components: []\n")
+		content.WriteString("\n")
+		content.WriteString("# Example component:\n")
+		content.WriteString("# - name: \"user-auth\"\n")
+		content.WriteString("#   type: \"service\"\n")
+		content.WriteString("#   path: \"backend/services/auth\"\n")
+		content.WriteString("#   description: \"Authentication service\"\n")
+		content.WriteString("#   dependencies: []\n")
+		content.WriteString("#   tags: [\"auth\", \"security\"]\n")
+		content.WriteString("#   tech_stack: [\"go\", \"jwt\"]\n")
+	} else {
+		data, err := yaml.Marshal(registryFile{Components: components})
+		if err != nil {
+			return fmt.Errorf("failed to encode registry: %w", err)
+		}
+		content.Write(data)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create .clause directory: %w", err)
+	}
+	return os.WriteFile(path, []byte(content.String()), 0644)
+}
@@ -0,0 +1,239 @@
+package governance
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/clause-cli/clause/internal/config"
+	"github.com/clause-cli/clause/internal/config/enum"
+	"github.com/clause-cli/clause/pkg/utils"
+)
+
+// Rule severities. They match the severities of config validation, so a
+// rule is configured the same way as a validation check.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityInfo    = "info"
+)
+
+// RuleViolation is a governance rule that a project does not meet.
+type RuleViolation struct {
+	// Rule is the name of the rule, as used in governance.rules.rules
+	Rule string `json:"rule"`
+
+	// Severity is error, warning or info
+	Severity string `json:"severity"`
+
+	// Path is the project-relative path the violation is about, if any
+	Path string `json:"path,omitempty"`
+
+	// Message describes the violation
+	Message string `json:"message"`
+}
+
+// String formats the violation for display.
+func (v RuleViolation) String() string {
+	return fmt.Sprintf("%s: %s [%s]", v.Severity, v.Message, v.Rule)
+}
+
+// governanceRule is a built-in rule with its default severity.
+type governanceRule struct {
+	name     string
+	severity string
+	check    func(projectPath string, cfg *config.ProjectConfig) []RuleViolation
+}
+
+// governanceRules lists the built-in rules in the order they are evaluated.
+var governanceRules = []governanceRule{
+	{"context-file", SeverityError, checkContextFile},
+	{"registry-paths", SeverityError, checkRegistryPaths},
+	{"readme", SeverityError, requireDoc("README.md", func(d config.DocumentationConfig) bool { return d.README })},
+	{"contributing", SeverityWarning, requireDoc("CONTRIBUTING.md", func(d config.DocumentationConfig) bool { return d.Contributing })},
+	{"changelog", SeverityWarning, requireDoc("CHANGELOG.md", func(d config.DocumentationConfig) bool { return d.Changelog })},
+	{"prompt-guidelines", SeverityWarning, checkPromptGuidelines},
+	{"assistant-files", SeverityWarning, checkAssistantFiles},
+}
+
+// RuleNames returns the names of the built-in governance rules.
+func RuleNames() []string {
+	names := make([]string, len(governanceRules))
+	for i, rule := range governanceRules {
+		names[i] = rule.name
+	}
+	return names
+}
+
+// Validator evaluates the governance rules of a project configuration.
+type Validator struct {
+	// Config is the project configuration
+	Config *config.ProjectConfig
+}
+
+// NewValidator creates a validator for a project configuration.
+func NewValidator(cfg *config.ProjectConfig) *Validator {
+	return &Validator{Config: cfg}
+}
+
+// Validate runs the enabled governance rules against the project at
+// projectPath and returns the violations found. Each rule can be given a
+// severity, or turned off, under governance.rules.rules by its name.
+// Violations about paths matching governance.rules.exclude_patterns are
+// dropped, and in strict mode warnings become errors. Keys of
+// governance.rules.rules that name neither a rule nor a config field are
+// reported as warnings. Nothing is checked when governance or its rules
+// are disabled.
+func (v *Validator) Validate(projectPath string) []RuleViolation {
+	cfg := v.Config
+	if cfg == nil || !cfg.Governance.Enabled || !cfg.Governance.Rules.Enabled {
+		return nil
+	}
+
+	rules := cfg.Governance.Rules
+
+	violations := unknownRuleKeys(rules)
+	for _, rule := range governanceRules {
+		severity := rule.severity
+		if override, ok := rules.Rules[rule.name].SeverityOverride(); ok {
+			severity = override
+		}
+		if severity == config.SeverityOff {
+			continue
+		}
+		if rules.StrictMode && severity == SeverityWarning {
+			severity = SeverityError
+		}
+
+		for _, violation := range rule.check(projectPath, cfg) {
+			if violation.Path != "" && isExcluded(violation.Path, rules.ExcludePatterns) {
+				continue
+			}
+			violation.Rule = rule.name
+			violation.Severity = severity
+			violations = append(violations, violation)
+		}
+	}
+	return violations
+}
+
+// unknownRuleKeys returns a warning for each key of governance.rules.rules
+// that is neither a governance rule nor a configuration field path, which
+// is most likely a misspelt rule name.
+func unknownRuleKeys(rules config.GovernanceRules) []RuleViolation {
+	keys := make([]string, 0, len(rules.Rules))
+	for key := range rules.Rules {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	severity := SeverityWarning
+	if rules.StrictMode {
+		severity = SeverityError
+	}
+
+	var violations []RuleViolation
+	for _, key := range keys {
+		if contains(RuleNames(), key) || config.IsFieldPath(key) {
+			continue
+		}
+		violations = append(violations, RuleViolation{
+			Rule:     "rules",
+			Severity: severity,
+			Message: fmt.Sprintf("governance.rules.rules has unknown rule %q (rules: %s)",
+				key, strings.Join(RuleNames(), ", ")),
+		})
+	}
+	return violations
+}
+
+// HasErrors reports whether any violation has error severity.
+func HasErrors(violations []RuleViolation) bool {
+	for _, violation := range violations {
+		if violation.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// isExcluded reports whether a project-relative path is selected by the
+// exclude patterns, which use the syntax of utils.Glob.
+func isExcluded(rel string, patterns []string) bool {
+	excluded, err := utils.MatchPatterns(filepath.ToSlash(rel), patterns...)
+	return err == nil && excluded
+}
+
+// checkContextFile requires .clause/context.yaml.
+func checkContextFile(projectPath string, _ *config.ProjectConfig) []RuleViolation {
+	return requireFile(projectPath, ".clause/context.yaml", "AI context file")
+}
+
+// checkRegistryPaths requires the path of every registered component to
+// exist.
+func checkRegistryPaths(projectPath string, cfg *config.ProjectConfig) []RuleViolation {
+	if !cfg.Governance.ComponentRegistry {
+		return nil
+	}
+
+	components, err := readRegistryFile(filepath.Join(projectPath, ".clause", "registry.yaml"))
+	if err != nil {
+		return []RuleViolation{{Path: ".clause/registry.yaml", Message: err.Error()}}
+	}
+
+	var violations []RuleViolation
+	for _, comp := range components {
+		if comp.Path == "" {
+			continue
+		}
+		if !utils.FileExists(filepath.Join(projectPath, filepath.FromSlash(comp.Path))) {
+			violations = append(violations, RuleViolation{
+				Path:    comp.Path,
+				Message: fmt.Sprintf("component %s is registered at %s, which does not exist", comp.Name, comp.Path),
+			})
+		}
+	}
+	return violations
+}
+
+// requireDoc returns a check that requires a documentation file when the
+// documentation standards ask for it.
+func requireDoc(name string, enabled func(config.DocumentationConfig) bool) func(string, *config.ProjectConfig) []RuleViolation {
+	return func(projectPath string, cfg *config.ProjectConfig) []RuleViolation {
+		if !enabled(cfg.Governance.Documentation) {
+			return nil
+		}
+		return requireFile(projectPath, name, "documentation")
+	}
+}
+
+// checkPromptGuidelines requires .clause/prompt-guidelines.md when prompt
+// guidelines are enabled.
+func checkPromptGuidelines(projectPath string, cfg *config.ProjectConfig) []RuleViolation {
+	if !cfg.Governance.PromptGuidelines {
+		return nil
+	}
+	return requireFile(projectPath, ".clause/prompt-guidelines.md", "prompt guidelines")
+}
+
+// checkAssistantFiles requires the instructions file of each configured
+// assistant.
+func checkAssistantFiles(projectPath string, cfg *config.ProjectConfig) []RuleViolation {
+	var violations []RuleViolation
+	for _, target := range cfg.Governance.AssistantTargets {
+		if name, ok := assistantFiles[enum.AssistantTarget(target)]; ok {
+			violations = append(violations, requireFile(projectPath, filepath.ToSlash(name), target+" instructions")...)
+		}
+	}
+	sort.SliceStable(violations, func(i, j int) bool { return violations[i].Path < violations[j].Path })
+	return violations
+}
+
+// requireFile returns a violation when the project-relative file is missing.
+func requireFile(projectPath, rel, what string) []RuleViolation {
+	if utils.FileExists(filepath.Join(projectPath, filepath.FromSlash(rel))) {
+		return nil
+	}
+	return []RuleViolation{{Path: rel, Message: fmt.Sprintf("%s %s is missing", what, rel)}}
+}
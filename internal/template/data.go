@@ -316,6 +316,11 @@ func NewTemplateData(cfg *config.ProjectConfig) *TemplateData {
 		PromptGuidelines:  cfg.Governance.PromptGuidelines,
 	}
 
+	// Expose custom variables as .Vars
+	for key, value := range cfg.Variables {
+		data.Vars[key] = value
+	}
+
 	return data
 }
 
@@ -11,4 +11,38 @@
 //
 //	engine := template.NewEngine()
 //	result, err := engine.RenderFile("template.yaml.tmpl", data)
+//
+// Every engine starts with these functions:
+//
+//	lower, upper, title, trim, trimPrefix, trimSuffix, replace, repeat
+//	split, join, contains, hasPrefix, hasSuffix, first, last
+//	camelCase, pascalCase, snakeCase, kebabCase, screamingSnakeCase,
+//	trainCase, dotCase, pathCase, and the short forms camel, pascal,
+//	snake and kebab
+//	pluralize, truncate, default, ternary, toString, quote, squote
+//	indent, nindent
+//
+// so templates can write {{ .Project.Name | kebab }} or
+// {{ .Resource | pluralize | snake }}. AddFunc registers project-specific
+// helpers and returns an error when the name is already taken:
+//
+//	if err := engine.AddFunc("envVar", strings.ToUpper); err != nil {
+//	    return err
+//	}
+//
+// Partials registered with AddPartial can be included by any template:
+//
+//	engine.AddPartial("header", "# {{ .Project.Name }}")
+//	result, err := engine.Render("{{ template \"header\" . }}\n...", data)
+//
+// RenderWithLayout renders a content template inside a layout. The layout
+// declares sections with {{ block "name" . }}...{{ end }}, and the content
+// template overrides the ones it needs with {{ define "name" }}:
+//
+//	result, err := engine.RenderWithLayout("Dockerfile.layout.tmpl", "Dockerfile.nextjs.tmpl", data)
+//
+// RenderDir and RenderFS render a whole template tree. Glob patterns, with
+// ** and ! negation as in utils.Glob, select which templates are rendered:
+//
+//	err := engine.RenderDir("templates/nextjs", out, data, "**", "!**/*.test.tsx.tmpl")
 package template
@@ -6,6 +6,8 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -24,6 +26,10 @@ type Engine struct {
 
 	// MissingKeyHandling determines how missing keys are handled
 	MissingKey string // "error", "default", "zero", "invalid"
+
+	// Partials are named templates that every rendered template can
+	// include with {{ template "name" . }}
+	Partials map[string]string
 }
 
 // EngineOption is a functional option for configuring the engine.
@@ -32,9 +38,9 @@ type EngineOption func(*Engine)
 // NewEngine creates a new template engine with default settings.
 func NewEngine(opts ...EngineOption) *Engine {
 	e := &Engine{
-		LeftDelim:    "{{",
-		RightDelim:   "}}",
-		MissingKey:   "error",
+		LeftDelim:     "{{",
+		RightDelim:    "}}",
+		MissingKey:    "error",
 		TemplateFuncs: make(template.FuncMap),
 	}
 
@@ -64,7 +70,8 @@ func WithMissingKey(handling string) EngineOption {
 	}
 }
 
-// WithFuncs adds custom template functions.
+// WithFuncs adds custom template functions, replacing default functions of
+// the same name. Use AddFunc to be told about collisions instead.
 func WithFuncs(funcs template.FuncMap) EngineOption {
 	return func(e *Engine) {
 		for k, v := range funcs {
@@ -99,6 +106,13 @@ func (e *Engine) addDefaultFuncs() {
 	e.TemplateFuncs["dotCase"] = utils.DotCase
 	e.TemplateFuncs["pathCase"] = utils.PathCase
 
+	// Short names for piping, as in {{ .Project.Name | kebab }}
+	e.TemplateFuncs["kebab"] = utils.KebabCase
+	e.TemplateFuncs["snake"] = utils.SnakeCase
+	e.TemplateFuncs["pascal"] = utils.PascalCase
+	e.TemplateFuncs["camel"] = utils.CamelCase
+	e.TemplateFuncs["pluralize"] = utils.Pluralize
+
 	// String manipulation
 	e.TemplateFuncs["truncate"] = utils.Truncate
 	e.TemplateFuncs["repeat"] = strings.Repeat
@@ -149,14 +163,37 @@ func (e *Engine) addDefaultFuncs() {
 	}
 }
 
-// Render renders a template string with the given data.
-func (e *Engine) Render(tmpl string, data interface{}) (string, error) {
-	t, err := template.New("template").
+// newTemplate creates a template with the engine settings and the
+// registered partials. Templates parsed into it afterwards can redefine a
+// partial to override it.
+func (e *Engine) newTemplate(name string) (*template.Template, error) {
+	t := template.New(name).
 		Delims(e.LeftDelim, e.RightDelim).
 		Funcs(e.TemplateFuncs).
-		Option("missingkey=" + e.MissingKey).
-		Parse(tmpl)
+		Option("missingkey=" + e.MissingKey)
+
+	names := make([]string, 0, len(e.Partials))
+	for partial := range e.Partials {
+		names = append(names, partial)
+	}
+	sort.Strings(names)
+
+	for _, partial := range names {
+		if _, err := t.New(partial).Parse(e.Partials[partial]); err != nil {
+			return nil, fmt.Errorf("failed to parse partial %q: %w", partial, err)
+		}
+	}
+
+	return t, nil
+}
+
+// Render renders a template string with the given data.
+func (e *Engine) Render(tmpl string, data interface{}) (string, error) {
+	t, err := e.newTemplate("template")
 	if err != nil {
+		return "", err
+	}
+	if _, err := t.Parse(tmpl); err != nil {
 		return "", fmt.Errorf("failed to parse template: %w", err)
 	}
 
@@ -168,6 +205,41 @@ func (e *Engine) Render(tmpl string, data interface{}) (string, error) {
 	return buf.String(), nil
 }
 
+// RenderWithLayout renders the layout template file at layoutPath with the
+// content template file at contentPath. The layout declares overridable
+// sections with {{ block "name" . }}default{{ end }} and the content
+// template overrides them with {{ define "name" }}...{{ end }}. Text in the
+// content template outside any define is available to the layout as
+// {{ template "content" . }}.
+func (e *Engine) RenderWithLayout(layoutPath, contentPath string, data interface{}) (string, error) {
+	layout, err := os.ReadFile(layoutPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read layout file: %w", err)
+	}
+	content, err := os.ReadFile(contentPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read template file: %w", err)
+	}
+
+	t, err := e.newTemplate("layout")
+	if err != nil {
+		return "", err
+	}
+	if _, err := t.Parse(string(layout)); err != nil {
+		return "", fmt.Errorf("failed to parse layout %s: %w", layoutPath, err)
+	}
+	if _, err := t.New("content").Parse(string(content)); err != nil {
+		return "", fmt.Errorf("failed to parse template %s: %w", contentPath, err)
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
 // RenderFile renders a template file with the given data.
 func (e *Engine) RenderFile(path string, data interface{}) (string, error) {
 	content, err := os.ReadFile(path)
@@ -199,8 +271,14 @@ func (e *Engine) RenderToFile(tmpl, outputPath string, data interface{}) error {
 	return nil
 }
 
-// RenderFS renders all templates from a filesystem.
-func (e *Engine) RenderFS(fsys fs.FS, root string, data interface{}, outputDir string) error {
+// RenderFS renders all templates from a filesystem. Patterns, if given,
+// select the templates to render by their path relative to root, with the
+// syntax of utils.Glob.
+func (e *Engine) RenderFS(fsys fs.FS, root string, data interface{}, outputDir string, patterns ...string) error {
+	if err := validatePatterns(patterns); err != nil {
+		return err
+	}
+
 	return fs.WalkDir(fsys, root, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
@@ -211,14 +289,18 @@ func (e *Engine) RenderFS(fsys fs.FS, root string, data interface{}, outputDir s
 			return nil
 		}
 
+		// Determine output path (remove .tmpl extension if present)
+		relPath := strings.TrimPrefix(path, root+"/")
+		if selected, err := selectTemplate(relPath, patterns); err != nil || !selected {
+			return err
+		}
+
 		// Read template content
 		content, err := fs.ReadFile(fsys, path)
 		if err != nil {
 			return fmt.Errorf("failed to read %s: %w", path, err)
 		}
 
-		// Determine output path (remove .tmpl extension if present)
-		relPath := strings.TrimPrefix(path, root+"/")
 		outputPath := filepath.Join(outputDir, strings.TrimSuffix(relPath, ".tmpl"))
 
 		// Check if this is a template file
@@ -246,8 +328,13 @@ func (e *Engine) RenderFS(fsys fs.FS, root string, data interface{}, outputDir s
 	})
 }
 
-// RenderDir renders all templates in a directory.
-func (e *Engine) RenderDir(templateDir, outputDir string, data interface{}) error {
+// RenderDir renders all templates in a directory. Patterns select the
+// templates to render as in RenderFS.
+func (e *Engine) RenderDir(templateDir, outputDir string, data interface{}, patterns ...string) error {
+	if err := validatePatterns(patterns); err != nil {
+		return err
+	}
+
 	return filepath.WalkDir(templateDir, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
@@ -258,15 +345,19 @@ func (e *Engine) RenderDir(templateDir, outputDir string, data interface{}) erro
 			return nil
 		}
 
+		// Determine output path
+		relPath := strings.TrimPrefix(path, templateDir)
+		relPath = strings.TrimPrefix(relPath, string(os.PathSeparator))
+		if selected, err := selectTemplate(filepath.ToSlash(relPath), patterns); err != nil || !selected {
+			return err
+		}
+
 		// Read template content
 		content, err := os.ReadFile(path)
 		if err != nil {
 			return fmt.Errorf("failed to read %s: %w", path, err)
 		}
 
-		// Determine output path
-		relPath := strings.TrimPrefix(path, templateDir)
-		relPath = strings.TrimPrefix(relPath, string(os.PathSeparator))
 		outputPath := filepath.Join(outputDir, strings.TrimSuffix(relPath, ".tmpl"))
 
 		// Check if this is a template file
@@ -294,20 +385,89 @@ func (e *Engine) RenderDir(templateDir, outputDir string, data interface{}) erro
 	})
 }
 
+// validatePatterns returns an error if any template selection pattern is
+// malformed, before any file is written.
+func validatePatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if err := utils.ValidateGlob(strings.TrimPrefix(pattern, "!")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// selectTemplate reports whether the template at the slash-separated
+// relPath is selected by patterns. Without patterns every template is.
+func selectTemplate(relPath string, patterns []string) (bool, error) {
+	if len(patterns) == 0 {
+		return true, nil
+	}
+	return utils.MatchPatterns(relPath, patterns...)
+}
+
 // RenderWithConfig renders a template with project configuration.
 func (e *Engine) RenderWithConfig(tmpl string, cfg *config.ProjectConfig) (string, error) {
 	data := NewTemplateData(cfg)
 	return e.Render(tmpl, data)
 }
 
-// AddFunc adds a custom template function.
-func (e *Engine) AddFunc(name string, fn interface{}) {
+// AddFunc registers a custom template function. It returns an error if a
+// function with the same name is already defined, including the defaults,
+// or if fn is not a function text/template can call: it must return one
+// value, or a value and an error.
+func (e *Engine) AddFunc(name string, fn interface{}) error {
+	if _, ok := e.TemplateFuncs[name]; ok {
+		return fmt.Errorf("template function %q is already defined", name)
+	}
+	if err := checkTemplateFunc(name, fn); err != nil {
+		return err
+	}
 	e.TemplateFuncs[name] = fn
+	return nil
+}
+
+// checkTemplateFunc reports whether fn can be registered as a template
+// function, which text/template would otherwise panic on.
+func checkTemplateFunc(name string, fn interface{}) error {
+	t := reflect.TypeOf(fn)
+	if t == nil || t.Kind() != reflect.Func {
+		return fmt.Errorf("template function %q is not a function", name)
+	}
+
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+	switch {
+	case t.NumOut() == 1:
+		return nil
+	case t.NumOut() == 2 && t.Out(1) == errorType:
+		return nil
+	default:
+		return fmt.Errorf("template function %q must return a value, or a value and an error", name)
+	}
+}
+
+// AddPartial registers a named template that other templates can include
+// with {{ template "name" . }}. Adding a partial with an existing name
+// replaces it.
+func (e *Engine) AddPartial(name, content string) {
+	if e.Partials == nil {
+		e.Partials = make(map[string]string)
+	}
+	e.Partials[name] = content
 }
 
-// AddFuncs adds multiple custom template functions.
-func (e *Engine) AddFuncs(funcs template.FuncMap) {
-	for k, v := range funcs {
-		e.TemplateFuncs[k] = v
+// AddFuncs registers multiple custom template functions. Nothing is added
+// if any of them fails AddFunc's checks.
+func (e *Engine) AddFuncs(funcs template.FuncMap) error {
+	for name, fn := range funcs {
+		if _, ok := e.TemplateFuncs[name]; ok {
+			return fmt.Errorf("template function %q is already defined", name)
+		}
+		if err := checkTemplateFunc(name, fn); err != nil {
+			return err
+		}
 	}
+	for name, fn := range funcs {
+		e.TemplateFuncs[name] = fn
+	}
+	return nil
 }
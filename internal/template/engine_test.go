@@ -0,0 +1,412 @@
+package template
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"text/template"
+
+	"github.com/clause-cli/clause/internal/config"
+)
+
+func TestRenderWithConfigVars(t *testing.T) {
+	tests := []struct {
+		name    string
+		tmpl    string
+		vars    map[string]string
+		want    string
+		wantErr bool
+	}{
+		{"company", "© {{ .Vars.company }}", map[string]string{"company": "Acme"}, "© Acme", false},
+		{"several", "{{ .Vars.company }} at {{ .Vars.API_BASE_URL }}",
+			map[string]string{"company": "Acme", "API_BASE_URL": "https://api.acme.dev"}, "Acme at https://api.acme.dev", false},
+		{"index missing", `{{ index .Vars "company" }}`, nil, "<no value>", false},
+		{"undefined", "{{ .Vars.company }}", nil, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			cfg.Variables = tt.vars
+
+			got, err := NewEngine().RenderWithConfig(tt.tmpl, cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderWithConfig error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("rendered %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// patternTemplates are the templates the pattern tests render, relative to
+// the template root.
+var patternTemplates = map[string]string{
+	"README.md.tmpl":        "# {{ .Name }}\n",
+	"src/main.go.tmpl":      "package {{ .Name }}\n",
+	"src/main_test.go.tmpl": "package {{ .Name }}_test\n",
+	"static/logo.txt":       "logo\n",
+}
+
+var patternTests = []struct {
+	name     string
+	patterns []string
+	want     []string
+	wantErr  bool
+}{
+	{
+		name: "no patterns",
+		want: []string{"README.md", "src/main.go", "src/main_test.go", "static/logo.txt"},
+	},
+	{
+		name:     "directory",
+		patterns: []string{"src/**"},
+		want:     []string{"src/main.go", "src/main_test.go"},
+	},
+	{
+		name:     "negation after a match",
+		patterns: []string{"**/*.tmpl", "!**/*_test.go.tmpl"},
+		want:     []string{"README.md", "src/main.go"},
+	},
+	{
+		name:     "leading negation",
+		patterns: []string{"!static/"},
+		want:     []string{"README.md", "src/main.go", "src/main_test.go"},
+	},
+	{
+		name:     "nothing selected",
+		patterns: []string{"docs/**"},
+	},
+	{
+		name:     "invalid pattern",
+		patterns: []string{"src/**", "[a-"},
+		wantErr:  true,
+	},
+	{
+		name:     "invalid negated pattern",
+		patterns: []string{"!src/[a-"},
+		wantErr:  true,
+	},
+}
+
+// renderedFiles returns the sorted slash-separated paths of the files
+// under dir.
+func renderedFiles(t *testing.T, dir string) []string {
+	t.Helper()
+
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		files = append(files, filepath.ToSlash(rel))
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(files)
+	return files
+}
+
+func TestRenderFSPatterns(t *testing.T) {
+	fsys := fstest.MapFS{}
+	for name, content := range patternTemplates {
+		fsys["templates/"+name] = &fstest.MapFile{Data: []byte(content)}
+	}
+	data := map[string]string{"Name": "demo"}
+
+	for _, tt := range patternTests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := t.TempDir()
+
+			err := NewEngine().RenderFS(fsys, "templates", data, out, tt.patterns...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderFS error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := renderedFiles(t, out); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rendered %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderDirPatterns(t *testing.T) {
+	templateDir := t.TempDir()
+	for name, content := range patternTemplates {
+		path := filepath.Join(templateDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data := map[string]string{"Name": "demo"}
+
+	for _, tt := range patternTests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := t.TempDir()
+
+			err := NewEngine().RenderDir(templateDir, out, data, tt.patterns...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderDir error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := renderedFiles(t, out); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rendered %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddPartial(t *testing.T) {
+	tests := []struct {
+		name     string
+		partials [][2]string
+		tmpl     string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "included",
+			partials: [][2]string{{"header", "# {{ .Name }}"}},
+			tmpl:     "{{ template \"header\" . }}\nbody",
+			want:     "# demo\nbody",
+		},
+		{
+			name:     "nested",
+			partials: [][2]string{{"header", "{{ template \"title\" . }}!"}, {"title", "{{ .Name | upper }}"}},
+			tmpl:     "{{ template \"header\" . }}",
+			want:     "DEMO!",
+		},
+		{
+			name:     "replaced by a later partial",
+			partials: [][2]string{{"header", "old"}, {"header", "new"}},
+			tmpl:     "{{ template \"header\" . }}",
+			want:     "new",
+		},
+		{
+			name:     "overridden by the template",
+			partials: [][2]string{{"header", "partial"}},
+			tmpl:     "{{ define \"header\" }}custom{{ end }}{{ template \"header\" . }}",
+			want:     "custom",
+		},
+		{
+			name:    "missing partial",
+			tmpl:    "{{ template \"footer\" . }}",
+			wantErr: true,
+		},
+		{
+			name:     "invalid partial",
+			partials: [][2]string{{"header", "{{ .Name "}},
+			tmpl:     "body",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewEngine()
+			for _, partial := range tt.partials {
+				e.AddPartial(partial[0], partial[1])
+			}
+
+			got, err := e.Render(tt.tmpl, map[string]string{"Name": "demo"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Render error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("rendered %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderWithLayout(t *testing.T) {
+	const layout = `<main>{{ block "title" . }}Untitled{{ end }}|{{ template "content" . }}</main>`
+
+	tests := []struct {
+		name     string
+		layout   string
+		content  string
+		partials map[string]string
+		// noLayout and noContent leave the layout or content file missing
+		noLayout, noContent bool
+		want                string
+		wantErr             bool
+	}{
+		{
+			name:    "content wrapped in the layout",
+			layout:  layout,
+			content: "Hello {{ .Name }}",
+			want:    "<main>Untitled|Hello demo</main>",
+		},
+		{
+			name:    "block overridden",
+			layout:  layout,
+			content: `{{ define "title" }}{{ .Name | upper }}{{ end }}Hello`,
+			want:    "<main>DEMO|Hello</main>",
+		},
+		{
+			name:     "partial in the layout",
+			layout:   `{{ template "content" . }}{{ template "footer" . }}`,
+			content:  "Hello",
+			partials: map[string]string{"footer": " - {{ .Name }}"},
+			want:     "Hello - demo",
+		},
+		{
+			name:    "missing partial",
+			layout:  layout,
+			content: `{{ template "footer" . }}`,
+			wantErr: true,
+		},
+		{
+			name:     "missing layout",
+			content:  "Hello",
+			noLayout: true,
+			wantErr:  true,
+		},
+		{
+			name:      "missing content",
+			layout:    layout,
+			noContent: true,
+			wantErr:   true,
+		},
+		{
+			name:    "invalid layout",
+			layout:  `{{ block "title" . }}`,
+			content: "Hello",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			layoutPath := filepath.Join(dir, "layout.tmpl")
+			contentPath := filepath.Join(dir, "page.tmpl")
+			if !tt.noLayout {
+				if err := os.WriteFile(layoutPath, []byte(tt.layout), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if !tt.noContent {
+				if err := os.WriteFile(contentPath, []byte(tt.content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			e := NewEngine()
+			for name, content := range tt.partials {
+				e.AddPartial(name, content)
+			}
+
+			got, err := e.RenderWithLayout(layoutPath, contentPath, map[string]string{"Name": "demo"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderWithLayout error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("rendered %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddFunc(t *testing.T) {
+	shout := func(s string) string { return s + "!" }
+
+	tests := []struct {
+		name    string
+		fn      interface{}
+		fnName  string
+		wantErr bool
+	}{
+		{name: "new function", fnName: "shout", fn: shout},
+		{name: "value and error", fnName: "shout", fn: func(s string) (string, error) { return s + "!", nil }},
+		{name: "built-in", fnName: "upper", fn: shout, wantErr: true},
+		{name: "added before", fnName: "greet", fn: shout, wantErr: true},
+		{name: "not a function", fnName: "shout", fn: "shout", wantErr: true},
+		{name: "nil", fnName: "shout", fn: nil, wantErr: true},
+		{name: "no result", fnName: "shout", fn: func(string) {}, wantErr: true},
+		{name: "second result not an error", fnName: "shout", fn: func(s string) (string, string) { return s, s }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewEngine()
+			if err := e.AddFunc("greet", func(s string) string { return "hi " + s }); err != nil {
+				t.Fatal(err)
+			}
+
+			err := e.AddFunc(tt.fnName, tt.fn)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AddFunc error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			// A rejected function leaves the existing ones in place
+			got, err := e.Render(`{{ upper "a" }} {{ greet "b" }}`, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != "A hi b" {
+				t.Errorf("rendered %q, want %q", got, "A hi b")
+			}
+
+			if tt.wantErr {
+				return
+			}
+			if got, err := e.Render(`{{ shout "c" }}`, nil); err != nil || got != "c!" {
+				t.Errorf("rendered %q, %v, want %q", got, err, "c!")
+			}
+		})
+	}
+}
+
+func TestAddFuncs(t *testing.T) {
+	tests := []struct {
+		name    string
+		funcs   template.FuncMap
+		wantErr bool
+	}{
+		{
+			name:  "new functions",
+			funcs: template.FuncMap{"shout": strings.ToUpper, "whisper": strings.ToLower},
+		},
+		{
+			name:    "built-in",
+			funcs:   template.FuncMap{"shout": strings.ToUpper, "lower": strings.ToUpper},
+			wantErr: true,
+		},
+		{
+			name:    "invalid function",
+			funcs:   template.FuncMap{"shout": strings.ToUpper, "whisper": "quiet"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewEngine()
+
+			err := e.AddFuncs(tt.funcs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AddFuncs error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got, err := e.Render(`{{ lower "A" }}`, nil); err != nil || got != "a" {
+				t.Errorf("built-in lower rendered %q, %v, want %q", got, err, "a")
+			}
+			_, added := e.TemplateFuncs["shout"]
+			if added == tt.wantErr {
+				t.Errorf("shout registered = %v, want %v", added, !tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package wizard
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/clause-cli/clause/internal/wizard/components"
+	"github.com/clause-cli/clause/internal/wizard/screens"
+	"github.com/clause-cli/clause/pkg/utils"
+)
+
+// AccessibleEnv is the environment variable that turns on accessible mode
+// when set to a true value such as "1" or "yes".
+const AccessibleEnv = "CLAUSE_ACCESSIBLE"
+
+// AccessibleRequested reports whether AccessibleEnv asks for accessible mode.
+func AccessibleRequested() bool {
+	enabled, err := utils.ParseBoolStrict(os.Getenv(AccessibleEnv))
+	return err == nil && enabled
+}
+
+// RunAccessible runs the wizard as a linear series of plain text questions
+// read from in and written to out, for screen readers and other setups
+// where the full-screen interface is unusable. The screens are visited in
+// the same order and fill in the same configuration as the interactive
+// wizard; afterwards IsFinished or IsQuitting report the outcome as usual.
+func (w *Wizard) RunAccessible(in io.Reader, out io.Writer) error {
+	p := components.NewPrompter(in, out)
+
+	for i, screen := range w.screenInstances {
+		w.current = i
+
+		accessible, ok := screen.(screens.AccessibleScreen)
+		if !ok {
+			continue
+		}
+
+		p.Heading(fmt.Sprintf("Step %d of %d: %s", i+1, len(w.screenInstances), screen.Name()))
+		for {
+			if err := accessible.Ask(p); err != nil {
+				w.quitting = true
+				if errors.Is(err, screens.ErrNotConfirmed) {
+					p.Say("Wizard cancelled. Run 'clause init' to start again.")
+					return nil
+				}
+				return fmt.Errorf("wizard stopped at %s: %w", screen.Name(), err)
+			}
+
+			// Ask the screen again until its answers have no errors
+			issues := screen.Validate()
+			for _, issue := range issues {
+				p.Say("%s: %s", utils.TitleCase(issue.Severity), issue.Message)
+			}
+			if !issues.HasErrors() {
+				break
+			}
+			p.Say("Please correct the answers above.")
+		}
+	}
+
+	w.finished = true
+	return nil
+}
@@ -0,0 +1,145 @@
+package wizard
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/clause-cli/clause/internal/config"
+)
+
+func TestRunAccessible(t *testing.T) {
+	tests := []struct {
+		name         string
+		answers      []string
+		wantErr      bool
+		wantFinished bool
+		check        func(t *testing.T, cfg *config.ProjectConfig)
+	}{
+		{
+			name: "custom full stack",
+			answers: []string{
+				"3",                               // custom starting point
+				"my-app", "A demo app", "Ada", "", // project information
+				"y", "2", "1", "", // frontend: Vue with Tailwind
+				"y", "2", "3", "1", "", // backend: Express, SQLite, REST
+				"6", "1", "", // infrastructure: Railway, GitHub Actions
+				"", "", // governance defaults
+				"y", // confirm
+			},
+			wantFinished: true,
+			check: func(t *testing.T, cfg *config.ProjectConfig) {
+				got := []string{
+					cfg.Metadata.Name, cfg.Metadata.Description, cfg.Metadata.Author,
+					cfg.Frontend.Framework, cfg.Frontend.Styling,
+					cfg.Backend.Framework, cfg.Backend.Language, cfg.Backend.Database.Primary, cfg.Backend.API.Style,
+					cfg.Infrastructure.Hosting, cfg.Infrastructure.CI,
+				}
+				want := []string{
+					"my-app", "A demo app", "Ada",
+					"vue", "tailwind",
+					"express", "node", "sqlite", "rest",
+					"railway", "github-actions",
+				}
+				for i := range want {
+					if got[i] != want[i] {
+						t.Errorf("answer %d applied as %q, want %q", i, got[i], want[i])
+					}
+				}
+				if !cfg.Frontend.Enabled || !cfg.Backend.Enabled {
+					t.Error("frontend and backend should both be enabled")
+				}
+			},
+		},
+		{
+			name: "backend only with defaults",
+			answers: []string{
+				"1",
+				"", "", "", "",
+				"n",
+				"y", "", "", "", "",
+				"", "", "",
+				"", "",
+				"",
+			},
+			wantFinished: true,
+			check: func(t *testing.T, cfg *config.ProjectConfig) {
+				if cfg.Metadata.Name != "demo" {
+					t.Errorf("name = %q, want the name given on the command line", cfg.Metadata.Name)
+				}
+				if cfg.Frontend.Enabled {
+					t.Error("frontend enabled, want it disabled")
+				}
+				if !cfg.Backend.Enabled {
+					t.Error("backend disabled, want it enabled")
+				}
+			},
+		},
+		{
+			name: "invalid name asked again",
+			answers: []string{
+				"2",
+				"Bad Name", "good-name", "", "", "",
+				"", "", "", "",
+				"", "", "", "", "",
+				"", "", "",
+				"", "",
+				"y",
+			},
+			wantFinished: true,
+			check: func(t *testing.T, cfg *config.ProjectConfig) {
+				if cfg.Metadata.Name != "good-name" {
+					t.Errorf("name = %q, want good-name", cfg.Metadata.Name)
+				}
+			},
+		},
+		{
+			name: "declined",
+			answers: []string{
+				"2",
+				"", "", "", "",
+				"", "", "", "",
+				"", "", "", "", "",
+				"", "", "",
+				"", "",
+				"n",
+			},
+		},
+		{
+			name:    "input ends early",
+			answers: []string{"2", "demo"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := New(WithProjectName("demo"))
+			in := strings.NewReader(strings.Join(tt.answers, "\n") + "\n")
+			var out bytes.Buffer
+
+			err := w.RunAccessible(in, &out)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RunAccessible error = %v, wantErr %v\n%s", err, tt.wantErr, out.String())
+			}
+			if w.IsFinished() != tt.wantFinished {
+				t.Errorf("IsFinished() = %v, want %v\n%s", w.IsFinished(), tt.wantFinished, out.String())
+			}
+			if !tt.wantFinished && !w.IsQuitting() {
+				t.Error("IsQuitting() = false for an unfinished run")
+			}
+			if strings.Contains(out.String(), "\x1b[") {
+				t.Error("output contains terminal escape sequences")
+			}
+
+			if tt.check == nil {
+				return
+			}
+			cfg := w.Config()
+			if errs := config.Validate(cfg); errs.HasErrors() {
+				t.Errorf("resulting config is invalid: %v", errs)
+			}
+			tt.check(t, cfg)
+		})
+	}
+}
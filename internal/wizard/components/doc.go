@@ -10,5 +10,7 @@
 //   - Progress: Progress bars with animations
 //   - Spinner: Loading indicators
 //   - Form: Grouped input fields
+//   - FormFlow: Declared fields stepped through one at a time with validation
 //   - List: Scrollable lists with filtering
+//   - Prompter: Plain text questions read line by line, without Bubble Tea
 package components
@@ -0,0 +1,329 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clause-cli/clause/pkg/styles"
+	"github.com/clause-cli/clause/pkg/tui"
+)
+
+// FlowFieldKind identifies the component that backs a flow field.
+type FlowFieldKind int
+
+const (
+	// FlowInput collects free text and yields a string
+	FlowInput FlowFieldKind = iota
+
+	// FlowSelect picks one item and yields its value as a string
+	FlowSelect
+
+	// FlowMultiSelect picks several items and yields their values as a []string
+	FlowMultiSelect
+)
+
+// FlowField declares a single step of a FormFlow.
+type FlowField struct {
+	// Key identifies the field in the collected values
+	Key string
+
+	// Label is shown above the component
+	Label string
+
+	// Kind selects the backing component
+	Kind FlowFieldKind
+
+	// Placeholder is shown in an empty input
+	Placeholder string
+
+	// Default is the initial input value
+	Default string
+
+	// Items are the choices for a select
+	Items []SelectItem
+
+	// Options are the choices for a multi-select
+	Options []MultiSelectItem
+
+	// Validate checks the field value before the flow advances (optional)
+	Validate func(value interface{}) error
+}
+
+// FormFlowCompleteMsg is sent when the last field of a flow is accepted.
+type FormFlowCompleteMsg struct {
+	// Values maps field keys to their collected values
+	Values map[string]interface{}
+}
+
+// flowStep holds the component state of one field.
+type flowStep struct {
+	field FlowField
+	input InputModel
+	sel   SelectModel
+	multi MultiSelectModel
+}
+
+// FormFlow steps through an ordered list of fields one at a time. Enter
+// validates the current field and advances, shift+tab goes back, and the
+// collected values are reported when the last field is accepted.
+type FormFlow struct {
+	// Width is the component width
+	Width int
+
+	// Theme is the current theme
+	Theme *styles.Theme
+
+	steps  []flowStep
+	focus  *tui.FocusManager
+	values map[string]interface{}
+	err    string
+	done   bool
+}
+
+// NewFormFlow creates a flow over the given fields.
+func NewFormFlow(fields []FlowField) FormFlow {
+	keys := make([]string, len(fields))
+	steps := make([]flowStep, len(fields))
+	for i, field := range fields {
+		keys[i] = field.Key
+
+		step := flowStep{field: field}
+		switch field.Kind {
+		case FlowSelect:
+			step.sel = NewSelect(field.Items)
+			step.sel.SetLabel(field.Label)
+		case FlowMultiSelect:
+			step.multi = NewMultiSelect(field.Options)
+			step.multi.SetLabel(field.Label)
+		default:
+			step.input = NewInput()
+			step.input.SetLabel(field.Label)
+			step.input.SetPlaceholder(field.Placeholder)
+			step.input.SetValue(field.Default)
+		}
+		steps[i] = step
+	}
+
+	// The flow ends after the last field instead of wrapping to the first
+	focus := tui.NewFocusManager(keys...)
+	focus.SetWrap(false)
+
+	f := FormFlow{
+		Width:  60,
+		steps:  steps,
+		focus:  focus,
+		values: make(map[string]interface{}),
+	}
+	f.applyFocus()
+	return f
+}
+
+// Init initializes the flow.
+func (f FormFlow) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles updates for the flow. It returns an updated copy and
+// leaves f unchanged, like the other components.
+func (f FormFlow) Update(msg tea.Msg) (FormFlow, tea.Cmd) {
+	if f.done || len(f.steps) == 0 {
+		return f, nil
+	}
+	f = f.clone()
+
+	if key, ok := msg.(tea.KeyMsg); ok {
+		switch key.String() {
+		case "enter":
+			return f.advance()
+		case "shift+tab":
+			f.back()
+			return f, nil
+		}
+	}
+
+	step := &f.steps[f.focus.CurrentIndex()]
+	var cmd tea.Cmd
+	switch step.field.Kind {
+	case FlowSelect:
+		step.sel, cmd = step.sel.Update(msg)
+	case FlowMultiSelect:
+		step.multi, cmd = step.multi.Update(msg)
+	default:
+		step.input, cmd = step.input.Update(msg)
+	}
+	return f, cmd
+}
+
+// clone returns a copy of the flow that shares no state with f, so
+// updating the copy does not change earlier values of the model.
+func (f FormFlow) clone() FormFlow {
+	f.steps = append([]flowStep(nil), f.steps...)
+
+	values := make(map[string]interface{}, len(f.values))
+	for k, v := range f.values {
+		values[k] = v
+	}
+	f.values = values
+
+	focus := *f.focus
+	f.focus = &focus
+	return f
+}
+
+// advance validates the current field and moves to the next one, completing
+// the flow after the last field.
+func (f FormFlow) advance() (FormFlow, tea.Cmd) {
+	index := f.focus.CurrentIndex()
+	step := &f.steps[index]
+
+	value := step.value()
+	if step.field.Validate != nil {
+		if err := step.field.Validate(value); err != nil {
+			f.err = err.Error()
+			step.input.SetError(f.err)
+			return f, nil
+		}
+	}
+	f.err = ""
+	step.input.ClearError()
+	f.values[step.field.Key] = value
+
+	if index == len(f.steps)-1 {
+		f.done = true
+		f.steps[index].blur()
+		values := f.Values()
+		return f, func() tea.Msg { return FormFlowCompleteMsg{Values: values} }
+	}
+
+	f.focus.Next()
+	f.applyFocus()
+	return f, nil
+}
+
+// back returns to the previous field, keeping its value.
+func (f *FormFlow) back() {
+	if f.focus.CurrentIndex() == 0 {
+		return
+	}
+	f.err = ""
+	f.focus.Previous()
+	f.applyFocus()
+}
+
+// applyFocus focuses the current step and blurs the others.
+func (f *FormFlow) applyFocus() {
+	for i := range f.steps {
+		if i == f.focus.CurrentIndex() {
+			f.steps[i].focus()
+		} else {
+			f.steps[i].blur()
+		}
+	}
+}
+
+// View renders the current field with a step counter.
+func (f FormFlow) View() string {
+	if len(f.steps) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+
+	counter := fmt.Sprintf("Step %d of %d", f.focus.CurrentIndex()+1, len(f.steps))
+	if f.Theme != nil {
+		counter = f.Theme.Typography.Muted.Render(counter)
+	}
+	b.WriteString(counter)
+	b.WriteString("\n\n")
+
+	step := f.steps[f.focus.CurrentIndex()]
+	switch step.field.Kind {
+	case FlowSelect:
+		b.WriteString(step.sel.View())
+	case FlowMultiSelect:
+		b.WriteString(step.multi.View())
+	default:
+		b.WriteString(step.input.View())
+	}
+
+	// Inputs render their own error; other fields show it below
+	if f.err != "" && step.field.Kind != FlowInput {
+		b.WriteString("\n")
+		if f.Theme != nil {
+			b.WriteString(f.Theme.Typography.Error.Render(f.err))
+		} else {
+			b.WriteString("Error: " + f.err)
+		}
+	}
+
+	return b.String()
+}
+
+// Values returns the accepted values keyed by field key.
+func (f FormFlow) Values() map[string]interface{} {
+	values := make(map[string]interface{}, len(f.values))
+	for k, v := range f.values {
+		values[k] = v
+	}
+	return values
+}
+
+// Done reports whether every field has been accepted.
+func (f FormFlow) Done() bool {
+	return f.done
+}
+
+// Current returns the key of the field being edited.
+func (f FormFlow) Current() string {
+	return f.focus.Current()
+}
+
+// Error returns the validation error of the current field, if any.
+func (f FormFlow) Error() string {
+	return f.err
+}
+
+// SetTheme sets the theme of the flow and its fields.
+func (f *FormFlow) SetTheme(theme *styles.Theme) {
+	f.Theme = theme
+	for i := range f.steps {
+		f.steps[i].input.SetTheme(theme)
+		f.steps[i].sel.SetTheme(theme)
+		f.steps[i].multi.SetTheme(theme)
+	}
+}
+
+// SetWidth sets the width of the flow and its fields.
+func (f *FormFlow) SetWidth(width int) {
+	f.Width = width
+	for i := range f.steps {
+		f.steps[i].input.SetWidth(width)
+		f.steps[i].sel.SetWidth(width)
+		f.steps[i].multi.SetWidth(width)
+	}
+}
+
+// value returns the current value of the step's component.
+func (s *flowStep) value() interface{} {
+	switch s.field.Kind {
+	case FlowSelect:
+		return s.sel.SelectedValue()
+	case FlowMultiSelect:
+		return s.multi.SelectedValues()
+	default:
+		return strings.TrimSpace(s.input.Value)
+	}
+}
+
+func (s *flowStep) focus() {
+	s.input.Focus()
+	s.sel.Focus()
+	s.multi.Focus()
+}
+
+func (s *flowStep) blur() {
+	s.input.Blur()
+	s.sel.Blur()
+	s.multi.Blur()
+}
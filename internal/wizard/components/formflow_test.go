@@ -0,0 +1,147 @@
+package components
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// flowFields declares a three-field flow: a required name, a language and
+// a set of features.
+func flowFields() []FlowField {
+	return []FlowField{
+		{
+			Key: "name", Label: "Project name", Kind: FlowInput,
+			Validate: func(value interface{}) error {
+				if value.(string) == "" {
+					return errors.New("name is required")
+				}
+				return nil
+			},
+		},
+		{
+			Key: "language", Label: "Language", Kind: FlowSelect,
+			Items: []SelectItem{{Label: "Go", Value: "go"}, {Label: "Python", Value: "python"}},
+		},
+		{
+			Key: "features", Label: "Features", Kind: FlowMultiSelect,
+			Options: []MultiSelectItem{{Label: "Auth", Value: "auth"}, {Label: "Email", Value: "email"}},
+		},
+	}
+}
+
+// flowKey returns the key message for a key name, or typed runes otherwise.
+func flowKey(key string) tea.KeyMsg {
+	switch key {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "shift+tab":
+		return tea.KeyMsg{Type: tea.KeyShiftTab}
+	case "down":
+		return tea.KeyMsg{Type: tea.KeyDown}
+	case "space":
+		return tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")}
+	default:
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+	}
+}
+
+func TestFormFlow(t *testing.T) {
+	tests := []struct {
+		name        string
+		keys        []string
+		wantDone    bool
+		wantCurrent string
+		wantErr     string
+		wantValues  map[string]interface{}
+	}{
+		{
+			name:     "complete",
+			keys:     []string{"demo", "enter", "down", "enter", "space", "down", "space", "enter"},
+			wantDone: true, wantCurrent: "features",
+			wantValues: map[string]interface{}{"name": "demo", "language": "python", "features": []string{"auth", "email"}},
+		},
+		{
+			name:        "validation blocks advancing",
+			keys:        []string{"enter"},
+			wantCurrent: "name", wantErr: "name is required",
+			wantValues: map[string]interface{}{},
+		},
+		{
+			name:        "error cleared once valid",
+			keys:        []string{"enter", "demo", "enter"},
+			wantCurrent: "language",
+			wantValues:  map[string]interface{}{"name": "demo"},
+		},
+		{
+			name:        "back keeps values",
+			keys:        []string{"demo", "enter", "shift+tab"},
+			wantCurrent: "name",
+			wantValues:  map[string]interface{}{"name": "demo"},
+		},
+		{
+			name:        "back on first field",
+			keys:        []string{"shift+tab"},
+			wantCurrent: "name",
+			wantValues:  map[string]interface{}{},
+		},
+		{
+			name:     "no features",
+			keys:     []string{"demo", "enter", "enter", "enter"},
+			wantDone: true, wantCurrent: "features",
+			wantValues: map[string]interface{}{"name": "demo", "language": "go", "features": []string(nil)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flow := NewFormFlow(flowFields())
+
+			var completed *FormFlowCompleteMsg
+			for _, key := range tt.keys {
+				var cmd tea.Cmd
+				flow, cmd = flow.Update(flowKey(key))
+				if cmd != nil {
+					if msg, ok := cmd().(FormFlowCompleteMsg); ok {
+						completed = &msg
+					}
+				}
+			}
+
+			if flow.Done() != tt.wantDone {
+				t.Errorf("done = %v, want %v", flow.Done(), tt.wantDone)
+			}
+			if flow.Current() != tt.wantCurrent {
+				t.Errorf("current = %q, want %q", flow.Current(), tt.wantCurrent)
+			}
+			if flow.Error() != tt.wantErr {
+				t.Errorf("error = %q, want %q", flow.Error(), tt.wantErr)
+			}
+			if !reflect.DeepEqual(flow.Values(), tt.wantValues) {
+				t.Errorf("values = %#v, want %#v", flow.Values(), tt.wantValues)
+			}
+
+			if (completed != nil) != tt.wantDone {
+				t.Fatalf("completion message sent = %v, want %v", completed != nil, tt.wantDone)
+			}
+			if completed != nil && !reflect.DeepEqual(completed.Values, tt.wantValues) {
+				t.Errorf("completion values = %#v, want %#v", completed.Values, tt.wantValues)
+			}
+		})
+	}
+}
+
+func TestFormFlowUpdateLeavesOriginal(t *testing.T) {
+	flow := NewFormFlow(flowFields())
+	flow, _ = flow.Update(flowKey("demo"))
+
+	next, _ := flow.Update(flowKey("enter"))
+	if next.Current() != "language" {
+		t.Fatalf("current = %q, want language", next.Current())
+	}
+	if flow.Current() != "name" || len(flow.Values()) != 0 {
+		t.Errorf("Update changed the original flow: current %q, values %v", flow.Current(), flow.Values())
+	}
+}
@@ -0,0 +1,211 @@
+package components
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ErrPromptClosed is returned when the input ends before a question is
+// answered.
+var ErrPromptClosed = errors.New("input closed")
+
+// Prompter asks questions one at a time as plain text, reading one answer
+// per line. Unlike the other components it does not use Bubble Tea: output
+// has no styling, no cursor movement and no redrawing, which keeps it usable
+// with screen readers and scripted input.
+type Prompter struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+// NewPrompter creates a prompter reading answers from in and writing
+// questions to out.
+func NewPrompter(in io.Reader, out io.Writer) *Prompter {
+	return &Prompter{in: bufio.NewReader(in), out: out}
+}
+
+// Say writes a line of text.
+func (p *Prompter) Say(format string, args ...interface{}) {
+	fmt.Fprintf(p.out, format+"\n", args...)
+}
+
+// Heading writes a section heading preceded by a blank line.
+func (p *Prompter) Heading(title string) {
+	fmt.Fprintf(p.out, "\n%s\n%s\n", title, strings.Repeat("=", len(title)))
+}
+
+// readLine reads one answer, without surrounding whitespace.
+func (p *Prompter) readLine() (string, error) {
+	line, err := p.in.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		if err == io.EOF {
+			return "", ErrPromptClosed
+		}
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// Input asks for a line of text. An empty answer takes def. When validate
+// is set, the question is repeated until it returns nil for the answer.
+func (p *Prompter) Input(label, def string, validate func(string) error) (string, error) {
+	for {
+		if def != "" {
+			fmt.Fprintf(p.out, "%s [%s]: ", label, def)
+		} else {
+			fmt.Fprintf(p.out, "%s: ", label)
+		}
+
+		answer, err := p.readLine()
+		if err != nil {
+			return "", err
+		}
+		if answer == "" {
+			answer = def
+		}
+
+		if validate != nil {
+			if err := validate(answer); err != nil {
+				p.Say("Invalid answer: %v", err)
+				continue
+			}
+		}
+		p.Say("%s: %s", label, answer)
+		return answer, nil
+	}
+}
+
+// Confirm asks a yes or no question. An empty answer takes def.
+func (p *Prompter) Confirm(label string, def bool) (bool, error) {
+	hint := "y/N"
+	if def {
+		hint = "Y/n"
+	}
+
+	for {
+		fmt.Fprintf(p.out, "%s (%s): ", label, hint)
+
+		answer, err := p.readLine()
+		if err != nil {
+			return false, err
+		}
+
+		value := def
+		switch strings.ToLower(answer) {
+		case "":
+		case "y", "yes":
+			value = true
+		case "n", "no":
+			value = false
+		default:
+			p.Say("Please answer yes or no.")
+			continue
+		}
+
+		p.Say("%s: %s", label, yesNo(value))
+		return value, nil
+	}
+}
+
+// Select asks for one of options by number and returns its index. An empty
+// answer takes def.
+func (p *Prompter) Select(label string, options []string, def int) (int, error) {
+	p.Say("%s", label)
+	for i, option := range options {
+		p.Say("  %d. %s", i+1, option)
+	}
+
+	for {
+		fmt.Fprintf(p.out, "Enter a number from 1 to %d [%d]: ", len(options), def+1)
+
+		answer, err := p.readLine()
+		if err != nil {
+			return 0, err
+		}
+
+		index := def
+		if answer != "" {
+			n, err := strconv.Atoi(answer)
+			if err != nil || n < 1 || n > len(options) {
+				p.Say("Please enter a number from 1 to %d.", len(options))
+				continue
+			}
+			index = n - 1
+		}
+
+		p.Say("%s: %s", label, options[index])
+		return index, nil
+	}
+}
+
+// MultiSelect asks for any number of options as a comma-separated list of
+// numbers and returns whether each one is selected. An empty answer keeps
+// the selection in selected, and "none" clears it.
+func (p *Prompter) MultiSelect(label string, options []string, selected []bool) ([]bool, error) {
+	p.Say("%s", label)
+	for i, option := range options {
+		p.Say("  %d. %s (%s)", i+1, option, onOff(i < len(selected) && selected[i]))
+	}
+
+	for {
+		fmt.Fprintf(p.out, "Enter numbers separated by commas, \"none\", or nothing to keep the current choice: ")
+
+		answer, err := p.readLine()
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]bool, len(options))
+		switch strings.ToLower(answer) {
+		case "":
+			copy(result, selected)
+		case "none":
+		default:
+			valid := true
+			for _, field := range strings.Split(answer, ",") {
+				n, err := strconv.Atoi(strings.TrimSpace(field))
+				if err != nil || n < 1 || n > len(options) {
+					valid = false
+					break
+				}
+				result[n-1] = true
+			}
+			if !valid {
+				p.Say("Please enter numbers from 1 to %d.", len(options))
+				continue
+			}
+		}
+
+		var chosen []string
+		for i, on := range result {
+			if on {
+				chosen = append(chosen, options[i])
+			}
+		}
+		if len(chosen) == 0 {
+			chosen = []string{"none"}
+		}
+		p.Say("%s: %s", label, strings.Join(chosen, ", "))
+		return result, nil
+	}
+}
+
+// yesNo returns "yes" or "no".
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+// onOff returns "selected" or "not selected".
+func onOff(b bool) string {
+	if b {
+		return "selected"
+	}
+	return "not selected"
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/clause-cli/clause/pkg/styles"
 	"github.com/clause-cli/clause/pkg/tui"
+	"github.com/clause-cli/clause/pkg/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -23,6 +24,16 @@ type Dashboard struct {
 	quitting    bool
 	selectedCmd string
 	showingHelp bool
+
+	// mouse enables wheel scrolling and clicking menu items; it should only
+	// be set when the program has mouse reporting turned on
+	mouse bool
+
+	// menuRows maps the rows of the menu card, relative to its top, to
+	// choice indexes, and itemRows maps screen rows to choice indexes as
+	// last drawn by View
+	menuRows map[int]int
+	itemRows map[int]int
 }
 
 // MenuChoice represents a selectable item in the dashboard.
@@ -54,6 +65,12 @@ func NewDashboard(rootCmd *cobra.Command, version string) *Dashboard {
 	}
 }
 
+// SetMouseEnabled turns handling of mouse events on or off. Enable it only
+// when the program reports mouse events, as StartDashboard does.
+func (d *Dashboard) SetMouseEnabled(enabled bool) {
+	d.mouse = enabled
+}
+
 // Init initializes the dashboard.
 func (d *Dashboard) Init() tea.Cmd {
 	return nil
@@ -84,33 +101,71 @@ func (d *Dashboard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				d.cursor++
 			}
 		case "enter":
-			choice := d.choices[d.cursor]
-			d.selectedCmd = choice.command
-
-			switch choice.command {
-			case "exit":
-				d.quitting = true
-				return d, tea.Quit
-			case "init":
-				// Transition to wizard
-				w := New()
-				return w, w.Init()
-			case "help":
-				d.showingHelp = true
-				return d, nil
-			default:
-				// For other commands, show info and quit
-				d.quitting = true
-				return d, tea.Quit
-			}
+			return d.selectCurrent()
 		case "q", "ctrl+c", "esc":
 			d.quitting = true
 			return d, tea.Quit
 		}
+
+	case tea.MouseMsg:
+		if d.mouse {
+			return d.handleMouse(m)
+		}
+	}
+	return d, nil
+}
+
+// handleMouse moves the cursor with the wheel and selects a menu item on a
+// left click, as Enter does.
+func (d *Dashboard) handleMouse(m tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if d.showingHelp {
+		if m.Action == tea.MouseActionPress && m.Button == tea.MouseButtonLeft {
+			d.showingHelp = false
+		}
+		return d, nil
+	}
+
+	switch {
+	case m.Button == tea.MouseButtonWheelUp:
+		if d.cursor > 0 {
+			d.cursor--
+		}
+	case m.Button == tea.MouseButtonWheelDown:
+		if d.cursor < len(d.choices)-1 {
+			d.cursor++
+		}
+	case m.Button == tea.MouseButtonLeft && m.Action == tea.MouseActionPress:
+		if index, ok := d.itemRows[m.Y]; ok {
+			d.cursor = index
+			return d.selectCurrent()
+		}
 	}
 	return d, nil
 }
 
+// selectCurrent runs the menu item under the cursor.
+func (d *Dashboard) selectCurrent() (tea.Model, tea.Cmd) {
+	choice := d.choices[d.cursor]
+	d.selectedCmd = choice.command
+
+	switch choice.command {
+	case "exit":
+		d.quitting = true
+		return d, tea.Quit
+	case "init":
+		// Transition to wizard
+		w := New()
+		return w, w.Init()
+	case "help":
+		d.showingHelp = true
+		return d, nil
+	default:
+		// For other commands, show info and quit
+		d.quitting = true
+		return d, tea.Quit
+	}
+}
+
 // View renders the interactive dashboard.
 func (d *Dashboard) View() string {
 	if d.quitting {
@@ -141,6 +196,19 @@ func (d *Dashboard) View() string {
 		footer,
 	)
 
+	// Record where the menu items are drawn so clicks can be mapped to them
+	top := 0
+	if d.width > 0 && d.height > 0 {
+		if gap := d.height - lipgloss.Height(ui); gap > 0 {
+			top = gap / 2
+		}
+	}
+	menuTop := top + lipgloss.Height(banner) + 1 + lipgloss.Height(descCard) + 1
+	d.itemRows = make(map[int]int, len(d.menuRows))
+	for row, index := range d.menuRows {
+		d.itemRows[menuTop+row] = index
+	}
+
 	// Center the UI in the terminal
 	if d.width > 0 && d.height > 0 {
 		return lipgloss.Place(d.width, d.height, lipgloss.Center, lipgloss.Center, ui)
@@ -277,6 +345,10 @@ func (d *Dashboard) renderMenuCard() string {
 
 	var sections []string
 
+	// Rows of the menu items within the card content
+	rows := make(map[int]int)
+	row := 0
+
 	for _, catName := range categoryOrder {
 		choices := categories[catName]
 		if len(choices) == 0 {
@@ -292,9 +364,18 @@ func (d *Dashboard) renderMenuCard() string {
 		dividerStyle := lipgloss.NewStyle().
 			Foreground(lipgloss.Color(theme.Colors.BorderMuted))
 
+		// Sections are separated by a blank line and start with the header
+		// and divider
+		if len(sections) > 0 {
+			row++
+		}
+		row += 2
+
 		var menuItems []string
 		for _, choice := range choices {
 			menuItems = append(menuItems, d.renderMenuItem(choice))
+			rows[row] = d.choiceIndex(choice.command)
+			row++
 		}
 
 		dividerWidth := 50
@@ -329,14 +410,32 @@ func (d *Dashboard) renderMenuCard() string {
 		Width(cardWidth)
 
 	content := strings.Join(sections, "\n\n")
+	title := titleStyle.Render("🎯 Main Menu")
+
+	// The content starts below the title, the top border and the padding
+	contentTop := lipgloss.Height(title) + 2
+	d.menuRows = make(map[int]int, len(rows))
+	for r, index := range rows {
+		d.menuRows[contentTop+r] = index
+	}
 
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
-		titleStyle.Render("🎯 Main Menu"),
+		title,
 		cardStyle.Render(content),
 	)
 }
 
+// choiceIndex returns the index of the choice running command.
+func (d *Dashboard) choiceIndex(command string) int {
+	for i, choice := range d.choices {
+		if choice.command == command {
+			return i
+		}
+	}
+	return 0
+}
+
 // renderMenuItem renders a single menu item.
 func (d *Dashboard) renderMenuItem(choice MenuChoice) string {
 	theme := d.renderer.Theme()
@@ -594,7 +693,16 @@ func (d *Dashboard) renderHelpScreen() string {
 
 // StartDashboard launches the interactive dashboard.
 func StartDashboard(rootCmd *cobra.Command, version string) error {
-	p := tea.NewProgram(NewDashboard(rootCmd, version), tea.WithAltScreen())
+	dashboard := NewDashboard(rootCmd, version)
+	opts := []tea.ProgramOption{tea.WithAltScreen()}
+
+	// Mouse reporting needs a capable terminal
+	if !utils.IsDumbTerminal() {
+		dashboard.SetMouseEnabled(true)
+		opts = append(opts, tea.WithMouseCellMotion())
+	}
+
+	p := tea.NewProgram(dashboard, opts...)
 	_, err := p.Run()
 	return err
 }
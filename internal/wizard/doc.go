@@ -4,6 +4,11 @@
 // interactive screens. Each screen collects specific configuration options
 // and the final configuration is used to scaffold the project.
 //
+// A breadcrumb at the top shows the completed, current and upcoming screens.
+// Esc returns to the previous screen with its answers intact, unless the
+// screen opts out through CanGoBack; on the first screen it cancels.
+// Moving forward is blocked while a screen's Validate reports errors.
+//
 // Usage:
 //
 //	w := wizard.New()
@@ -12,4 +17,14 @@
 //	    log.Fatal(err)
 //	}
 //	config := w.Config()
+//
+// SaveState and LoadState keep the progress of a cancelled wizard in
+// ~/.clause/wizard-state.yaml, and WithState resumes from it:
+//
+//	state, _ := wizard.LoadState()
+//	w := wizard.New(wizard.WithState(state))
+//
+// For screen readers, RunAccessible asks the same questions as plain text
+// on an io.Reader and io.Writer instead, with no styling or cursor
+// movement. clause init uses it with --accessible or CLAUSE_ACCESSIBLE=1.
 package wizard
@@ -0,0 +1,289 @@
+package screens
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/clause-cli/clause/internal/wizard/components"
+)
+
+// ErrNotConfirmed is returned by SummaryScreen.Ask when the user declines
+// to create the project.
+var ErrNotConfirmed = errors.New("configuration not confirmed")
+
+// AccessibleScreen is a screen that can also be completed as plain text
+// questions, for the wizard's accessible mode. Ask asks the screen's
+// questions in order and applies the answers to the config, giving the same
+// result as the interactive screen.
+type AccessibleScreen interface {
+	Screen
+
+	// Ask asks the screen's questions through p
+	Ask(p *components.Prompter) error
+}
+
+// Ask asks for the starting point and loads its preset.
+func (s *WelcomeScreen) Ask(p *components.Prompter) error {
+	p.Say("Welcome to Clause. This wizard configures a new project.")
+
+	index, err := p.Select("Choose a starting point", []string{
+		"Quick Start: Minimal configuration, get coding fast",
+		"Standard: Balanced setup with common features",
+		"Custom: Full control over all options",
+	}, s.cursor)
+	if err != nil {
+		return err
+	}
+	s.cursor = index
+
+	// Keep a name given on the command line when the preset has none
+	var name string
+	if s.config != nil {
+		name = s.config.Metadata.Name
+	}
+	s.loadPreset()
+	if s.config != nil && s.config.Metadata.Name == "" {
+		s.config.Metadata.Name = name
+	}
+	return nil
+}
+
+// Ask asks for the project information fields.
+func (s *ProjectScreen) Ask(p *components.Prompter) error {
+	for i := range s.fields {
+		f := &s.fields[i]
+
+		def := f.value
+		if def == "" && s.config != nil {
+			switch f.key {
+			case "name":
+				def = s.config.Metadata.Name
+			case "description":
+				def = s.config.Metadata.Description
+			case "author":
+				def = s.config.Metadata.Author
+			case "license":
+				def = s.config.Metadata.License
+			}
+		}
+
+		// Only the project name is checked, as on the interactive screen
+		var validate func(string) error
+		if f.key == "name" {
+			validate = func(value string) error {
+				if !isValidProjectName(value) {
+					return fmt.Errorf("use lowercase letters, numbers and hyphens, starting with a letter")
+				}
+				return nil
+			}
+		}
+
+		value, err := p.Input(f.name, def, validate)
+		if err != nil {
+			return err
+		}
+		f.value = value
+	}
+
+	s.applyValues()
+	s.complete = s.validateAll()
+	return nil
+}
+
+// Ask asks whether to include a frontend and how to set it up.
+func (s *FrontendScreen) Ask(p *components.Prompter) error {
+	enabled, err := p.Confirm("Include a frontend?", s.enabled)
+	if err != nil {
+		return err
+	}
+	s.enabled = enabled
+
+	if s.enabled {
+		names := make([]string, len(frameworks))
+		for i, fw := range frameworks {
+			names[i] = fw.name + ": " + fw.description
+		}
+		if s.frameworkIdx, err = p.Select("Frontend framework", names, s.frameworkIdx); err != nil {
+			return err
+		}
+
+		names = make([]string, len(stylingOptions))
+		for i, style := range stylingOptions {
+			names[i] = style.name + ": " + style.description
+		}
+		if s.stylingIdx, err = p.Select("Styling approach", names, s.stylingIdx); err != nil {
+			return err
+		}
+
+		names = make([]string, len(frontendFeatureOptions))
+		keys := make([]string, len(frontendFeatureOptions))
+		for i, feat := range frontendFeatureOptions {
+			names[i] = feat.name + ": " + feat.description
+			keys[i] = feat.key
+		}
+		if err := askFeatures(p, "Frontend features", names, keys, s.features); err != nil {
+			return err
+		}
+	}
+
+	s.ApplyToConfig()
+	return nil
+}
+
+// Ask asks whether to include a backend and how to set it up.
+func (s *BackendScreen) Ask(p *components.Prompter) error {
+	enabled, err := p.Confirm("Include a backend?", s.enabled)
+	if err != nil {
+		return err
+	}
+	s.enabled = enabled
+
+	if s.enabled {
+		names := make([]string, len(backendFrameworks))
+		for i, fw := range backendFrameworks {
+			names[i] = fw.name + ": " + fw.description
+		}
+		if s.frameworkIdx, err = p.Select("Backend framework", names, s.frameworkIdx); err != nil {
+			return err
+		}
+
+		names = make([]string, len(databases))
+		for i, db := range databases {
+			names[i] = db.name + ": " + db.description
+		}
+		if s.databaseIdx, err = p.Select("Database", names, s.databaseIdx); err != nil {
+			return err
+		}
+
+		names = make([]string, len(apiStyles))
+		for i, style := range apiStyles {
+			names[i] = style.name + ": " + style.description
+		}
+		if s.apiStyleIdx, err = p.Select("API style", names, s.apiStyleIdx); err != nil {
+			return err
+		}
+
+		names = make([]string, len(backendFeatureOptions))
+		keys := make([]string, len(backendFeatureOptions))
+		for i, feat := range backendFeatureOptions {
+			names[i] = feat.name + ": " + feat.description
+			keys[i] = feat.key
+		}
+		if err := askFeatures(p, "Backend features", names, keys, s.features); err != nil {
+			return err
+		}
+	}
+
+	s.ApplyToConfig()
+	return nil
+}
+
+// Ask asks for hosting, CI/CD and infrastructure features.
+func (s *InfrastructureScreen) Ask(p *components.Prompter) error {
+	var err error
+
+	names := make([]string, len(hostingOptions))
+	for i, host := range hostingOptions {
+		names[i] = host.name + ": " + host.description
+	}
+	if s.hostingIdx, err = p.Select("Hosting", names, s.hostingIdx); err != nil {
+		return err
+	}
+
+	names = make([]string, len(ciOptions))
+	for i, ci := range ciOptions {
+		names[i] = ci.name + ": " + ci.description
+	}
+	if s.ciIdx, err = p.Select("CI/CD", names, s.ciIdx); err != nil {
+		return err
+	}
+
+	names = make([]string, len(infraFeatureOptions))
+	keys := make([]string, len(infraFeatureOptions))
+	for i, feat := range infraFeatureOptions {
+		names[i] = feat.name + ": " + feat.description
+		keys[i] = feat.key
+	}
+	if err := askFeatures(p, "Infrastructure features", names, keys, s.features); err != nil {
+		return err
+	}
+
+	s.ApplyToConfig()
+	return nil
+}
+
+// Ask asks for the context level and governance features.
+func (s *GovernanceScreen) Ask(p *components.Prompter) error {
+	var err error
+
+	names := make([]string, len(contextLevels))
+	for i, level := range contextLevels {
+		names[i] = level.name + ": " + level.description
+	}
+	if s.contextLevelIdx, err = p.Select("AI context level", names, s.contextLevelIdx); err != nil {
+		return err
+	}
+
+	names = make([]string, len(governanceFeatureOptions))
+	keys := make([]string, len(governanceFeatureOptions))
+	for i, feat := range governanceFeatureOptions {
+		names[i] = feat.name + ": " + feat.description
+		keys[i] = feat.key
+	}
+	if err := askFeatures(p, "Governance features", names, keys, s.features); err != nil {
+		return err
+	}
+
+	s.ApplyToConfig()
+	return nil
+}
+
+// Ask reads out the configuration and asks for confirmation. It returns
+// ErrNotConfirmed when the user declines.
+func (s *SummaryScreen) Ask(p *components.Prompter) error {
+	if cfg := s.Config(); cfg != nil {
+		p.Say("Project: %s", cfg.Metadata.Name)
+		if cfg.Frontend.Enabled {
+			p.Say("Frontend: %s with %s", cfg.Frontend.Framework, cfg.Frontend.Styling)
+		} else {
+			p.Say("Frontend: none")
+		}
+		if cfg.Backend.Enabled {
+			p.Say("Backend: %s (%s), %s database, %s API", cfg.Backend.Framework, cfg.Backend.Language,
+				cfg.Backend.Database.Primary, cfg.Backend.API.Style)
+		} else {
+			p.Say("Backend: none")
+		}
+		p.Say("Hosting: %s", cfg.Infrastructure.Hosting)
+		p.Say("CI/CD: %s", cfg.Infrastructure.CI)
+		p.Say("AI context level: %s", cfg.Governance.ContextLevel)
+	}
+
+	confirmed, err := p.Confirm("Create the project with this configuration?", true)
+	if err != nil {
+		return err
+	}
+	s.confirmed = confirmed
+	if !confirmed {
+		return ErrNotConfirmed
+	}
+	return nil
+}
+
+// askFeatures asks which of a list of features to enable and records the
+// answers in features under keys.
+func askFeatures(p *components.Prompter, label string, names, keys []string, features map[string]bool) error {
+	selected := make([]bool, len(keys))
+	for i, key := range keys {
+		selected[i] = features[key]
+	}
+
+	answers, err := p.MultiSelect(label, names, selected)
+	if err != nil {
+		return err
+	}
+	for i, key := range keys {
+		features[key] = answers[i]
+	}
+	return nil
+}
@@ -178,6 +178,17 @@ func (s *BackendScreen) toggle() {
 	}
 }
 
+// HelpText returns the help shown in the help overlay.
+func (s *BackendScreen) HelpText() string {
+	return `Pick the backend framework, database and features.
+
+  ←/h, →/l   switch section
+  ↑/k, ↓/j   move within a section
+  Enter      select or toggle the option
+  Tab        next section
+  Ctrl+N     continue, Esc/Ctrl+P go back`
+}
+
 // View renders the screen.
 func (s *BackendScreen) View() string {
 	var b strings.Builder
@@ -205,6 +216,12 @@ func (s *BackendScreen) View() string {
 
 	b.WriteString("\n")
 
+	// Issues with the current selections
+	if issues := s.renderIssues(s.Validate(), "backend."); issues != "" {
+		b.WriteString(issues)
+		b.WriteString("\n\n")
+	}
+
 	kb := tui.NewKeyBindings()
 	kb.Add("←/→", "Switch sections")
 	kb.Add("↑/↓", "Navigate")
@@ -338,30 +355,72 @@ func (s *BackendScreen) ApplyToConfig() {
 	if s.config == nil {
 		return
 	}
+	s.applyTo(s.config)
+}
 
-	s.config.Backend.Enabled = s.enabled
+// applyTo copies the selections into cfg.
+func (s *BackendScreen) applyTo(cfg *config.ProjectConfig) {
+	cfg.Backend.Enabled = s.enabled
 
 	if s.enabled && s.frameworkIdx < len(backendFrameworks) {
 		fw := backendFrameworks[s.frameworkIdx]
-		s.config.Backend.Framework = strings.ToLower(strings.ReplaceAll(fw.name, " ", "-"))
-		s.config.Backend.Language = fw.language
+		cfg.Backend.Framework = strings.ToLower(strings.ReplaceAll(fw.name, " ", "-"))
+		cfg.Backend.Language = fw.language
 	}
 
 	if s.databaseIdx < len(databases) {
-		s.config.Backend.Database.Primary = strings.ToLower(databases[s.databaseIdx].name)
+		cfg.Backend.Database.Primary = strings.ToLower(databases[s.databaseIdx].name)
 	}
 
 	if s.apiStyleIdx < len(apiStyles) {
-		s.config.Backend.API.Style = strings.ToLower(apiStyles[s.apiStyleIdx].name)
+		cfg.Backend.API.Style = strings.ToLower(apiStyles[s.apiStyleIdx].name)
+	}
+
+	cfg.Backend.Features.WebSocket = s.features["websocket"]
+	cfg.Backend.Features.BackgroundJobs = s.features["jobs"]
+	cfg.Backend.Features.FileUpload = s.features["file_upload"]
+	cfg.Backend.Features.Email = s.features["email"]
+	cfg.Backend.Features.RateLimiting = s.features["rate_limiting"]
+	cfg.Backend.Features.Logging = s.features["logging"]
+	cfg.Backend.Features.Metrics = s.features["metrics"]
+}
+
+// LoadFromConfig sets the selections from the config, so a resumed wizard
+// shows the answers given before.
+func (s *BackendScreen) LoadFromConfig() {
+	if s.config == nil {
+		return
 	}
+	cfg := s.config
+
+	s.enabled = cfg.Backend.Enabled
+	for i, fw := range backendFrameworks {
+		if strings.ToLower(strings.ReplaceAll(fw.name, " ", "-")) == cfg.Backend.Framework {
+			s.frameworkIdx = i
+		}
+	}
+	for i, db := range databases {
+		if strings.ToLower(db.name) == cfg.Backend.Database.Primary {
+			s.databaseIdx = i
+		}
+	}
+	for i, style := range apiStyles {
+		if strings.ToLower(style.name) == cfg.Backend.API.Style {
+			s.apiStyleIdx = i
+		}
+	}
+	s.features["websocket"] = cfg.Backend.Features.WebSocket
+	s.features["jobs"] = cfg.Backend.Features.BackgroundJobs
+	s.features["file_upload"] = cfg.Backend.Features.FileUpload
+	s.features["email"] = cfg.Backend.Features.Email
+	s.features["rate_limiting"] = cfg.Backend.Features.RateLimiting
+	s.features["logging"] = cfg.Backend.Features.Logging
+	s.features["metrics"] = cfg.Backend.Features.Metrics
+}
 
-	s.config.Backend.Features.WebSocket = s.features["websocket"]
-	s.config.Backend.Features.BackgroundJobs = s.features["jobs"]
-	s.config.Backend.Features.FileUpload = s.features["file_upload"]
-	s.config.Backend.Features.Email = s.features["email"]
-	s.config.Backend.Features.RateLimiting = s.features["rate_limiting"]
-	s.config.Backend.Features.Logging = s.features["logging"]
-	s.config.Backend.Features.Metrics = s.features["metrics"]
+// Validate checks the backend selections.
+func (s *BackendScreen) Validate() config.ValidationErrors {
+	return s.validateWith("backend", s.applyTo)
 }
 
 // SetTheme sets the theme.
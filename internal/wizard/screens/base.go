@@ -1,6 +1,8 @@
 package screens
 
 import (
+	"strings"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/clause-cli/clause/internal/config"
 	"github.com/clause-cli/clause/pkg/styles"
@@ -42,6 +44,18 @@ type Screen interface {
 
 	// IsComplete returns true if the screen's data entry is complete
 	IsComplete() bool
+
+	// Validate returns the validation issues of the values on the screen;
+	// the wizard does not move on while there are errors
+	Validate() config.ValidationErrors
+}
+
+// TextInputScreen is implemented by screens that take typed text. While
+// such a screen is shown, the wizard leaves Backspace and the letter keys
+// to it instead of using them for navigation.
+type TextInputScreen interface {
+	// TakesTextInput reports whether the screen currently takes text
+	TakesTextInput() bool
 }
 
 // BaseScreen provides common functionality for all screens.
@@ -186,6 +200,43 @@ func (s *BaseScreen) ScreenHelp(bindings tui.KeyBindings) string {
 }
 
 // Validate validates screen input - override in specific screens.
-func (s *BaseScreen) Validate() error {
+func (s *BaseScreen) Validate() config.ValidationErrors {
 	return nil
 }
+
+// validateWith applies the screen's values to a copy of the config with
+// apply and runs the validator checks for section on the copy.
+func (s *BaseScreen) validateWith(section string, apply func(*config.ProjectConfig)) config.ValidationErrors {
+	if s.config == nil {
+		return nil
+	}
+	cfg := s.config.Clone()
+	apply(cfg)
+	return config.NewValidator().ValidateSection(cfg, section)
+}
+
+// renderIssues renders the messages of the issues whose field starts with
+// one of prefixes, errors in the error color and warnings in the warning
+// color. It returns an empty string when there are none.
+func (s *BaseScreen) renderIssues(issues config.ValidationErrors, prefixes ...string) string {
+	var lines []string
+	for _, issue := range issues {
+		matched := false
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(issue.Field, prefix) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+
+		if issue.Severity == "error" {
+			lines = append(lines, s.renderer.Error("✗ "+issue.Message))
+		} else {
+			lines = append(lines, s.renderer.Warning("⚠ "+issue.Message))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -9,6 +9,14 @@
 //   - FrontendScreen: Frontend framework and features
 //   - BackendScreen: Backend framework and database
 //   - InfrastructureScreen: Hosting and CI/CD
-//   - GovernanceScreen: AI governance settings
+//   - GovernanceScreen: AI governance settings, with a scrollable preview
+//     of the prompt guidelines
 //   - SummaryScreen: Configuration review and confirmation
+//
+// Validate checks a screen's current selections with the matching section
+// of config.Validator, and the screens show the issues in place, under the
+// offending input.
+//
+// Every screen also implements AccessibleScreen, asking its questions as
+// plain text through a components.Prompter for the accessible wizard.
 package screens
@@ -37,16 +37,17 @@ var frameworks = []struct {
 	{"Solid", "Reactive UI library"},
 }
 
-// Styling options
+// Styling options, with the frontend.styling value each one sets
 var stylingOptions = []struct {
 	name        string
 	description string
+	value       string
 }{
-	{"Tailwind CSS", "Utility-first CSS framework"},
-	{"CSS Modules", "Scoped CSS for components"},
-	{"Styled Components", "CSS-in-JS styling"},
-	{"SCSS/Sass", "CSS preprocessor"},
-	{"Emotion", "CSS-in-JS library"},
+	{"Tailwind CSS", "Utility-first CSS framework", "tailwind"},
+	{"CSS Modules", "Scoped CSS for components", "css-modules"},
+	{"Styled Components", "CSS-in-JS styling", "styled-components"},
+	{"SCSS/Sass", "CSS preprocessor", "scss"},
+	{"Emotion", "CSS-in-JS library", "emotion"},
 }
 
 // Frontend feature options
@@ -183,6 +184,17 @@ func (s *FrontendScreen) updateCompletion() {
 	s.complete = true
 }
 
+// HelpText returns the help shown in the help overlay.
+func (s *FrontendScreen) HelpText() string {
+	return `Pick the frontend framework, styling and features.
+
+  ←/h, →/l   switch section
+  ↑/k, ↓/j   move within a section
+  Enter      select or toggle the option
+  Tab        next section
+  Ctrl+N     continue, Esc/Ctrl+P go back`
+}
+
 // View renders the screen.
 func (s *FrontendScreen) View() string {
 	var b strings.Builder
@@ -214,6 +226,12 @@ func (s *FrontendScreen) View() string {
 
 	b.WriteString("\n")
 
+	// Issues with the current selections
+	if issues := s.renderIssues(s.Validate(), "frontend."); issues != "" {
+		b.WriteString(issues)
+		b.WriteString("\n\n")
+	}
+
 	// Help
 	kb := tui.NewKeyBindings()
 	kb.Add("←/→", "Switch sections")
@@ -328,20 +346,57 @@ func (s *FrontendScreen) ApplyToConfig() {
 	if s.config == nil {
 		return
 	}
+	s.applyTo(s.config)
+}
 
-	s.config.Frontend.Enabled = s.enabled
+// applyTo copies the selections into cfg.
+func (s *FrontendScreen) applyTo(cfg *config.ProjectConfig) {
+	cfg.Frontend.Enabled = s.enabled
 
 	if s.enabled {
-		s.config.Frontend.Framework = strings.ToLower(strings.ReplaceAll(frameworks[s.frameworkIdx].name, ".", ""))
-		s.config.Frontend.Styling = strings.ToLower(strings.Split(stylingOptions[s.stylingIdx].name, " ")[0])
-		s.config.Frontend.TypeScript = s.features["typescript"]
-		s.config.Frontend.Features.SSR = s.features["ssr"]
-		s.config.Frontend.Features.SSG = s.features["ssg"]
-		s.config.Frontend.Features.PWA = s.features["pwa"]
-		s.config.Frontend.Features.I18n = s.features["i18n"]
-		s.config.Frontend.Features.DarkMode = s.features["dark_mode"]
-		s.config.Frontend.Features.Storybook = s.features["storybook"]
+		cfg.Frontend.Framework = strings.ToLower(strings.ReplaceAll(frameworks[s.frameworkIdx].name, ".", ""))
+		cfg.Frontend.Styling = stylingOptions[s.stylingIdx].value
+		cfg.Frontend.TypeScript = s.features["typescript"]
+		cfg.Frontend.Features.SSR = s.features["ssr"]
+		cfg.Frontend.Features.SSG = s.features["ssg"]
+		cfg.Frontend.Features.PWA = s.features["pwa"]
+		cfg.Frontend.Features.I18n = s.features["i18n"]
+		cfg.Frontend.Features.DarkMode = s.features["dark_mode"]
+		cfg.Frontend.Features.Storybook = s.features["storybook"]
+	}
+}
+
+// LoadFromConfig sets the selections from the config, so a resumed wizard
+// shows the answers given before.
+func (s *FrontendScreen) LoadFromConfig() {
+	if s.config == nil {
+		return
+	}
+	cfg := s.config
+
+	s.enabled = cfg.Frontend.Enabled
+	for i, fw := range frameworks {
+		if strings.ToLower(strings.ReplaceAll(fw.name, ".", "")) == cfg.Frontend.Framework {
+			s.frameworkIdx = i
+		}
+	}
+	for i, opt := range stylingOptions {
+		if opt.value == cfg.Frontend.Styling {
+			s.stylingIdx = i
+		}
 	}
+	s.features["typescript"] = cfg.Frontend.TypeScript
+	s.features["ssr"] = cfg.Frontend.Features.SSR
+	s.features["ssg"] = cfg.Frontend.Features.SSG
+	s.features["pwa"] = cfg.Frontend.Features.PWA
+	s.features["i18n"] = cfg.Frontend.Features.I18n
+	s.features["dark_mode"] = cfg.Frontend.Features.DarkMode
+	s.features["storybook"] = cfg.Frontend.Features.Storybook
+}
+
+// Validate checks the frontend selections.
+func (s *FrontendScreen) Validate() config.ValidationErrors {
+	return s.validateWith("frontend", s.applyTo)
 }
 
 // SetTheme sets the theme.
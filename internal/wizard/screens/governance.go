@@ -5,6 +5,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/clause-cli/clause/internal/config"
+	"github.com/clause-cli/clause/internal/governance"
 	"github.com/clause-cli/clause/pkg/styles"
 	"github.com/clause-cli/clause/pkg/tui"
 )
@@ -15,6 +16,10 @@ type GovernanceScreen struct {
 	cursor          int
 	contextLevelIdx int
 	features        map[string]bool
+
+	// preview shows the prompt guidelines the selections produce, and is
+	// nil while the preview is closed
+	preview *tui.Viewport
 }
 
 // Context level options
@@ -62,8 +67,18 @@ func (s *GovernanceScreen) Init() tea.Cmd {
 	return nil
 }
 
-// Update handles updates.
+// Update handles updates. While the preview is open it takes the
+// navigation keys, and P closes it again.
 func (s *GovernanceScreen) Update(msg tea.Msg) tea.Cmd {
+	if s.preview != nil {
+		if m, ok := msg.(tea.KeyMsg); ok && m.String() == "p" {
+			s.preview = nil
+			s.complete = true
+			return nil
+		}
+		return s.preview.Update(msg)
+	}
+
 	switch m := msg.(type) {
 	case tea.KeyMsg:
 		switch m.String() {
@@ -78,6 +93,11 @@ func (s *GovernanceScreen) Update(msg tea.Msg) tea.Cmd {
 			}
 		case "enter", " ":
 			s.toggle()
+		case "p":
+			s.openPreview()
+			// Keep Enter from leaving the screen while previewing
+			s.complete = false
+			return nil
 		}
 	}
 
@@ -85,6 +105,26 @@ func (s *GovernanceScreen) Update(msg tea.Msg) tea.Cmd {
 	return nil
 }
 
+// openPreview renders the prompt guidelines for the current selections
+// into a viewport sized to the screen.
+func (s *GovernanceScreen) openPreview() {
+	cfg := config.DefaultConfig()
+	if s.config != nil {
+		cfg = s.config.Clone()
+	}
+	s.applyTo(cfg)
+
+	width, height := s.previewSize()
+	s.preview = tui.NewViewport(width, height)
+	s.preview.SetContent(governance.NewGenerator("", cfg).PromptGuidelines())
+}
+
+// previewSize returns the size of the preview text area, leaving room for
+// the title, the help line and the scroll indicator.
+func (s *GovernanceScreen) previewSize() (width, height int) {
+	return max(s.width-4, 20), max(s.height-6, 5)
+}
+
 func (s *GovernanceScreen) toggle() {
 	// Check if selecting context level
 	if s.cursor < len(contextLevels) {
@@ -99,8 +139,25 @@ func (s *GovernanceScreen) toggle() {
 	}
 }
 
+// HelpText returns the help shown in the help overlay.
+func (s *GovernanceScreen) HelpText() string {
+	return `Configure AI governance for the project.
+
+The context level controls how much detail is written to .clause/context.yaml.
+Features add the component registry, prompt guidelines and Brainstorm.md.
+
+  ↑/k, ↓/j   move between options
+  Enter      select a level or toggle a feature
+  p          preview the prompt guidelines, p again to close
+  Ctrl+N     continue, Esc/Ctrl+P go back`
+}
+
 // View renders the screen.
 func (s *GovernanceScreen) View() string {
+	if s.preview != nil {
+		return s.previewView()
+	}
+
 	var b strings.Builder
 
 	b.WriteString(s.Renderer().Title("AI Governance Configuration"))
@@ -149,29 +206,72 @@ func (s *GovernanceScreen) View() string {
 
 	b.WriteString("\n")
 
+	// Issues with the current selections
+	if issues := s.renderIssues(s.Validate(), "governance."); issues != "" {
+		b.WriteString(issues)
+		b.WriteString("\n\n")
+	}
+
 	// Help
 	kb := tui.NewKeyBindings()
 	kb.Add("↑/↓", "Navigate")
 	kb.Add("Enter/Space", "Select/Toggle")
+	kb.Add("p", "Preview")
 	b.WriteString(s.Renderer().HelpText(kb))
 
 	return b.String()
 }
 
+// previewView renders the prompt guidelines preview.
+func (s *GovernanceScreen) previewView() string {
+	kb := s.preview.KeyBindings()
+	kb.Add("p", "Close")
+
+	return s.Renderer().Title("Prompt Guidelines Preview") + "\n\n" +
+		s.preview.View() + "\n\n" +
+		s.Renderer().HelpText(kb)
+}
+
 // ApplyToConfig applies settings to config.
 func (s *GovernanceScreen) ApplyToConfig() {
 	if s.config == nil {
 		return
 	}
+	s.applyTo(s.config)
+}
 
+// applyTo copies the selections into cfg.
+func (s *GovernanceScreen) applyTo(cfg *config.ProjectConfig) {
 	if s.contextLevelIdx < len(contextLevels) {
-		s.config.Governance.ContextLevel = contextLevels[s.contextLevelIdx].key
+		cfg.Governance.ContextLevel = contextLevels[s.contextLevelIdx].key
 	}
 
-	s.config.Governance.Enabled = true
-	s.config.Governance.BrainstormMd = s.features["brainstorm_md"]
-	s.config.Governance.PromptGuidelines = s.features["prompt_guidelines"]
-	s.config.Governance.ComponentRegistry = s.features["component_registry"]
+	cfg.Governance.Enabled = true
+	cfg.Governance.BrainstormMd = s.features["brainstorm_md"]
+	cfg.Governance.PromptGuidelines = s.features["prompt_guidelines"]
+	cfg.Governance.ComponentRegistry = s.features["component_registry"]
+}
+
+// LoadFromConfig sets the selections from the config, so a resumed wizard
+// shows the answers given before.
+func (s *GovernanceScreen) LoadFromConfig() {
+	if s.config == nil {
+		return
+	}
+
+	for i, level := range contextLevels {
+		if level.key == s.config.Governance.ContextLevel {
+			s.contextLevelIdx = i
+		}
+	}
+	s.features["brainstorm_md"] = s.config.Governance.BrainstormMd
+	s.features["prompt_guidelines"] = s.config.Governance.PromptGuidelines
+	s.features["component_registry"] = s.config.Governance.ComponentRegistry
+}
+
+// Validate checks the governance selections.
+func (s *GovernanceScreen) Validate() config.ValidationErrors {
+	return s.validateWith("governance", s.applyTo)
 }
 
 // SetTheme sets the theme.
@@ -187,4 +287,7 @@ func (s *GovernanceScreen) SetConfig(config *config.ProjectConfig) {
 // SetSize sets the size.
 func (s *GovernanceScreen) SetSize(width, height int) {
 	s.BaseScreen.SetSize(width, height)
+	if s.preview != nil {
+		s.preview.SetSize(s.previewSize())
+	}
 }
@@ -0,0 +1,85 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clause-cli/clause/internal/config"
+	"github.com/clause-cli/clause/pkg/styles"
+)
+
+func TestGovernanceScreenPreview(t *testing.T) {
+	p := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")}
+	end := tea.KeyMsg{Type: tea.KeyEnd}
+
+	tests := []struct {
+		name         string
+		keys         []tea.KeyMsg
+		want         []string
+		notWant      []string
+		wantComplete bool
+	}{
+		{
+			name:    "closed",
+			want:    []string{"AI Governance Configuration", "Preview"},
+			notWant: []string{"# AI Prompt Guidelines"},
+		},
+		{
+			name:    "open",
+			keys:    []tea.KeyMsg{p},
+			want:    []string{"Prompt Guidelines Preview", "# AI Prompt Guidelines", "- [Project Context](#project-context)"},
+			notWant: []string{"AI Governance Configuration"},
+		},
+		{
+			name:    "scrolled to the end",
+			keys:    []tea.KeyMsg{p, end},
+			want:    []string{"Prompt Guidelines Preview"},
+			notWant: []string{"# AI Prompt Guidelines"},
+		},
+		{
+			name:         "closed again",
+			keys:         []tea.KeyMsg{p, end, p},
+			want:         []string{"AI Governance Configuration"},
+			notWant:      []string{"Prompt Guidelines Preview"},
+			wantComplete: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadPreset("saas")
+			if err != nil {
+				t.Fatal(err)
+			}
+			cfg.Metadata.Name = "demo"
+
+			s := NewGovernanceScreen()
+			s.SetTheme(styles.GetTheme())
+			s.SetSize(100, 20)
+			s.SetConfig(cfg)
+
+			for _, key := range tt.keys {
+				s.Update(key)
+			}
+
+			view := s.View()
+			for _, want := range tt.want {
+				if !strings.Contains(view, want) {
+					t.Errorf("view does not contain %q:\n%s", want, view)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(view, notWant) {
+					t.Errorf("view contains %q:\n%s", notWant, view)
+				}
+			}
+			if lines := strings.Count(view, "\n") + 1; s.preview != nil && lines > 20 {
+				t.Errorf("preview is %d lines tall, want at most 20", lines)
+			}
+			if s.IsComplete() != tt.wantComplete {
+				t.Errorf("IsComplete() = %v, want %v", s.IsComplete(), tt.wantComplete)
+			}
+		})
+	}
+}
@@ -19,21 +19,22 @@ type InfrastructureScreen struct {
 	ciIdx      int
 }
 
-// Hosting options
+// Hosting options, with the infrastructure.hosting value each one sets
 var hostingOptions = []struct {
 	name        string
 	description string
+	value       string
 }{
-	{"Vercel", "Optimized for frontend & serverless"},
-	{"Netlify", "Static sites & functions"},
-	{"AWS", "Amazon Web Services"},
-	{"Google Cloud", "Google Cloud Platform"},
-	{"Azure", "Microsoft Azure"},
-	{"Railway", "Simple infrastructure platform"},
-	{"Render", "Cloud platform for apps"},
-	{"Fly.io", "Global app deployment"},
-	{"DigitalOcean", "Cloud infrastructure"},
-	{"Self-hosted", "On your own servers"},
+	{"Vercel", "Optimized for frontend & serverless", "vercel"},
+	{"Netlify", "Static sites & functions", "netlify"},
+	{"AWS", "Amazon Web Services", "aws"},
+	{"Google Cloud", "Google Cloud Platform", "gcp"},
+	{"Azure", "Microsoft Azure", "azure"},
+	{"Railway", "Simple infrastructure platform", "railway"},
+	{"Render", "Cloud platform for apps", "render"},
+	{"Fly.io", "Global app deployment", "fly"},
+	{"DigitalOcean", "Cloud infrastructure", "digitalocean"},
+	{"Self-hosted", "On your own servers", "self-hosted"},
 }
 
 // CI/CD options
@@ -150,6 +151,17 @@ func (s *InfrastructureScreen) toggle() {
 	}
 }
 
+// HelpText returns the help shown in the help overlay.
+func (s *InfrastructureScreen) HelpText() string {
+	return `Choose hosting, CI and container options.
+
+  ←/h, →/l   switch section
+  ↑/k, ↓/j   move within a section
+  Enter      select or toggle the option
+  Tab        next section
+  Ctrl+N     continue, Esc/Ctrl+P go back`
+}
+
 // View renders the screen.
 func (s *InfrastructureScreen) View() string {
 	var b strings.Builder
@@ -173,6 +185,12 @@ func (s *InfrastructureScreen) View() string {
 
 	b.WriteString("\n")
 
+	// Issues with the current selections
+	if issues := s.renderIssues(s.Validate(), "infrastructure."); issues != "" {
+		b.WriteString(issues)
+		b.WriteString("\n\n")
+	}
+
 	kb := tui.NewKeyBindings()
 	kb.Add("←/→", "Switch sections")
 	kb.Add("↑/↓", "Navigate")
@@ -253,27 +271,65 @@ func (s *InfrastructureScreen) ApplyToConfig() {
 	if s.config == nil {
 		return
 	}
+	s.applyTo(s.config)
+}
 
+// applyTo copies the selections into cfg.
+func (s *InfrastructureScreen) applyTo(cfg *config.ProjectConfig) {
 	if s.hostingIdx < len(hostingOptions) {
-		s.config.Infrastructure.Hosting = strings.ToLower(strings.ReplaceAll(hostingOptions[s.hostingIdx].name, ".", ""))
+		cfg.Infrastructure.Hosting = hostingOptions[s.hostingIdx].value
 	}
 
 	if s.ciIdx < len(ciOptions) {
 		ci := ciOptions[s.ciIdx]
 		if ci.name == "GitHub Actions" {
-			s.config.Infrastructure.CI = "github-actions"
+			cfg.Infrastructure.CI = "github-actions"
 		} else if ci.name == "GitLab CI" {
-			s.config.Infrastructure.CI = "gitlab-ci"
+			cfg.Infrastructure.CI = "gitlab-ci"
 		} else if ci.name != "None" {
-			s.config.Infrastructure.CI = strings.ToLower(strings.ReplaceAll(ci.name, " ", "-"))
+			cfg.Infrastructure.CI = strings.ToLower(strings.ReplaceAll(ci.name, " ", "-"))
 		}
 	}
 
-	s.config.Infrastructure.Docker = s.features["docker"]
-	s.config.Infrastructure.DockerCompose = s.features["docker_compose"]
-	s.config.Infrastructure.Kubernetes = s.features["kubernetes"]
-	s.config.Infrastructure.CDN = s.features["cdn"]
-	s.config.Infrastructure.Monitoring.Enabled = s.features["monitoring"]
+	cfg.Infrastructure.Docker = s.features["docker"]
+	cfg.Infrastructure.DockerCompose = s.features["docker_compose"]
+	cfg.Infrastructure.Kubernetes = s.features["kubernetes"]
+	cfg.Infrastructure.CDN = s.features["cdn"]
+	cfg.Infrastructure.Monitoring.Enabled = s.features["monitoring"]
+}
+
+// LoadFromConfig sets the selections from the config, so a resumed wizard
+// shows the answers given before.
+func (s *InfrastructureScreen) LoadFromConfig() {
+	if s.config == nil {
+		return
+	}
+	cfg := s.config
+
+	for i, opt := range hostingOptions {
+		if opt.value == cfg.Infrastructure.Hosting {
+			s.hostingIdx = i
+		}
+	}
+	s.ciIdx = len(ciOptions) - 1 // None
+	for i, ci := range ciOptions {
+		switch {
+		case ci.name == "GitHub Actions" && cfg.Infrastructure.CI == "github-actions",
+			ci.name == "GitLab CI" && cfg.Infrastructure.CI == "gitlab-ci",
+			strings.ToLower(strings.ReplaceAll(ci.name, " ", "-")) == cfg.Infrastructure.CI:
+			s.ciIdx = i
+		}
+	}
+	s.features["docker"] = cfg.Infrastructure.Docker
+	s.features["docker_compose"] = cfg.Infrastructure.DockerCompose
+	s.features["kubernetes"] = cfg.Infrastructure.Kubernetes
+	s.features["cdn"] = cfg.Infrastructure.CDN
+	s.features["monitoring"] = cfg.Infrastructure.Monitoring.Enabled
+}
+
+// Validate checks the infrastructure selections.
+func (s *InfrastructureScreen) Validate() config.ValidationErrors {
+	return s.validateWith("infrastructure", s.applyTo)
 }
 
 // SetTheme sets the theme.
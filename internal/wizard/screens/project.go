@@ -105,6 +105,21 @@ func (s *ProjectScreen) Update(msg tea.Msg) tea.Cmd {
 	return nil
 }
 
+// TakesTextInput returns true, as the fields take typed text.
+func (s *ProjectScreen) TakesTextInput() bool {
+	return true
+}
+
+// HelpText returns the help shown in the help overlay.
+func (s *ProjectScreen) HelpText() string {
+	return `Enter the basic project details.
+
+  ↑, ↓/Tab   move between fields
+  Backspace  delete the last character
+  Enter      next field, or continue when complete
+  Esc/Ctrl+P back to the previous screen`
+}
+
 // View renders the screen.
 func (s *ProjectScreen) View() string {
 	var b strings.Builder
@@ -117,7 +132,8 @@ func (s *ProjectScreen) View() string {
 	b.WriteString(s.Renderer().Body("Let's start with some basic information about your project."))
 	b.WriteString("\n\n")
 
-	// Fields
+	// Fields, with the issues of each shown once it is filled in or passed
+	issues := s.Validate()
 	for i, f := range s.fields {
 		label := s.Renderer().Header(f.name)
 		b.WriteString(label)
@@ -138,6 +154,13 @@ func (s *ProjectScreen) View() string {
 			}
 		}
 
+		if f.value != "" || i < s.activeField {
+			if msgs := s.renderIssues(issues, "metadata."+f.key); msgs != "" {
+				b.WriteString("\n")
+				b.WriteString(msgs)
+			}
+		}
+
 		b.WriteString("\n\n")
 	}
 
@@ -167,20 +190,51 @@ func (s *ProjectScreen) applyValues() {
 	if s.config == nil {
 		return
 	}
+	s.applyTo(s.config)
+}
+
+// applyTo copies the field values into cfg.
+func (s *ProjectScreen) applyTo(cfg *config.ProjectConfig) {
 	for _, f := range s.fields {
 		switch f.key {
 		case "name":
-			s.config.Metadata.Name = f.value
+			cfg.Metadata.Name = f.value
 		case "description":
-			s.config.Metadata.Description = f.value
+			cfg.Metadata.Description = f.value
 		case "author":
-			s.config.Metadata.Author = f.value
+			cfg.Metadata.Author = f.value
 		case "license":
-			s.config.Metadata.License = f.value
+			cfg.Metadata.License = f.value
 		}
 	}
 }
 
+// LoadFromConfig sets the field values from the config, so a resumed wizard
+// shows the answers given before.
+func (s *ProjectScreen) LoadFromConfig() {
+	if s.config == nil {
+		return
+	}
+
+	for i, f := range s.fields {
+		switch f.key {
+		case "name":
+			s.fields[i].value = s.config.Metadata.Name
+		case "description":
+			s.fields[i].value = s.config.Metadata.Description
+		case "author":
+			s.fields[i].value = s.config.Metadata.Author
+		case "license":
+			s.fields[i].value = s.config.Metadata.License
+		}
+	}
+}
+
+// Validate checks the project metadata entered so far.
+func (s *ProjectScreen) Validate() config.ValidationErrors {
+	return s.validateWith("metadata", s.applyTo)
+}
+
 // isValidProjectName validates a project name.
 func isValidProjectName(name string) bool {
 	if len(name) == 0 || len(name) > 100 {
@@ -10,11 +10,21 @@ import (
 	"github.com/clause-cli/clause/pkg/tui"
 )
 
+// compactSummaryHeight is the terminal height below which summary sections
+// start collapsed.
+const compactSummaryHeight = 40
+
 // SummaryScreen shows a summary of the configuration.
 type SummaryScreen struct {
 	BaseScreen
 	confirmed bool
 	cursor    int
+
+	// section is the focused summary section, or -1 when an action is focused
+	section int
+
+	// expanded records sections the user expanded or collapsed
+	expanded map[string]bool
 }
 
 // NewSummaryScreen creates a new summary screen.
@@ -23,6 +33,8 @@ func NewSummaryScreen() *SummaryScreen {
 		BaseScreen: *NewBaseScreen("Summary", "summary"),
 		confirmed:  false,
 		cursor:     0,
+		section:    -1,
+		expanded:   make(map[string]bool),
 	}
 	s.complete = true
 	return s
@@ -37,17 +49,34 @@ func (s *SummaryScreen) Init() tea.Cmd {
 func (s *SummaryScreen) Update(msg tea.Msg) tea.Cmd {
 	switch m := msg.(type) {
 	case tea.KeyMsg:
+		sections := s.sections()
 		switch m.String() {
 		case "up", "k":
-			if s.cursor > 0 {
+			switch {
+			case s.section > 0:
+				s.section--
+			case s.section < 0 && s.cursor > 0:
 				s.cursor--
+			case s.section < 0 && len(sections) > 0:
+				s.section = len(sections) - 1
 			}
 		case "down", "j":
-			if s.cursor < 1 {
+			switch {
+			case s.section >= 0 && s.section < len(sections)-1:
+				s.section++
+			case s.section >= 0:
+				s.section = -1
+				s.cursor = 0
+			case s.cursor < 1:
 				s.cursor++
 			}
-		case "enter", " ":
-			if s.cursor == 0 {
+		case " ", "enter":
+			if s.section >= 0 && s.section < len(sections) {
+				title := sections[s.section].Title
+				s.expanded[title] = !s.isExpanded(title)
+				break
+			}
+			if m.String() == "enter" && s.cursor == 0 {
 				s.confirmed = true
 				// Apply all settings before finishing
 				s.applyAllSettings()
@@ -59,6 +88,22 @@ func (s *SummaryScreen) Update(msg tea.Msg) tea.Cmd {
 	return nil
 }
 
+// IsComplete reports whether Enter finishes the wizard. While a section
+// has focus, Enter toggles it instead.
+func (s *SummaryScreen) IsComplete() bool {
+	return s.section < 0
+}
+
+// HelpText returns the help shown in the help overlay.
+func (s *SummaryScreen) HelpText() string {
+	return `Review your choices before the project is created.
+
+  ↑/k, ↓/j     move between sections and actions
+  Space/Enter  expand or collapse the focused section
+  Enter        on the actions, confirm and create the project
+  Esc/Ctrl+P   go back and change a setting`
+}
+
 // View renders the screen.
 func (s *SummaryScreen) View() string {
 	var b strings.Builder
@@ -69,29 +114,15 @@ func (s *SummaryScreen) View() string {
 	b.WriteString(s.Renderer().Body("Review your project configuration before creating."))
 	b.WriteString("\n\n")
 
-	// Project info
-	b.WriteString(s.renderSection("Project", s.renderProjectSummary()))
-	b.WriteString("\n")
-
-	// Frontend
-	if s.Config() != nil && s.Config().Frontend.Enabled {
-		b.WriteString(s.renderSection("Frontend", s.renderFrontendSummary()))
-		b.WriteString("\n")
-	}
-
-	// Backend
-	if s.Config() != nil && s.Config().Backend.Enabled {
-		b.WriteString(s.renderSection("Backend", s.renderBackendSummary()))
-		b.WriteString("\n")
+	// Configuration sections, collapsible on compact terminals
+	sections := s.sections()
+	expanded := make(map[string]bool, len(sections))
+	for i := range sections {
+		sections[i].Focused = i == s.section
+		expanded[sections[i].Title] = s.isExpanded(sections[i].Title)
 	}
-
-	// Infrastructure
-	b.WriteString(s.renderSection("Infrastructure", s.renderInfrastructureSummary()))
-	b.WriteString("\n")
-
-	// Governance
-	b.WriteString(s.renderSection("AI Governance", s.renderGovernanceSummary()))
-	b.WriteString("\n")
+	b.WriteString(s.Renderer().Accordion(sections, expanded, s.Width()-4))
+	b.WriteString("\n\n")
 
 	// Confirmation
 	b.WriteString(s.Renderer().Divider(s.Width() - 4))
@@ -103,7 +134,7 @@ func (s *SummaryScreen) View() string {
 	}
 
 	for i, opt := range options {
-		if i == s.cursor {
+		if i == s.cursor && s.section < 0 {
 			b.WriteString(s.Renderer().ListItem("▸ "+opt, true))
 		} else {
 			b.WriteString(s.Renderer().ListItem("  "+opt, false))
@@ -115,14 +146,43 @@ func (s *SummaryScreen) View() string {
 
 	kb := tui.NewKeyBindings()
 	kb.Add("↑/↓", "Navigate")
+	kb.Add("Space", "Expand/collapse")
 	kb.Add("Enter", "Select")
 	b.WriteString(s.Renderer().HelpText(kb))
 
 	return b.String()
 }
 
-func (s *SummaryScreen) renderSection(title, content string) string {
-	return s.Renderer().Box(title, content, s.Width()-4)
+// sections returns the summary sections for the enabled parts of the
+// configuration.
+func (s *SummaryScreen) sections() []tui.Section {
+	sections := []tui.Section{{Title: "Project", Body: s.renderProjectSummary()}}
+
+	if s.Config() != nil && s.Config().Frontend.Enabled {
+		sections = append(sections, tui.Section{Title: "Frontend", Body: s.renderFrontendSummary()})
+	}
+	if s.Config() != nil && s.Config().Backend.Enabled {
+		sections = append(sections, tui.Section{Title: "Backend", Body: s.renderBackendSummary()})
+	}
+
+	return append(sections,
+		tui.Section{Title: "Infrastructure", Body: s.renderInfrastructureSummary()},
+		tui.Section{Title: "AI Governance", Body: s.renderGovernanceSummary()},
+	)
+}
+
+// isExpanded reports whether a section is expanded. Sections the user has
+// not toggled start collapsed on compact terminals.
+func (s *SummaryScreen) isExpanded(title string) bool {
+	if expanded, ok := s.expanded[title]; ok {
+		return expanded
+	}
+	return s.Height() == 0 || s.Height() >= compactSummaryHeight
+}
+
+// featureGrid renders features to fit inside a summary section.
+func (s *SummaryScreen) featureGrid(features map[string]bool) string {
+	return s.Renderer().FeatureGrid(features, s.Width()-12)
 }
 
 func (s *SummaryScreen) renderProjectSummary() string {
@@ -159,29 +219,14 @@ func (s *SummaryScreen) renderFrontendSummary() string {
 	items = append(items, fmt.Sprintf("TypeScript: %v", cfg.Frontend.TypeScript))
 	items = append(items, fmt.Sprintf("Styling: %s", cfg.Frontend.Styling))
 
-	var features []string
-	if cfg.Frontend.Features.SSR {
-		features = append(features, "SSR")
-	}
-	if cfg.Frontend.Features.SSG {
-		features = append(features, "SSG")
-	}
-	if cfg.Frontend.Features.PWA {
-		features = append(features, "PWA")
-	}
-	if cfg.Frontend.Features.I18n {
-		features = append(features, "i18n")
-	}
-	if cfg.Frontend.Features.DarkMode {
-		features = append(features, "Dark Mode")
-	}
-	if cfg.Frontend.Features.Storybook {
-		features = append(features, "Storybook")
-	}
-
-	if len(features) > 0 {
-		items = append(items, fmt.Sprintf("Features: %s", strings.Join(features, ", ")))
-	}
+	items = append(items, "Features:", s.featureGrid(map[string]bool{
+		"SSR":       cfg.Frontend.Features.SSR,
+		"SSG":       cfg.Frontend.Features.SSG,
+		"PWA":       cfg.Frontend.Features.PWA,
+		"i18n":      cfg.Frontend.Features.I18n,
+		"Dark Mode": cfg.Frontend.Features.DarkMode,
+		"Storybook": cfg.Frontend.Features.Storybook,
+	}))
 
 	return strings.Join(items, "\n")
 }
@@ -203,26 +248,15 @@ func (s *SummaryScreen) renderBackendSummary() string {
 		items = append(items, fmt.Sprintf("Auth: %s", cfg.Backend.Auth.Provider))
 	}
 
-	var features []string
-	if cfg.Backend.Features.WebSocket {
-		features = append(features, "WebSocket")
-	}
-	if cfg.Backend.Features.BackgroundJobs {
-		features = append(features, "Background Jobs")
-	}
-	if cfg.Backend.Features.FileUpload {
-		features = append(features, "File Upload")
-	}
-	if cfg.Backend.Features.Email {
-		features = append(features, "Email")
-	}
-	if cfg.Backend.Features.RateLimiting {
-		features = append(features, "Rate Limiting")
-	}
-
-	if len(features) > 0 {
-		items = append(items, fmt.Sprintf("Features: %s", strings.Join(features, ", ")))
-	}
+	items = append(items, "Features:", s.featureGrid(map[string]bool{
+		"WebSocket":       cfg.Backend.Features.WebSocket,
+		"Background Jobs": cfg.Backend.Features.BackgroundJobs,
+		"File Upload":     cfg.Backend.Features.FileUpload,
+		"Email":           cfg.Backend.Features.Email,
+		"Rate Limiting":   cfg.Backend.Features.RateLimiting,
+		"Logging":         cfg.Backend.Features.Logging,
+		"Metrics":         cfg.Backend.Features.Metrics,
+	}))
 
 	return strings.Join(items, "\n")
 }
@@ -0,0 +1,103 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clause-cli/clause/internal/config"
+	"github.com/clause-cli/clause/pkg/styles"
+)
+
+func TestSummaryScreenAccordion(t *testing.T) {
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	space := tea.KeyMsg{Type: tea.KeySpace}
+	up := tea.KeyMsg{Type: tea.KeyUp}
+	down := tea.KeyMsg{Type: tea.KeyDown}
+
+	tests := []struct {
+		name          string
+		height        int
+		keys          []tea.KeyMsg
+		want          []string
+		wantComplete  bool
+		wantConfirmed bool
+	}{
+		{
+			name:         "compact starts collapsed",
+			height:       20,
+			want:         []string{"▸ Project", "▸ AI Governance"},
+			wantComplete: true,
+		},
+		{
+			name:         "tall starts expanded",
+			height:       50,
+			want:         []string{"▾ Project", "▾ AI Governance"},
+			wantComplete: true,
+		},
+		{
+			name:   "enter expands the focused section",
+			height: 20,
+			keys:   []tea.KeyMsg{up, enter},
+			want:   []string{"▸ Project", "▾ AI Governance"},
+		},
+		{
+			name:   "space expands the focused section",
+			height: 20,
+			keys:   []tea.KeyMsg{up, space},
+			want:   []string{"▸ Project", "▾ AI Governance"},
+		},
+		{
+			name:   "enter again collapses it",
+			height: 20,
+			keys:   []tea.KeyMsg{up, enter, enter},
+			want:   []string{"▸ AI Governance"},
+		},
+		{
+			name:         "leaving the sections restores enter",
+			height:       20,
+			keys:         []tea.KeyMsg{up, enter, down},
+			want:         []string{"▾ AI Governance"},
+			wantComplete: true,
+		},
+		{
+			name:          "enter on the actions confirms",
+			height:        20,
+			keys:          []tea.KeyMsg{enter},
+			want:          []string{"▸ Project"},
+			wantComplete:  true,
+			wantConfirmed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadPreset("saas")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			s := NewSummaryScreen()
+			s.SetTheme(styles.GetTheme())
+			s.SetSize(100, tt.height)
+			s.SetConfig(cfg)
+
+			for _, key := range tt.keys {
+				s.Update(key)
+			}
+
+			view := s.View()
+			for _, want := range tt.want {
+				if !strings.Contains(view, want) {
+					t.Errorf("view does not contain %q:\n%s", want, view)
+				}
+			}
+			if s.IsComplete() != tt.wantComplete {
+				t.Errorf("IsComplete() = %v, want %v", s.IsComplete(), tt.wantComplete)
+			}
+			if s.confirmed != tt.wantConfirmed {
+				t.Errorf("confirmed = %v, want %v", s.confirmed, tt.wantConfirmed)
+			}
+		})
+	}
+}
@@ -26,7 +26,7 @@ func NewWelcomeScreen() *WelcomeScreen {
 	s.complete = true // Welcome screen is always complete
 
 	// Load available presets
-	s.presets = config.AvailablePresets
+	s.presets = config.Presets()
 
 	return s
 }
@@ -57,6 +57,15 @@ func (s *WelcomeScreen) Update(msg tea.Msg) tea.Cmd {
 	return nil
 }
 
+// HelpText returns the help shown in the help overlay.
+func (s *WelcomeScreen) HelpText() string {
+	return `Choose how to start your project.
+
+  ↑/k, ↓/j   move between options
+  Enter      select the highlighted option
+  Ctrl+C     quit the wizard`
+}
+
 // View renders the screen.
 func (s *WelcomeScreen) View() string {
 	var b strings.Builder
@@ -120,32 +129,37 @@ func (s *WelcomeScreen) CanGoBack() bool {
 // applyPreset applies the selected preset.
 func (s *WelcomeScreen) applyPreset() tea.Cmd {
 	return func() tea.Msg {
-		if s.config == nil {
-			return nil
-		}
+		s.loadPreset()
+		return nil
+	}
+}
 
-		var presetName string
-		switch s.cursor {
-		case 0:
-			presetName = "minimal"
-		case 1:
-			presetName = "standard"
-		default:
-			presetName = "" // Custom - use defaults
-		}
+// loadPreset copies the preset under the cursor into the config. The Custom
+// option keeps the config as it is.
+func (s *WelcomeScreen) loadPreset() {
+	if s.config == nil {
+		return
+	}
 
-		if presetName != "" {
-			// Load the preset configuration
-			if preset, err := config.LoadPreset(presetName); err == nil {
-				s.config.Metadata = preset.Metadata
-				s.config.Frontend = preset.Frontend
-				s.config.Backend = preset.Backend
-				s.config.Infrastructure = preset.Infrastructure
-				s.config.Governance = preset.Governance
-			}
-		}
+	var presetName string
+	switch s.cursor {
+	case 0:
+		presetName = "minimal"
+	case 1:
+		presetName = "standard"
+	default:
+		presetName = "" // Custom - use defaults
+	}
 
-		return nil
+	if presetName != "" {
+		// Load the preset configuration
+		if preset, err := config.LoadPreset(presetName); err == nil {
+			s.config.Metadata = preset.Metadata
+			s.config.Frontend = preset.Frontend
+			s.config.Backend = preset.Backend
+			s.config.Infrastructure = preset.Infrastructure
+			s.config.Governance = preset.Governance
+		}
 	}
 }
 
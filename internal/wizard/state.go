@@ -0,0 +1,121 @@
+package wizard
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/clause-cli/clause/pkg/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// StateFileName is the name of the saved wizard state in the global
+// configuration directory.
+const StateFileName = "wizard-state.yaml"
+
+// StatePath returns the path of the saved wizard state,
+// ~/.clause/wizard-state.yaml.
+func StatePath() string {
+	return filepath.Join(utils.GetHomeDirectory(), ".clause", StateFileName)
+}
+
+// SaveState writes state to StatePath so a cancelled wizard can be resumed
+// by the next clause init.
+func SaveState(state *WizardState) error {
+	if state.SavedAt.IsZero() {
+		state.SavedAt = time.Now()
+	}
+
+	data, err := yaml.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to encode wizard state: %w", err)
+	}
+	if err := utils.AtomicWrite(StatePath(), data); err != nil {
+		return fmt.Errorf("failed to save wizard state: %w", err)
+	}
+	return nil
+}
+
+// LoadState reads the state saved by SaveState. It returns nil and no error
+// when there is no saved state.
+func LoadState() (*WizardState, error) {
+	data, err := os.ReadFile(StatePath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read wizard state: %w", err)
+	}
+
+	var state WizardState
+	if err := yaml.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse wizard state %s: %w", StatePath(), err)
+	}
+	if state.Config == nil {
+		return nil, fmt.Errorf("wizard state %s has no configuration", StatePath())
+	}
+	return &state, nil
+}
+
+// ClearState removes the saved state, if there is any.
+func ClearState() error {
+	if err := os.Remove(StatePath()); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove wizard state: %w", err)
+	}
+	return nil
+}
+
+// configApplier is implemented by screens that copy their selections into
+// the config on request rather than as they change.
+type configApplier interface {
+	ApplyToConfig()
+}
+
+// configLoader is implemented by screens that can show the selections
+// already in the config instead of their defaults.
+type configLoader interface {
+	LoadFromConfig()
+}
+
+// loadScreens sets the selections of every screen from the config, so the
+// screens of a resumed wizard, including those before the one it resumes
+// on, keep the saved answers when they are applied again.
+func (w *Wizard) loadScreens() {
+	for _, screen := range w.screenInstances {
+		if loader, ok := screen.(configLoader); ok {
+			loader.LoadFromConfig()
+		}
+	}
+}
+
+// State returns the wizard's progress: the current screen and the config
+// with the selections of the screens already passed applied to it.
+func (w *Wizard) State() *WizardState {
+	for _, screen := range w.screenInstances[:w.current] {
+		if applier, ok := screen.(configApplier); ok {
+			applier.ApplyToConfig()
+		}
+	}
+
+	return &WizardState{
+		CurrentScreen: w.current,
+		TotalScreens:  len(w.screenInstances),
+		Config:        w.config,
+		Preset:        w.preset,
+	}
+}
+
+// WithState resumes the wizard from a saved state, starting on the screen
+// the state was saved on.
+func WithState(state *WizardState) WizardOption {
+	return func(w *Wizard) {
+		if state == nil || state.Config == nil {
+			return
+		}
+		w.config = state.Config
+		w.preset = state.Preset
+		w.current = state.CurrentScreen
+		w.resumed = true
+	}
+}
@@ -2,18 +2,24 @@
 package wizard
 
 import (
+	"time"
+
 	"github.com/clause-cli/clause/internal/config"
 )
 
 // Screen is an alias to screens.Screen for convenience
 // Screen alias removed to avoid recursive type issues
 
-// WizardState represents the current state of the wizard.
+// WizardState represents the current state of the wizard. It is what
+// SaveState writes when the wizard is cancelled partway.
 type WizardState struct {
-	CurrentScreen int
-	TotalScreens  int
-	Config        *config.ProjectConfig
-	Preset        string
+	CurrentScreen int                   `yaml:"current_screen"`
+	TotalScreens  int                   `yaml:"total_screens"`
+	Config        *config.ProjectConfig `yaml:"config"`
+	Preset        string                `yaml:"preset,omitempty"`
+
+	// SavedAt is when the state was saved
+	SavedAt time.Time `yaml:"saved_at"`
 }
 
 // Messages for wizard events
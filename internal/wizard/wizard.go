@@ -26,11 +26,18 @@ type Wizard struct {
 	height          int
 	quitting        bool
 	finished        bool
+	resumed         bool
 	err             error
 
+	// help provides the "?" help overlay
+	help tui.BaseModel
+
 	// Animation
-	fadeIn    bool
-	fadeAlpha float64
+	fadeIn          bool
+	fadeAlpha       float64
+	transition      *tui.Transition
+	transitionStyle tui.TransitionStyle
+	reduceMotion    bool
 }
 
 // WizardOption is a functional option for configuring the wizard.
@@ -76,16 +83,33 @@ func WithConfig(cfg *config.ProjectConfig) WizardOption {
 	}
 }
 
+// WithTransition sets the animation used when moving between screens.
+// Transitions are skipped when the terminal prefers reduced motion.
+func WithTransition(style tui.TransitionStyle) WizardOption {
+	return func(w *Wizard) {
+		w.transitionStyle = style
+	}
+}
+
+// WithReduceMotion disables screen transitions when set. Passing false
+// restores the transition chosen with WithTransition.
+func WithReduceMotion(reduce bool) WizardOption {
+	return func(w *Wizard) {
+		w.reduceMotion = reduce
+	}
+}
+
 // New creates a new wizard.
 func New(opts ...WizardOption) *Wizard {
 	theme := styles.GetTheme()
 
 	w := &Wizard{
-		config:   config.NewProjectConfig(),
-		theme:    theme,
-		renderer: tui.NewRenderer(theme, 80, 24),
-		current:  0,
-		fadeIn:   true,
+		config:          config.NewProjectConfig(),
+		theme:           theme,
+		renderer:        tui.NewRenderer(theme, 80, 24),
+		current:         0,
+		fadeIn:          true,
+		transitionStyle: tui.TransitionSlide,
 	}
 
 	// Apply options
@@ -93,8 +117,23 @@ func New(opts ...WizardOption) *Wizard {
 		opt(w)
 	}
 
+	if w.reduceMotion {
+		w.transition = &tui.Transition{Style: tui.TransitionNone}
+	} else {
+		w.transition = tui.NewTransition(w.transitionStyle)
+	}
+
+	w.help = tui.NewBaseModel()
+	w.help.SetTheme(w.theme)
+
 	// Add screens in order
 	w.addScreens()
+	if w.resumed {
+		w.loadScreens()
+	}
+
+	// A resumed state may come from a version with more screens
+	w.current = min(max(w.current, 0), len(w.screenInstances)-1)
 
 	return w
 }
@@ -162,22 +201,49 @@ func (w *Wizard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	}
 
 	switch m := msg.(type) {
+	case tui.FrameMsg:
+		if w.transition.Active() {
+			return w, w.transition.Update(m)
+		}
+
 	case tea.WindowSizeMsg:
 		w.width = m.Width
 		w.height = m.Height
+		w.transition.Width = m.Width
+		w.help.SetSize(m.Width, m.Height)
 		w.renderer.SetSize(m.Width, m.Height)
 		for _, screen := range w.screenInstances {
 			screen.SetSize(m.Width, m.Height)
 		}
 
 	case tea.KeyMsg:
-		// Handle global keys
+		// Any key press skips a running transition
+		if w.transition.Active() {
+			w.transition.Stop()
+		}
+
+		// The help overlay swallows keys while open. While a text field
+		// has focus, ? is typed into it rather than opening the overlay.
+		if m.Type != tea.KeyCtrlC && w.currentHelp() != "" &&
+			(w.help.HelpVisible() || !w.takesTextInput()) && w.help.HandleHelpKey(m) {
+			return w, nil
+		}
+
+		// Handle global keys: Esc goes back a screen, or cancels on the
+		// first one
 		switch m.Type {
-		case tea.KeyCtrlC, tea.KeyEsc:
+		case tea.KeyCtrlC:
+			w.quitting = true
+			return w, tea.Quit
+		case tea.KeyEsc:
 			if w.current == 0 {
 				w.quitting = true
 				return w, tea.Quit
 			}
+			if w.screenInstances[w.current].CanGoBack() {
+				return w, w.prevScreen()
+			}
+			return w, nil
 		}
 
 		// Handle navigation
@@ -233,10 +299,12 @@ func (w *Wizard) View() string {
 		return "No screens configured"
 	}
 
-	content := w.screenInstances[w.current].View()
+	// Render the interpolated frame while moving between screens
+	if w.transition.Active() {
+		return w.transition.View()
+	}
 
-	// Add progress indicator
-	content = w.addProgressIndicator(content)
+	content := w.help.RenderHelp(w.renderScreen(), w.currentHelp())
 
 	// Apply fade effect
 	if w.fadeIn && w.fadeAlpha < 1.0 {
@@ -246,6 +314,22 @@ func (w *Wizard) View() string {
 	return content
 }
 
+// renderScreen renders the current screen with its progress indicator.
+func (w *Wizard) renderScreen() string {
+	return w.addProgressIndicator(w.screenInstances[w.current].View())
+}
+
+// currentHelp returns the help text of the current screen, if it has any.
+func (w *Wizard) currentHelp() string {
+	if len(w.screenInstances) == 0 {
+		return ""
+	}
+	if provider, ok := w.screenInstances[w.current].(tui.HelpProvider); ok {
+		return provider.HelpText()
+	}
+	return ""
+}
+
 // viewQuit renders the quit message.
 func (w *Wizard) viewQuit() string {
 	return w.renderer.Info("Wizard cancelled. Run 'clause init' to start again.")
@@ -304,6 +388,7 @@ func (w *Wizard) addProgressIndicator(content string) string {
 	}
 
 	return tui.JoinVertical(
+		w.breadcrumb(),
 		screenName,
 		"",
 		content,
@@ -313,6 +398,25 @@ func (w *Wizard) addProgressIndicator(content string) string {
 	)
 }
 
+// breadcrumb renders the trail of screens, showing which are done, which is
+// current and which are still to come.
+func (w *Wizard) breadcrumb() string {
+	names := make([]string, len(w.screenInstances))
+	for i, screen := range w.screenInstances {
+		names[i] = screen.Name()
+	}
+	return w.renderer.Breadcrumb(names, w.current, w.width)
+}
+
+// takesTextInput reports whether the current screen takes typed text.
+func (w *Wizard) takesTextInput() bool {
+	if len(w.screenInstances) == 0 {
+		return false
+	}
+	input, ok := w.screenInstances[w.current].(screens.TextInputScreen)
+	return ok && input.TakesTextInput()
+}
+
 // handleNavigation handles navigation key presses.
 func (w *Wizard) handleNavigation(msg tea.KeyMsg) tea.Cmd {
 	if len(w.screenInstances) == 0 {
@@ -321,6 +425,14 @@ func (w *Wizard) handleNavigation(msg tea.KeyMsg) tea.Cmd {
 
 	currentScreen := w.screenInstances[w.current]
 
+	// Screens taking text keep Backspace and the letter keys for typing
+	if input, ok := currentScreen.(screens.TextInputScreen); ok && input.TakesTextInput() {
+		switch msg.String() {
+		case "backspace", "left", "h", "right", "l":
+			return nil
+		}
+	}
+
 	switch msg.String() {
 	case "enter", "right", "l":
 		if currentScreen.CanGoNext() && currentScreen.IsComplete() {
@@ -346,19 +458,28 @@ func (w *Wizard) handleNavigation(msg tea.KeyMsg) tea.Cmd {
 	return nil
 }
 
-// nextScreen moves to the next screen.
+// nextScreen moves to the next screen. It stays on a screen with
+// validation errors, which the screen shows next to the offending input,
+// and otherwise applies the screen's selections to the config.
 func (w *Wizard) nextScreen() tea.Cmd {
+	current := w.screenInstances[w.current]
+	if current.Validate().HasErrors() {
+		return nil
+	}
+	if applier, ok := current.(configApplier); ok {
+		applier.ApplyToConfig()
+	}
+
 	if w.current >= len(w.screenInstances)-1 {
 		// Last screen, finish
 		return tea.Quit
 	}
 
+	from := w.renderScreen()
 	w.current++
-	w.fadeIn = true
-	w.fadeAlpha = 0.0
 
 	return tea.Batch(
-		w.fadeInCmd(),
+		w.transition.Start(from, w.renderScreen()),
 		w.screenInstances[w.current].Init(),
 	)
 }
@@ -369,12 +490,11 @@ func (w *Wizard) prevScreen() tea.Cmd {
 		return nil
 	}
 
+	from := w.renderScreen()
 	w.current--
-	w.fadeIn = true
-	w.fadeAlpha = 0.0
 
 	return tea.Batch(
-		w.fadeInCmd(),
+		w.transition.Start(from, w.renderScreen()),
 		w.screenInstances[w.current].Init(),
 	)
 }
@@ -409,10 +529,14 @@ func (w *Wizard) getKeyBindings() tui.KeyBindings {
 		current := w.screenInstances[w.current]
 
 		if current.CanGoBack() {
-			kb.Add("←/h", "Back")
+			if input, ok := current.(screens.TextInputScreen); ok && input.TakesTextInput() {
+				kb.Add("Esc", "Back")
+			} else {
+				kb.Add("Esc/←", "Back")
+			}
 		}
 
-		if current.CanGoNext() && current.IsComplete() {
+		if current.CanGoNext() && current.IsComplete() && !current.Validate().HasErrors() {
 			if w.current == len(w.screenInstances)-1 {
 				kb.Add("Enter", "Finish")
 			} else {
@@ -421,7 +545,11 @@ func (w *Wizard) getKeyBindings() tui.KeyBindings {
 		}
 	}
 
-	kb.Add("Esc/Ctrl+C", "Cancel")
+	if w.current == 0 {
+		kb.Add("Esc/Ctrl+C", "Cancel")
+	} else {
+		kb.Add("Ctrl+C", "Cancel")
+	}
 
 	return kb
 }
@@ -0,0 +1,136 @@
+package wizard
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clause-cli/clause/pkg/tui"
+)
+
+func TestWizardTransitionOptions(t *testing.T) {
+	enabled := tui.NewTransition(tui.TransitionFade).Style
+
+	tests := []struct {
+		name string
+		opts []WizardOption
+		want tui.TransitionStyle
+	}{
+		{"chosen transition", []WizardOption{WithTransition(tui.TransitionFade)}, enabled},
+		{"reduce motion", []WizardOption{WithTransition(tui.TransitionFade), WithReduceMotion(true)}, tui.TransitionNone},
+		{"reduce motion first", []WizardOption{WithReduceMotion(true), WithTransition(tui.TransitionFade)}, tui.TransitionNone},
+		{"reduce motion undone", []WizardOption{WithTransition(tui.TransitionFade), WithReduceMotion(true), WithReduceMotion(false)}, enabled},
+		{"no transition", []WizardOption{WithTransition(tui.TransitionNone)}, tui.TransitionNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := New(tt.opts...)
+			if w.transition.Style != tt.want {
+				t.Errorf("transition style = %v, want %v", w.transition.Style, tt.want)
+			}
+		})
+	}
+}
+
+func TestWizardScreenSwap(t *testing.T) {
+	tests := []struct {
+		name       string
+		transition *tui.Transition
+		animated   bool
+	}{
+		{"immediate", &tui.Transition{Style: tui.TransitionNone}, false},
+		{"animated", &tui.Transition{Style: tui.TransitionSlide, Frames: 3, Width: 80}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := New()
+			w.fadeIn = false
+			w.transition = tt.transition
+			w.current = 1
+
+			w.prevScreen()
+			if w.current != 0 {
+				t.Fatalf("current screen = %d, want 0", w.current)
+			}
+			if got := w.transition.Active(); got != tt.animated {
+				t.Fatalf("transition active = %v, want %v", got, tt.animated)
+			}
+			if !tt.animated {
+				if w.View() != w.renderScreen() {
+					t.Error("view does not show the new screen immediately")
+				}
+				return
+			}
+
+			frames := 0
+			for w.transition.Active() {
+				if w.View() == w.renderScreen() {
+					t.Errorf("frame %d already shows the final screen", frames)
+				}
+				w.Update(tui.FrameMsg{})
+				frames++
+			}
+			if frames != tt.transition.Frames {
+				t.Errorf("rendered %d frames, want %d", frames, tt.transition.Frames)
+			}
+		})
+	}
+}
+
+func TestWizardHelpOverlay(t *testing.T) {
+	tests := []struct {
+		name     string
+		screen   int
+		helpText string
+	}{
+		{"frontend", 2, "Pick the frontend framework"},
+		{"backend", 3, "Pick the backend framework"},
+		{"infrastructure", 4, "Choose hosting, CI and container options"},
+		{"governance", 5, "Configure AI governance"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := New()
+			w.fadeIn = false
+			w.current = tt.screen
+
+			before := w.View()
+			if strings.Contains(before, tt.helpText) {
+				t.Fatalf("help text shown before ? was pressed")
+			}
+
+			w.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")})
+			if !w.help.HelpVisible() {
+				t.Fatal("help overlay not opened by ?")
+			}
+			if got := w.View(); !strings.Contains(got, tt.helpText) {
+				t.Errorf("help overlay does not show %q:\n%s", tt.helpText, got)
+			}
+
+			w.Update(tea.KeyMsg{Type: tea.KeyDown})
+			if w.help.HelpVisible() {
+				t.Fatal("help overlay not dismissed by a key press")
+			}
+			if got := w.View(); got != before {
+				t.Errorf("dismissing help changed the view:\n%s\nwant:\n%s", got, before)
+			}
+		})
+	}
+}
+
+func TestWizardHelpKeyInTextField(t *testing.T) {
+	w := New()
+	w.fadeIn = false
+	w.current = 1
+
+	w.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a?")})
+	if w.help.HelpVisible() {
+		t.Fatal("? opened help while a text field had focus")
+	}
+	if got := w.View(); !strings.Contains(got, "a?") {
+		t.Errorf("? was not typed into the field:\n%s", got)
+	}
+}
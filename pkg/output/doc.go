@@ -13,6 +13,16 @@
 //	printer.PrintBullet("Item one")
 //	printer.PrintCheckmark("Done")
 //
+// StartSpinner shows an ongoing operation and replaces it with a final
+// status. Nothing is animated when the output is not a terminal:
+//
+//	spinner := printer.StartSpinner("Installing dependencies")
+//	if err := install(); err != nil {
+//	    spinner.Fail("Install failed")
+//	} else {
+//	    spinner.Success("Dependencies installed")
+//	}
+//
 // # Logger
 //
 // Logger provides structured logging with levels:
@@ -36,15 +46,26 @@
 //
 //	columns := []output.TableColumn{
 //	    {Title: "Name", Width: 20},
-//	    {Title: "Status", Width: 10},
-//	    {Title: "Date", Width: 15},
+//	    {Title: "Status", Width: 10, Align: output.Center},
+//	    {Title: "Files", Width: 8, Align: output.Right},
 //	}
 //
 //	table := output.NewTable(columns)
-//	table.AddRow("Project A", "Active", "2024-01-15")
-//	table.AddRow("Project B", "Pending", "2024-01-16")
+//	table.AddRow("Project A", "Active", "1,204")
+//	table.AddRow("Project B", "Pending", "87")
+//	table.SortBy("Files", false)
 //	fmt.Println(table.Render())
 //
+// SortBy compares cells that are numbers numerically and other cells as
+// text. Align pads each cell with the typography padding helpers; Right
+// suits numeric columns.
+//
+// RenderCSV and RenderMarkdown export the same rows for spreadsheets and
+// documentation:
+//
+//	os.WriteFile("projects.csv", []byte(table.RenderCSV()), 0644)
+//	fmt.Print(table.RenderMarkdown())
+//
 // # Convenience Functions
 //
 // Package-level functions are available for quick access:
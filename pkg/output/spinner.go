@@ -0,0 +1,84 @@
+package output
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/clause-cli/clause/pkg/styles"
+	"github.com/clause-cli/clause/pkg/utils"
+)
+
+// SpinnerHandle controls a spinner started with Printer.StartSpinner.
+type SpinnerHandle struct {
+	printer *Printer
+	stop    chan struct{}
+	done    chan struct{}
+	once    sync.Once
+}
+
+// StartSpinner shows message next to a spinner on the current line until
+// the returned handle is stopped. When the printer does not write to a
+// terminal nothing is animated; Success and Fail still print their status.
+func (p *Printer) StartSpinner(message string) *SpinnerHandle {
+	h := &SpinnerHandle{printer: p}
+	if !p.isTerminal() {
+		return h
+	}
+
+	h.stop = make(chan struct{})
+	h.done = make(chan struct{})
+	go h.run(message)
+	return h
+}
+
+// run draws a frame every styles.SpinnerInterval until the spinner stops.
+func (h *SpinnerHandle) run(message string) {
+	defer close(h.done)
+
+	ticker := time.NewTicker(styles.SpinnerInterval)
+	defer ticker.Stop()
+
+	for i := 0; ; i++ {
+		h.printer.Spinner(styles.SpinnerFrames[i%len(styles.SpinnerFrames)], message)
+		select {
+		case <-h.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop stops the spinner and clears its line. It is safe to call more than
+// once.
+func (h *SpinnerHandle) Stop() {
+	h.once.Do(func() {
+		if h.stop == nil {
+			return
+		}
+		close(h.stop)
+		<-h.done
+		h.printer.ClearLine()
+	})
+}
+
+// Success stops the spinner and prints msg as a success.
+func (h *SpinnerHandle) Success(msg string) {
+	h.Stop()
+	h.printer.PrintSuccess("%s", msg)
+}
+
+// Fail stops the spinner and prints msg as an error.
+func (h *SpinnerHandle) Fail(msg string) {
+	h.Stop()
+	h.printer.PrintError("%s", msg)
+}
+
+// isTerminal reports whether the printer writes to a terminal.
+func (p *Printer) isTerminal() bool {
+	if p.writer == os.Stdout {
+		return utils.IsTerminal()
+	}
+	f, ok := p.writer.(*os.File)
+	return ok && utils.IsTerminalFd(int(f.Fd()))
+}
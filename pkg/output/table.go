@@ -1,9 +1,12 @@
 package output
 
 import (
+	"encoding/csv"
 	"fmt"
 	"io"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
@@ -11,12 +14,57 @@ import (
 	"github.com/clause-cli/clause/pkg/utils"
 )
 
+// Align is the horizontal alignment of the cells in a table column.
+type Align int
+
+// Column alignments.
+const (
+	// Left aligns cells to the left (the default)
+	Left Align = iota
+
+	// Right aligns cells to the right, which suits numeric columns
+	Right
+
+	// Center centers cells in the column
+	Center
+)
+
 // TableColumn represents a table column.
 type TableColumn struct {
-	Title     string
-	Width     int
+	Title    string
+	Width    int
+	Align    Align
+	MaxWidth int
+
+	// Deprecated: Alignment is used only when Align is Left; use Align.
 	Alignment lipgloss.Position
-	MaxWidth  int
+}
+
+// align returns the alignment of the column's cells.
+func (c TableColumn) align() Align {
+	if c.Align != Left {
+		return c.Align
+	}
+	switch c.Alignment {
+	case lipgloss.Right:
+		return Right
+	case lipgloss.Center:
+		return Center
+	default:
+		return Left
+	}
+}
+
+// alignCell pads text to width according to align.
+func alignCell(text string, width int, align Align) string {
+	switch align {
+	case Right:
+		return styles.PadLeft(text, width)
+	case Center:
+		return styles.Center(text, width)
+	default:
+		return styles.PadRight(text, width)
+	}
 }
 
 // TableRow represents a table row.
@@ -144,6 +192,58 @@ func (t *Table) AddTitle(title string) *Table {
 	return t
 }
 
+// SortBy sorts the rows added so far by the column with the given title.
+// Cells that are all numbers, such as "1,024" or "3.5", are compared as
+// numbers and other cells as case-insensitive text. Title rows stay in
+// place and the rows under each title are sorted separately. An unknown
+// column leaves the rows unchanged.
+func (t *Table) SortBy(columnTitle string, ascending bool) *Table {
+	col := -1
+	for i, c := range t.columns {
+		if strings.EqualFold(c.Title, columnTitle) {
+			col = i
+			break
+		}
+	}
+	if col < 0 {
+		return t
+	}
+
+	start := 0
+	for i := 0; i <= len(t.rows); i++ {
+		if i < len(t.rows) && !t.rows[i].IsTitle {
+			continue
+		}
+		group := t.rows[start:i]
+		sort.SliceStable(group, func(a, b int) bool {
+			if ascending {
+				return lessCell(cellAt(group[a], col), cellAt(group[b], col))
+			}
+			return lessCell(cellAt(group[b], col), cellAt(group[a], col))
+		})
+		start = i + 1
+	}
+	return t
+}
+
+// cellAt returns the cell of row in column col, or "" if the row is short.
+func cellAt(row TableRow, col int) string {
+	if col < len(row.Cells) {
+		return row.Cells[col]
+	}
+	return ""
+}
+
+// lessCell reports whether cell a sorts before cell b.
+func lessCell(a, b string) bool {
+	x, errA := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(a), ",", ""), 64)
+	y, errB := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(b), ",", ""), 64)
+	if errA == nil && errB == nil {
+		return x < y
+	}
+	return strings.ToLower(a) < strings.ToLower(b)
+}
+
 // calculateWidths calculates column widths.
 func (t *Table) calculateWidths() []int {
 	widths := make([]int, len(t.columns))
@@ -227,6 +327,77 @@ func (t *Table) Render() string {
 	return strings.Join(lines, "\n")
 }
 
+// RenderCSV renders the header and rows as CSV. Fields containing commas,
+// quotes or newlines are quoted. A title row becomes a record with the title
+// as its only field.
+func (t *Table) RenderCSV() string {
+	var buf strings.Builder
+	w := csv.NewWriter(&buf)
+
+	if t.showHeader {
+		header := make([]string, len(t.columns))
+		for i, col := range t.columns {
+			header[i] = col.Title
+		}
+		w.Write(header)
+	}
+	for _, row := range t.rows {
+		w.Write(row.Cells)
+	}
+
+	w.Flush()
+	return buf.String()
+}
+
+// RenderMarkdown renders the table as a Markdown table. The separator row
+// carries the column alignments, pipes in cells are escaped and newlines
+// become <br>. A title row is written in bold in the first column.
+func (t *Table) RenderMarkdown() string {
+	header := make([]string, len(t.columns))
+	separator := make([]string, len(t.columns))
+	for i, col := range t.columns {
+		header[i] = markdownCell(col.Title)
+		switch col.align() {
+		case Right:
+			separator[i] = "---:"
+		case Center:
+			separator[i] = ":---:"
+		default:
+			separator[i] = "---"
+		}
+	}
+
+	lines := []string{markdownRow(header), markdownRow(separator)}
+	for _, row := range t.rows {
+		cells := make([]string, len(t.columns))
+		if row.IsTitle {
+			if title := cellAt(row, 0); title != "" && len(cells) > 0 {
+				cells[0] = "**" + markdownCell(title) + "**"
+			}
+		} else {
+			for i := range cells {
+				cells[i] = markdownCell(cellAt(row, i))
+			}
+		}
+		lines = append(lines, markdownRow(cells))
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// markdownCell escapes the characters that would break a Markdown table
+// cell.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r\n", "<br>")
+	return strings.ReplaceAll(s, "\n", "<br>")
+}
+
+// markdownRow joins cells into a Markdown table row.
+func markdownRow(cells []string) string {
+	return "| " + strings.Join(cells, " | ") + " |"
+}
+
 // renderBorder renders a border line.
 func (t *Table) renderBorder(kind string, widths []int) string {
 	var left, middle, right, horiz string
@@ -268,10 +439,10 @@ func (t *Table) renderBorder(kind string, widths []int) string {
 func (t *Table) renderHeader(widths []int) string {
 	cells := make([]string, len(t.columns))
 	for i, col := range t.columns {
+		// The cell width includes its padding, like the border segments
 		cell := t.style.CellStyle.
-			Width(widths[i]).
-			Align(col.Alignment).
-			Render(col.Title)
+			Width(widths[i] + 2).
+			Render(alignCell(col.Title, widths[i], col.align()))
 		cells[i] = t.style.HeaderStyle.Render(cell)
 	}
 
@@ -300,15 +471,14 @@ func (t *Table) renderRow(row TableRow, widths []int, rowIndex int) string {
 			content = utils.TruncateText(content, widths[i])
 		}
 
-		alignment := lipgloss.Left
+		align := Left
 		if i < len(t.columns) {
-			alignment = t.columns[i].Alignment
+			align = t.columns[i].align()
 		}
 
 		cells[i] = t.style.CellStyle.
-			Width(widths[i]).
-			Align(alignment).
-			Render(content)
+			Width(widths[i] + 2).
+			Render(alignCell(content, widths[i], align))
 	}
 
 	// Apply alternating row style
@@ -368,9 +538,9 @@ func SimpleTable(headers []string, rows [][]string) string {
 	columns := make([]TableColumn, len(headers))
 	for i, h := range headers {
 		columns[i] = TableColumn{
-			Title:     h,
-			Width:     len(h),
-			Alignment: lipgloss.Left,
+			Title: h,
+			Width: len(h),
+			Align: Left,
 		}
 	}
 
@@ -385,8 +555,8 @@ func SimpleTable(headers []string, rows [][]string) string {
 // KeyValueTable creates a key-value table.
 func KeyValueTable(pairs [][2]string, keyWidth int) string {
 	columns := []TableColumn{
-		{Title: "Key", Width: keyWidth, Alignment: lipgloss.Right},
-		{Title: "Value", Width: 40, Alignment: lipgloss.Left},
+		{Title: "Key", Width: keyWidth, Align: Right},
+		{Title: "Value", Width: 40, Align: Left},
 	}
 
 	table := NewTable(columns,
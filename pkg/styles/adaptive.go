@@ -0,0 +1,198 @@
+package styles
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+// AdaptiveColor is a color with a value for each theme mode.
+type AdaptiveColor struct {
+	// Dark is used by dark themes
+	Dark string
+
+	// Light is used by light themes
+	Light string
+}
+
+// Same returns an adaptive color that uses the same value in both modes.
+func Same(color string) AdaptiveColor {
+	return AdaptiveColor{Dark: color, Light: color}
+}
+
+// Resolve returns the color for the given theme mode.
+func (c AdaptiveColor) Resolve(mode ThemeMode) string {
+	if mode == ModeLight {
+		return c.Light
+	}
+	return c.Dark
+}
+
+// Lipgloss converts the color to a lipgloss.AdaptiveColor, which picks the
+// value from the terminal background instead of the theme mode.
+func (c AdaptiveColor) Lipgloss() lipgloss.AdaptiveColor {
+	return lipgloss.AdaptiveColor{Dark: c.Dark, Light: c.Light}
+}
+
+// AdaptivePalette defines every palette color once as a dark/light pair.
+type AdaptivePalette struct {
+	// Primary colors
+	Primary      AdaptiveColor
+	PrimaryDim   AdaptiveColor
+	PrimaryLight AdaptiveColor
+
+	// Background colors
+	Background      AdaptiveColor
+	BackgroundAlt   AdaptiveColor
+	BackgroundCard  AdaptiveColor
+	BackgroundHover AdaptiveColor
+
+	// Text colors
+	Text         AdaptiveColor
+	TextMuted    AdaptiveColor
+	TextDim      AdaptiveColor
+	TextInverted AdaptiveColor
+
+	// Semantic colors
+	Success AdaptiveColor
+	Warning AdaptiveColor
+	Error   AdaptiveColor
+	Info    AdaptiveColor
+
+	// Accent colors
+	Accent         AdaptiveColor
+	AccentAlt      AdaptiveColor
+	AccentTertiary AdaptiveColor
+
+	// Border colors
+	Border       AdaptiveColor
+	BorderMuted  AdaptiveColor
+	BorderAccent AdaptiveColor
+}
+
+// DefaultPalette is the Clause palette used by the dark and light themes.
+var DefaultPalette = AdaptivePalette{
+	Primary:         Same(PrimaryPurple),
+	PrimaryDim:      Same(PrimaryPurple),
+	PrimaryLight:    Same(PrimaryPurpleLight),
+	Background:      AdaptiveColor{Dark: BackgroundNavy, Light: "#FFFFFF"},
+	BackgroundAlt:   AdaptiveColor{Dark: BackgroundDarker, Light: "#F6F8FA"},
+	BackgroundCard:  AdaptiveColor{Dark: BackgroundCard, Light: "#F6F8FA"},
+	BackgroundHover: AdaptiveColor{Dark: BackgroundHover, Light: "#E8ECF0"},
+	Text:            AdaptiveColor{Dark: TextPrimary, Light: "#1F2328"},
+	TextMuted:       AdaptiveColor{Dark: TextSecondary, Light: "#656D76"},
+	TextDim:         AdaptiveColor{Dark: TextDim, Light: "#8C959F"},
+	TextInverted:    AdaptiveColor{Dark: BackgroundNavy, Light: "#FFFFFF"},
+	Success:         AdaptiveColor{Dark: SuccessGreen, Light: "#059669"},
+	Warning:         AdaptiveColor{Dark: WarningAmber, Light: "#D97706"},
+	Error:           AdaptiveColor{Dark: ErrorRed, Light: "#DC2626"},
+	Info:            AdaptiveColor{Dark: InfoBlue, Light: "#2563EB"},
+	Accent:          AdaptiveColor{Dark: AccentPurple, Light: "#7C3AED"},
+	AccentAlt:       AdaptiveColor{Dark: AccentCyan, Light: "#2563EB"},
+	AccentTertiary:  AdaptiveColor{Dark: AccentPink, Light: "#DB2777"},
+	Border:          AdaptiveColor{Dark: BorderDefault, Light: "#D0D7DE"},
+	BorderMuted:     AdaptiveColor{Dark: BorderMuted, Light: "#E8ECF0"},
+	BorderAccent:    AdaptiveColor{Dark: BorderAccent, Light: PrimaryPurple},
+}
+
+// HighContrastPalette is the palette of the high-contrast theme: white text
+// on black, with light accent and status colors that stay readable as text
+// and behind the black text of badges.
+var HighContrastPalette = AdaptivePalette{
+	Primary:         Same("#C4B5FD"),
+	PrimaryDim:      Same("#A78BFA"),
+	PrimaryLight:    Same("#E0D7FF"),
+	Background:      Same("#000000"),
+	BackgroundAlt:   Same("#000000"),
+	BackgroundCard:  Same("#0A0A0A"),
+	BackgroundHover: Same("#262626"),
+	Text:            Same("#FFFFFF"),
+	TextMuted:       Same("#E5E5E5"),
+	TextDim:         Same("#BDBDBD"),
+	TextInverted:    Same("#000000"),
+	Success:         Same("#5CFF8A"),
+	Warning:         Same("#FFE14D"),
+	Error:           Same("#FF7070"),
+	Info:            Same("#75BFFF"),
+	Accent:          Same("#C4B5FD"),
+	AccentAlt:       Same("#5CF2FF"),
+	AccentTertiary:  Same("#FF94DB"),
+	Border:          Same("#FFFFFF"),
+	BorderMuted:     Same("#BDBDBD"),
+	BorderAccent:    Same("#C4B5FD"),
+}
+
+// DeuteranopiaPalette is the palette of the colorblind-safe theme. It keeps
+// the dark background and uses the Okabe-Ito colors for status, with blue
+// for success and orange for errors instead of green and red.
+var DeuteranopiaPalette = AdaptivePalette{
+	Primary:         Same(PrimaryPurpleLight),
+	PrimaryDim:      Same(PrimaryPurple),
+	PrimaryLight:    Same("#C4B5FD"),
+	Background:      Same(BackgroundNavy),
+	BackgroundAlt:   Same(BackgroundDarker),
+	BackgroundCard:  Same(BackgroundCard),
+	BackgroundHover: Same(BackgroundHover),
+	Text:            Same(TextPrimary),
+	TextMuted:       Same(TextSecondary),
+	TextDim:         Same(TextDim),
+	TextInverted:    Same(BackgroundNavy),
+	Success:         Same("#56B4E9"),
+	Warning:         Same("#F0E442"),
+	Error:           Same("#E69F00"),
+	Info:            Same("#CC79A7"),
+	Accent:          Same(PrimaryPurpleLight),
+	AccentAlt:       Same("#56B4E9"),
+	AccentTertiary:  Same("#CC79A7"),
+	Border:          Same(BorderDefault),
+	BorderMuted:     Same(BorderMuted),
+	BorderAccent:    Same(PrimaryPurpleLight),
+}
+
+// builtinPalette returns the palette of the built-in theme for a mode.
+func builtinPalette(mode ThemeMode) AdaptivePalette {
+	switch mode {
+	case ModeHighContrast:
+		return HighContrastPalette
+	case ModeDeuteranopia:
+		return DeuteranopiaPalette
+	default:
+		return DefaultPalette
+	}
+}
+
+// Resolve returns the concrete palette for the given theme mode.
+func (p AdaptivePalette) Resolve(mode ThemeMode) ColorPalette {
+	return ColorPalette{
+		Primary:         p.Primary.Resolve(mode),
+		PrimaryDim:      p.PrimaryDim.Resolve(mode),
+		PrimaryLight:    p.PrimaryLight.Resolve(mode),
+		Background:      p.Background.Resolve(mode),
+		BackgroundAlt:   p.BackgroundAlt.Resolve(mode),
+		BackgroundCard:  p.BackgroundCard.Resolve(mode),
+		BackgroundHover: p.BackgroundHover.Resolve(mode),
+		Text:            p.Text.Resolve(mode),
+		TextMuted:       p.TextMuted.Resolve(mode),
+		TextDim:         p.TextDim.Resolve(mode),
+		TextInverted:    p.TextInverted.Resolve(mode),
+		Success:         p.Success.Resolve(mode),
+		Warning:         p.Warning.Resolve(mode),
+		Error:           p.Error.Resolve(mode),
+		Info:            p.Info.Resolve(mode),
+		Accent:          p.Accent.Resolve(mode),
+		AccentAlt:       p.AccentAlt.Resolve(mode),
+		AccentTertiary:  p.AccentTertiary.Resolve(mode),
+		Border:          p.Border.Resolve(mode),
+		BorderMuted:     p.BorderMuted.Resolve(mode),
+		BorderAccent:    p.BorderAccent.Resolve(mode),
+	}
+}
+
+// NewThemeFromPalette creates a theme for the given mode from an adaptive palette.
+func NewThemeFromPalette(mode ThemeMode, palette AdaptivePalette) *Theme {
+	t := &Theme{
+		Mode:   mode,
+		Colors: palette.Resolve(mode),
+	}
+
+	t.initStyles()
+	return t
+}
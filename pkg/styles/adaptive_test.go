@@ -0,0 +1,65 @@
+package styles
+
+import "testing"
+
+func TestAdaptiveColorResolve(t *testing.T) {
+	color := AdaptiveColor{Dark: "#FFFFFF", Light: "#000000"}
+
+	tests := []struct {
+		name  string
+		color AdaptiveColor
+		mode  ThemeMode
+		want  string
+	}{
+		{"dark", color, ModeDark, "#FFFFFF"},
+		{"light", color, ModeLight, "#000000"},
+		{"high contrast is dark", color, ModeHighContrast, "#FFFFFF"},
+		{"deuteranopia is dark", color, ModeDeuteranopia, "#FFFFFF"},
+		{"same in dark", Same("#FF6B35"), ModeDark, "#FF6B35"},
+		{"same in light", Same("#FF6B35"), ModeLight, "#FF6B35"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.color.Resolve(tt.mode); got != tt.want {
+				t.Errorf("Resolve(%v) = %q, want %q", tt.mode, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewThemeFromPalette(t *testing.T) {
+	tests := []struct {
+		name string
+		mode ThemeMode
+		pick func(AdaptiveColor) string
+	}{
+		{"dark", ModeDark, func(c AdaptiveColor) string { return c.Dark }},
+		{"light", ModeLight, func(c AdaptiveColor) string { return c.Light }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			theme := NewThemeFromPalette(tt.mode, DefaultPalette)
+			if theme.Mode != tt.mode {
+				t.Errorf("theme mode = %v, want %v", theme.Mode, tt.mode)
+			}
+
+			colors := map[string][2]string{
+				"primary":    {theme.Colors.Primary, tt.pick(DefaultPalette.Primary)},
+				"background": {theme.Colors.Background, tt.pick(DefaultPalette.Background)},
+				"text":       {theme.Colors.Text, tt.pick(DefaultPalette.Text)},
+				"border":     {theme.Colors.Border, tt.pick(DefaultPalette.Border)},
+			}
+			for name, c := range colors {
+				if c[0] != c[1] {
+					t.Errorf("%s = %q, want %q", name, c[0], c[1])
+				}
+			}
+		})
+	}
+
+	if DefaultPalette.Background.Dark == DefaultPalette.Background.Light {
+		t.Error("default palette uses the same background in both modes")
+	}
+}
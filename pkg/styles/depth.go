@@ -0,0 +1,232 @@
+package styles
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/clause-cli/clause/pkg/utils"
+)
+
+// Minimum contrast ratios against the background, as defined by WCAG.
+const (
+	// minTextContrast is required for body text.
+	minTextContrast = 4.5
+	// minAccentContrast is required for muted text and colored accents.
+	minAccentContrast = 3.0
+)
+
+// ansi16 holds the xterm values of the 16 basic ANSI colors, indexed by
+// color number.
+var ansi16 = []rgbColor{
+	{0, 0, 0}, {128, 0, 0}, {0, 128, 0}, {128, 128, 0},
+	{0, 0, 128}, {128, 0, 128}, {0, 128, 128}, {192, 192, 192},
+	{128, 128, 128}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+	{0, 0, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+// cubeLevels are the channel values of the 6x6x6 color cube in the 256-color
+// palette.
+var cubeLevels = []int{0, 95, 135, 175, 215, 255}
+
+// AdaptThemeToDepth returns a copy of the theme for a terminal with the
+// given color depth. On 16-color and 256-color terminals every hex color in
+// the palette is replaced with the nearest ANSI color. Text and accents are
+// limited to colors with enough contrast against the background, so they
+// stay readable where the nearest color would be too close to it.
+// True color and colorless terminals get the theme unchanged.
+func AdaptThemeToDepth(t *Theme, depth utils.ColorDepth) *Theme {
+	if t == nil || (depth != utils.ColorDepth16 && depth != utils.ColorDepth256) {
+		return t
+	}
+
+	nearest := func(color string) string {
+		return nearestANSI(color, depth)
+	}
+
+	c := t.Colors
+	adapted := &Theme{
+		Mode: t.Mode,
+		Colors: ColorPalette{
+			Primary:         nearest(c.Primary),
+			PrimaryDim:      nearest(c.PrimaryDim),
+			PrimaryLight:    nearest(c.PrimaryLight),
+			Background:      nearest(c.Background),
+			BackgroundAlt:   nearest(c.BackgroundAlt),
+			BackgroundCard:  nearest(c.BackgroundCard),
+			BackgroundHover: nearest(c.BackgroundHover),
+			Text:            nearest(c.Text),
+			TextMuted:       nearest(c.TextMuted),
+			TextDim:         nearest(c.TextDim),
+			TextInverted:    nearest(c.TextInverted),
+			Success:         nearest(c.Success),
+			Warning:         nearest(c.Warning),
+			Error:           nearest(c.Error),
+			Info:            nearest(c.Info),
+			Accent:          nearest(c.Accent),
+			AccentAlt:       nearest(c.AccentAlt),
+			AccentTertiary:  nearest(c.AccentTertiary),
+			Border:          nearest(c.Border),
+			BorderMuted:     nearest(c.BorderMuted),
+			BorderAccent:    nearest(c.BorderAccent),
+		},
+	}
+
+	// Pick the text and accent colors again, from the colors that stand out
+	// from the background
+	bg, ok := paletteRGB(adapted.Colors.Background, depth)
+	if ok {
+		p := &adapted.Colors
+		p.Text = nearestWithContrast(c.Text, bg, minTextContrast, depth)
+		p.Primary = nearestWithContrast(c.Primary, bg, minAccentContrast, depth)
+		p.PrimaryLight = nearestWithContrast(c.PrimaryLight, bg, minAccentContrast, depth)
+		p.TextMuted = nearestWithContrast(c.TextMuted, bg, minAccentContrast, depth)
+		p.Success = nearestWithContrast(c.Success, bg, minAccentContrast, depth)
+		p.Warning = nearestWithContrast(c.Warning, bg, minAccentContrast, depth)
+		p.Error = nearestWithContrast(c.Error, bg, minAccentContrast, depth)
+		p.Info = nearestWithContrast(c.Info, bg, minAccentContrast, depth)
+		p.Accent = nearestWithContrast(c.Accent, bg, minAccentContrast, depth)
+		p.AccentAlt = nearestWithContrast(c.AccentAlt, bg, minAccentContrast, depth)
+		p.AccentTertiary = nearestWithContrast(c.AccentTertiary, bg, minAccentContrast, depth)
+	}
+
+	adapted.initStyles()
+	return adapted
+}
+
+// nearestANSI returns the number of the ANSI color closest to a hex color.
+// Colors that are not hex values are returned unchanged.
+func nearestANSI(color string, depth utils.ColorDepth) string {
+	return nearestMatching(color, depth, func(rgbColor) bool { return true })
+}
+
+// nearestWithContrast returns the number of the ANSI color closest to a hex
+// color that has at least minimum contrast against bg, or the closest color
+// when none has. On 16-color terminals the bright or normal version of the
+// closest color is tried first, which keeps its hue.
+func nearestWithContrast(color string, bg rgbColor, minimum float64, depth utils.ColorDepth) string {
+	nearest := nearestANSI(color, depth)
+	if n, err := strconv.Atoi(nearest); err == nil && depth == utils.ColorDepth16 {
+		if contrastRatio(ansiRGB(n), bg) >= minimum {
+			return nearest
+		}
+		if counterpart := n ^ 8; contrastRatio(ansiRGB(counterpart), bg) >= minimum {
+			return strconv.Itoa(counterpart)
+		}
+	}
+
+	if n := nearestMatching(color, depth, func(c rgbColor) bool {
+		return contrastRatio(c, bg) >= minimum
+	}); n != "" {
+		return n
+	}
+	return nearest
+}
+
+// nearestMatching returns the number of the ANSI color closest to a hex
+// color among the colors accepted by match, or "" when match accepts none.
+// Colors that are not hex values are returned unchanged.
+func nearestMatching(color string, depth utils.ColorDepth, match func(rgbColor) bool) string {
+	rgb, ok := hexRGB(color)
+	if !ok {
+		return color
+	}
+
+	best, bestDistance := -1, math.MaxInt
+	for n := ansiFirst(depth); n < ansiCount(depth); n++ {
+		c := ansiRGB(n)
+		if !match(c) {
+			continue
+		}
+		if d := colorDistance(rgb, c); d < bestDistance {
+			best, bestDistance = n, d
+		}
+	}
+	if best < 0 {
+		return ""
+	}
+	return strconv.Itoa(best)
+}
+
+// ansiFirst returns the first color number to choose from. The 256-color
+// palette skips the basic colors, whose values vary between terminals.
+func ansiFirst(depth utils.ColorDepth) int {
+	if depth == utils.ColorDepth256 {
+		return 16
+	}
+	return 0
+}
+
+// ansiCount returns the number of colors available at a color depth.
+func ansiCount(depth utils.ColorDepth) int {
+	if depth == utils.ColorDepth256 {
+		return 256
+	}
+	return 16
+}
+
+// ansiRGB returns the xterm value of a 256-color palette entry.
+func ansiRGB(n int) rgbColor {
+	switch {
+	case n < 16:
+		return ansi16[n]
+	case n < 232:
+		n -= 16
+		return rgbColor{cubeLevels[n/36], cubeLevels[n/6%6], cubeLevels[n%6]}
+	default:
+		level := 8 + (n-232)*10
+		return rgbColor{level, level, level}
+	}
+}
+
+// paletteRGB returns the value of a palette color, either a hex value or an
+// ANSI color number.
+func paletteRGB(color string, depth utils.ColorDepth) (rgbColor, bool) {
+	if rgb, ok := hexRGB(color); ok {
+		return rgb, true
+	}
+	n, err := strconv.Atoi(color)
+	if err != nil || n < 0 || n >= ansiCount(depth) {
+		return rgbColor{}, false
+	}
+	return ansiRGB(n), true
+}
+
+// hexRGB parses a "#RRGGBB" color.
+func hexRGB(color string) (rgbColor, bool) {
+	if !strings.HasPrefix(color, "#") || len(color) != 7 {
+		return rgbColor{}, false
+	}
+	if _, err := strconv.ParseUint(color[1:], 16, 32); err != nil {
+		return rgbColor{}, false
+	}
+	return parseHexColor(color), true
+}
+
+// colorDistance returns the squared distance between two colors, weighted
+// for how the eye perceives each channel.
+func colorDistance(a, b rgbColor) int {
+	dr, dg, db := a.r-b.r, a.g-b.g, a.b-b.b
+	return 3*dr*dr + 4*dg*dg + 2*db*db
+}
+
+// contrastRatio returns the WCAG contrast ratio of two colors, from 1 to 21.
+func contrastRatio(a, b rgbColor) float64 {
+	la, lb := luminance(a), luminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// luminance returns the WCAG relative luminance of a color.
+func luminance(c rgbColor) float64 {
+	channel := func(v int) float64 {
+		s := float64(v) / 255
+		if s <= 0.03928 {
+			return s / 12.92
+		}
+		return math.Pow((s+0.055)/1.055, 2.4)
+	}
+	return 0.2126*channel(c.r) + 0.7152*channel(c.g) + 0.0722*channel(c.b)
+}
@@ -67,6 +67,35 @@
 //	// Switch to light theme
 //	styles.SetThemeMode(styles.ModeLight)
 //
+// For accessibility, ModeHighContrast uses white text on black with light
+// status colors, and ModeDeuteranopia uses blue for success and orange for
+// errors instead of green and red. Their status colors also stay legible
+// behind the dark text of StatusBadge.
+//
+// The dark and light themes are resolved from DefaultPalette, which defines
+// each color once as an AdaptiveColor{Dark, Light} pair. Custom palettes can be
+// turned into themes the same way:
+//
+//	theme := styles.NewThemeFromPalette(styles.ModeLight, palette)
+//
+// On 16-color and 256-color terminals AdaptThemeToDepth replaces the hex
+// colors with the nearest ANSI colors that stay readable on the background.
+// GetTheme and SetThemeMode apply it for the detected color depth; a theme
+// passed to SetTheme is used as given:
+//
+//	theme := styles.AdaptThemeToDepth(styles.DefaultTheme, utils.ColorDepth256)
+//
+// LoadTheme reads a palette from a YAML or JSON file, with keys naming
+// ColorPalette fields, and SetThemeFromFile makes it the current theme. The
+// CLI loads the file given with --theme or CLAUSE_THEME:
+//
+//	# brand.yaml
+//	mode: dark
+//	primary: "#FF6600"
+//	primary_light: "#FF9A4D"
+//
+//	err := styles.SetThemeFromFile("brand.yaml")
+//
 // # Responsive Layout
 //
 // The layout system adapts to terminal size with three breakpoints:
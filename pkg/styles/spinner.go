@@ -0,0 +1,10 @@
+package styles
+
+import "time"
+
+// SpinnerFrames are the frames of the default "dots" spinner. They live here
+// so both the TUI spinner and the printer's line spinner can use them.
+var SpinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// SpinnerInterval is the delay between SpinnerFrames.
+const SpinnerInterval = 80 * time.Millisecond
@@ -2,6 +2,8 @@ package styles
 
 import (
 	"github.com/charmbracelet/lipgloss"
+
+	"github.com/clause-cli/clause/pkg/utils"
 )
 
 // ThemeMode represents the color scheme mode.
@@ -12,6 +14,10 @@ const (
 	ModeDark ThemeMode = iota
 	// ModeLight is a light theme variant.
 	ModeLight
+	// ModeHighContrast is a dark theme with maximum contrast.
+	ModeHighContrast
+	// ModeDeuteranopia is a dark theme that avoids red/green distinctions.
+	ModeDeuteranopia
 )
 
 // Theme contains all style definitions for the application.
@@ -159,73 +165,33 @@ var DefaultTheme = createDarkTheme()
 // LightTheme is a light theme variant.
 var LightTheme = createLightTheme()
 
+// HighContrastTheme is a high-contrast theme for low vision.
+var HighContrastTheme = createHighContrastTheme()
+
+// DeuteranopiaTheme is a colorblind-safe theme.
+var DeuteranopiaTheme = createDeuteranopiaTheme()
+
 // currentTheme holds the currently active theme.
 var currentTheme *Theme
 
 // createDarkTheme creates and returns the default dark theme.
 func createDarkTheme() *Theme {
-	t := &Theme{
-		Mode: ModeDark,
-		Colors: ColorPalette{
-			Primary:         PrimaryPurple,
-			PrimaryDim:      PrimaryPurple, // Using PrimaryPurple for consistency
-			PrimaryLight:    PrimaryPurpleLight,
-			Background:      BackgroundNavy,
-			BackgroundAlt:   BackgroundDarker,
-			BackgroundCard:  BackgroundCard,
-			BackgroundHover: BackgroundHover,
-			Text:            TextPrimary,
-			TextMuted:       TextSecondary,
-			TextDim:         TextDim,
-			TextInverted:    BackgroundNavy,
-			Success:         SuccessGreen,
-			Warning:         WarningAmber,
-			Error:           ErrorRed,
-			Info:            InfoBlue,
-			Accent:          AccentPurple,
-			AccentAlt:       AccentCyan,
-			AccentTertiary:  AccentPink,
-			Border:          BorderDefault,
-			BorderMuted:     BorderMuted,
-			BorderAccent:    BorderAccent,
-		},
-	}
-
-	t.initStyles()
-	return t
+	return NewThemeFromPalette(ModeDark, DefaultPalette)
 }
 
 // createLightTheme creates and returns a light theme.
 func createLightTheme() *Theme {
-	t := &Theme{
-		Mode: ModeLight,
-		Colors: ColorPalette{
-			Primary:         PrimaryPurple,
-			PrimaryDim:      PrimaryPurple,
-			PrimaryLight:    PrimaryPurpleLight,
-			Background:      "#FFFFFF",
-			BackgroundAlt:   "#F6F8FA",
-			BackgroundCard:  "#F6F8FA",
-			BackgroundHover: "#E8ECF0",
-			Text:            "#1F2328",
-			TextMuted:       "#656D76",
-			TextDim:         "#8C959F",
-			TextInverted:    "#FFFFFF",
-			Success:         "#059669",
-			Warning:         "#D97706",
-			Error:           "#DC2626",
-			Info:            "#2563EB",
-			Accent:          "#7C3AED",
-			AccentAlt:       "#2563EB",
-			AccentTertiary:  "#DB2777",
-			Border:          "#D0D7DE",
-			BorderMuted:     "#E8ECF0",
-			BorderAccent:    PrimaryPurple,
-		},
-	}
+	return NewThemeFromPalette(ModeLight, DefaultPalette)
+}
 
-	t.initStyles()
-	return t
+// createHighContrastTheme creates and returns the high-contrast theme.
+func createHighContrastTheme() *Theme {
+	return NewThemeFromPalette(ModeHighContrast, HighContrastPalette)
+}
+
+// createDeuteranopiaTheme creates and returns the colorblind-safe theme.
+func createDeuteranopiaTheme() *Theme {
+	return NewThemeFromPalette(ModeDeuteranopia, DeuteranopiaPalette)
 }
 
 // initStyles initializes all style definitions based on the color palette.
@@ -400,26 +366,32 @@ func (t *Theme) initStyles() {
 	}
 }
 
-// GetTheme returns the current theme, initializing it if necessary.
+// GetTheme returns the current theme, initializing it if necessary. The
+// default theme is adapted to the terminal's color depth.
 func GetTheme() *Theme {
 	if currentTheme == nil {
-		currentTheme = DefaultTheme
+		currentTheme = AdaptThemeToDepth(DefaultTheme, utils.DetectColorDepth())
 	}
 	return currentTheme
 }
 
-// SetTheme sets the current theme.
+// SetTheme sets the current theme. The theme is used as given, without
+// adapting it to the terminal's color depth.
 func SetTheme(theme *Theme) {
 	currentTheme = theme
 }
 
-// SetThemeMode sets the theme by mode.
+// SetThemeMode sets the theme by mode, adapted to the terminal's color depth.
 func SetThemeMode(mode ThemeMode) {
 	switch mode {
 	case ModeLight:
-		currentTheme = LightTheme
+		currentTheme = AdaptThemeToDepth(LightTheme, utils.DetectColorDepth())
+	case ModeHighContrast:
+		currentTheme = AdaptThemeToDepth(HighContrastTheme, utils.DetectColorDepth())
+	case ModeDeuteranopia:
+		currentTheme = AdaptThemeToDepth(DeuteranopiaTheme, utils.DetectColorDepth())
 	default:
-		currentTheme = DefaultTheme
+		currentTheme = AdaptThemeToDepth(DefaultTheme, utils.DetectColorDepth())
 	}
 }
 
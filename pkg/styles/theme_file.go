@@ -0,0 +1,140 @@
+package styles
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/clause-cli/clause/pkg/utils"
+)
+
+// ThemeEnv is the environment variable that points at a theme file.
+const ThemeEnv = "CLAUSE_THEME"
+
+// LoadTheme reads a theme from a YAML or JSON file. The file maps
+// ColorPalette fields to colors, written as the field name in any case or
+// in snake_case ("primary_dim" or "PrimaryDim"), and may set "mode" to
+// "dark", "light", "high-contrast" or "deuteranopia". Colors the file leaves
+// out come from the built-in theme for the mode. Unknown keys and invalid
+// colors are errors naming the offending key.
+func LoadTheme(path string) (*Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read theme: %w", err)
+	}
+
+	values := make(map[string]string)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.Unmarshal(data, &values)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &values)
+	default:
+		return nil, fmt.Errorf("unsupported theme file %s: use .yaml, .yml or .json", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse theme %s: %w", path, err)
+	}
+
+	mode := ModeDark
+	for key, value := range values {
+		if themeKey(key) != "mode" {
+			continue
+		}
+		switch strings.ToLower(value) {
+		case "dark":
+		case "light":
+			mode = ModeLight
+		case "high-contrast", "high_contrast", "highcontrast":
+			mode = ModeHighContrast
+		case "deuteranopia":
+			mode = ModeDeuteranopia
+		default:
+			return nil, fmt.Errorf("invalid theme mode %q in %s: use dark, light, high-contrast or deuteranopia", value, path)
+		}
+		delete(values, key)
+	}
+
+	palette := builtinPalette(mode).Resolve(mode)
+	fields := paletteFields(&palette)
+
+	// Sort the keys so the first bad key is reported consistently
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		field, ok := fields[themeKey(key)]
+		if !ok {
+			return nil, fmt.Errorf("unknown theme color %q in %s", key, path)
+		}
+		if !isThemeColor(values[key]) {
+			return nil, fmt.Errorf("invalid color %q for %q in %s: use #RRGGBB, #RGB or an ANSI color number", values[key], key, path)
+		}
+		field.SetString(expandHexColor(values[key]))
+	}
+
+	t := &Theme{Mode: mode, Colors: palette}
+	t.initStyles()
+	return t, nil
+}
+
+// SetThemeFromFile loads a theme with LoadTheme and makes it the current
+// theme, adapted to the terminal's color depth.
+func SetThemeFromFile(path string) error {
+	theme, err := LoadTheme(path)
+	if err != nil {
+		return err
+	}
+	SetTheme(AdaptThemeToDepth(theme, utils.DetectColorDepth()))
+	return nil
+}
+
+// paletteFields returns the settable string fields of a palette keyed by
+// their normalized names.
+func paletteFields(palette *ColorPalette) map[string]reflect.Value {
+	v := reflect.ValueOf(palette).Elem()
+	fields := make(map[string]reflect.Value, v.NumField())
+	for i := 0; i < v.NumField(); i++ {
+		fields[themeKey(v.Type().Field(i).Name)] = v.Field(i)
+	}
+	return fields
+}
+
+// themeKey normalizes a theme file key for matching against field names.
+func themeKey(key string) string {
+	key = strings.ToLower(key)
+	key = strings.ReplaceAll(key, "_", "")
+	return strings.ReplaceAll(key, "-", "")
+}
+
+// isThemeColor reports whether a value is a hex color or an ANSI color
+// number.
+func isThemeColor(value string) bool {
+	if n, err := strconv.Atoi(value); err == nil {
+		return n >= 0 && n <= 255
+	}
+	if !strings.HasPrefix(value, "#") || (len(value) != 4 && len(value) != 7) {
+		return false
+	}
+	_, err := strconv.ParseUint(value[1:], 16, 32)
+	return err == nil
+}
+
+// expandHexColor turns a "#RGB" color into "#RRGGBB" and returns other
+// colors unchanged.
+func expandHexColor(value string) string {
+	if len(value) != 4 || !strings.HasPrefix(value, "#") {
+		return value
+	}
+	return "#" + strings.Repeat(value[1:2], 2) + strings.Repeat(value[2:3], 2) + strings.Repeat(value[3:4], 2)
+}
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/clause-cli/clause/pkg/styles"
+	"github.com/clause-cli/clause/pkg/utils"
 )
 
 // AnimationFrame represents a single frame of an animation.
@@ -111,18 +113,7 @@ func (a *Animation) Reset() {
 
 // SpinnerStyles contains common spinner animations.
 var SpinnerStyles = map[string][]AnimationFrame{
-	"dots": {
-		{Content: "⠋", Delay: 80 * time.Millisecond},
-		{Content: "⠙", Delay: 80 * time.Millisecond},
-		{Content: "⠹", Delay: 80 * time.Millisecond},
-		{Content: "⠸", Delay: 80 * time.Millisecond},
-		{Content: "⠼", Delay: 80 * time.Millisecond},
-		{Content: "⠴", Delay: 80 * time.Millisecond},
-		{Content: "⠦", Delay: 80 * time.Millisecond},
-		{Content: "⠧", Delay: 80 * time.Millisecond},
-		{Content: "⠇", Delay: 80 * time.Millisecond},
-		{Content: "⠏", Delay: 80 * time.Millisecond},
-	},
+	"dots": spinnerFrames(styles.SpinnerFrames, styles.SpinnerInterval),
 	"line": {
 		{Content: "|", Delay: 100 * time.Millisecond},
 		{Content: "/", Delay: 100 * time.Millisecond},
@@ -154,6 +145,16 @@ var SpinnerStyles = map[string][]AnimationFrame{
 	},
 }
 
+// spinnerFrames returns an animation frame for each of frames, shown for
+// delay.
+func spinnerFrames(frames []string, delay time.Duration) []AnimationFrame {
+	result := make([]AnimationFrame, len(frames))
+	for i, frame := range frames {
+		result[i] = AnimationFrame{Content: frame, Delay: delay}
+	}
+	return result
+}
+
 // NewSpinner creates a new spinner animation.
 func NewSpinner(style string) *Animation {
 	frames, ok := SpinnerStyles[style]
@@ -451,3 +452,135 @@ func ApplyFade(content string, alpha float64) string {
 	}
 	return content
 }
+
+// TransitionStyle selects how one screen replaces another.
+type TransitionStyle int
+
+const (
+	// TransitionNone swaps screens immediately.
+	TransitionNone TransitionStyle = iota
+	// TransitionFade fades the incoming screen in.
+	TransitionFade
+	// TransitionSlide slides the incoming screen in from the right.
+	TransitionSlide
+)
+
+// DefaultTransitionFrames is the number of frames a transition lasts (~130ms at 60fps).
+const DefaultTransitionFrames = 8
+
+// Transition interpolates between two rendered screens, advancing one step
+// per FrameMsg. A transition with style TransitionNone, or one created while
+// reduce-motion is in effect, never becomes active.
+type Transition struct {
+	Style  TransitionStyle
+	Frames int
+	Width  int
+
+	from   string
+	to     string
+	frame  int
+	active bool
+}
+
+// NewTransition creates a transition with the given style. The style is
+// downgraded to TransitionNone when the terminal prefers reduced motion.
+func NewTransition(style TransitionStyle) *Transition {
+	if !utils.DetectCapabilities().Animations {
+		style = TransitionNone
+	}
+	return &Transition{
+		Style:  style,
+		Frames: DefaultTransitionFrames,
+	}
+}
+
+// Enabled returns whether the transition produces intermediate frames.
+func (t *Transition) Enabled() bool {
+	return t != nil && t.Style != TransitionNone && t.Frames > 0
+}
+
+// Start begins a transition from one rendered screen to another. It returns
+// the command driving the animation, or nil if the swap is immediate.
+func (t *Transition) Start(from, to string) tea.Cmd {
+	if !t.Enabled() {
+		return nil
+	}
+
+	t.from = from
+	t.to = to
+	t.frame = 0
+	t.active = true
+	return Frame()
+}
+
+// Update advances the transition on FrameMsg.
+func (t *Transition) Update(msg tea.Msg) tea.Cmd {
+	if t == nil || !t.active {
+		return nil
+	}
+	if _, ok := msg.(FrameMsg); !ok {
+		return nil
+	}
+
+	t.frame++
+	if t.frame >= t.Frames {
+		t.Stop()
+		return nil
+	}
+	return Frame()
+}
+
+// Stop ends the transition immediately.
+func (t *Transition) Stop() {
+	t.active = false
+	t.from = ""
+	t.to = ""
+}
+
+// Active returns whether a transition is in progress.
+func (t *Transition) Active() bool {
+	return t != nil && t.active
+}
+
+// Progress returns the eased progress of the transition between 0.0 and 1.0.
+func (t *Transition) Progress() float64 {
+	if t == nil || !t.active || t.Frames <= 0 {
+		return 1.0
+	}
+	linear := float64(t.frame) / float64(t.Frames)
+	// Ease out so the motion settles gently
+	return 1 - (1-linear)*(1-linear)
+}
+
+// View renders the interpolated frame for the current progress.
+func (t *Transition) View() string {
+	if !t.Active() {
+		return ""
+	}
+
+	progress := t.Progress()
+	switch t.Style {
+	case TransitionFade:
+		if progress < 0.5 {
+			return ApplyFade(t.from, 1-progress*2)
+		}
+		return ApplyFade(t.to, (progress-0.5)*2)
+	case TransitionSlide:
+		width := t.Width
+		if width <= 0 {
+			width = lipgloss.Width(t.to)
+		}
+		offset := int(float64(width) * (1 - progress))
+		if offset <= 0 {
+			return t.to
+		}
+		pad := strings.Repeat(" ", offset)
+		lines := strings.Split(t.to, "\n")
+		for i, line := range lines {
+			lines[i] = pad + line
+		}
+		return strings.Join(lines, "\n")
+	default:
+		return t.to
+	}
+}
@@ -0,0 +1,82 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTransition(t *testing.T) {
+	const from, to = "old screen", "new screen"
+
+	tests := []struct {
+		name   string
+		style  TransitionStyle
+		frames int
+		want   int // intermediate frames rendered before the swap completes
+	}{
+		{"none", TransitionNone, DefaultTransitionFrames, 0},
+		{"no frames", TransitionSlide, 0, 0},
+		{"slide", TransitionSlide, 4, 4},
+		{"fade", TransitionFade, DefaultTransitionFrames, DefaultTransitionFrames},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := &Transition{Style: tt.style, Frames: tt.frames, Width: 20}
+
+			cmd := tr.Start(from, to)
+			if tt.want == 0 {
+				if cmd != nil || tr.Active() || tr.View() != "" {
+					t.Fatalf("disabled transition started: active=%v view=%q", tr.Active(), tr.View())
+				}
+				return
+			}
+			if cmd == nil || !tr.Active() {
+				t.Fatal("enabled transition did not start")
+			}
+
+			if tr.View() == to {
+				t.Error("first frame already shows the final screen")
+			}
+
+			rendered := 0
+			for tr.Active() {
+				rendered++
+				if rendered > tt.frames {
+					t.Fatal("transition did not finish")
+				}
+				if cmd := tr.Update(FrameMsg{}); cmd == nil && tr.Active() {
+					t.Fatal("active transition stopped requesting frames")
+				}
+			}
+			if rendered != tt.want {
+				t.Errorf("rendered %d frames, want %d", rendered, tt.want)
+			}
+			if tr.Progress() != 1.0 {
+				t.Errorf("finished transition progress = %v, want 1", tr.Progress())
+			}
+		})
+	}
+}
+
+func TestTransitionSlideOffset(t *testing.T) {
+	tr := &Transition{Style: TransitionSlide, Frames: 4, Width: 8}
+	tr.Start("a", "b\nc")
+
+	lines := strings.Split(tr.View(), "\n")
+	for _, line := range lines {
+		if !strings.HasPrefix(line, strings.Repeat(" ", 8)) {
+			t.Errorf("first frame line %q is not offset by the full width", line)
+		}
+	}
+}
+
+func TestTransitionStop(t *testing.T) {
+	tr := &Transition{Style: TransitionFade, Frames: 4}
+	tr.Start("a", "b")
+	tr.Stop()
+
+	if tr.Active() || tr.Update(FrameMsg{}) != nil {
+		t.Error("stopped transition is still running")
+	}
+}
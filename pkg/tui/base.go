@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/clause-cli/clause/pkg/styles"
 )
 
@@ -78,6 +79,7 @@ type BaseModel struct {
 	focused    bool
 	theme      *styles.Theme
 	layout     *styles.Layout
+	showHelp   bool
 }
 
 // NewBaseModel creates a new base model.
@@ -153,6 +155,67 @@ func (b *BaseModel) HandleResize(msg tea.WindowSizeMsg) {
 	b.SetSize(msg.Width, msg.Height)
 }
 
+// HelpProvider is implemented by screens that offer contextual help.
+type HelpProvider interface {
+	// HelpText returns the help shown in the help overlay
+	HelpText() string
+}
+
+// HelpVisible returns whether the help overlay is shown.
+func (b *BaseModel) HelpVisible() bool {
+	return b.showHelp
+}
+
+// ToggleHelp shows or hides the help overlay.
+func (b *BaseModel) ToggleHelp() {
+	b.showHelp = !b.showHelp
+}
+
+// HandleHelpKey opens the help overlay on "?" and closes it on any key.
+// It returns true if the key was consumed and should not reach the screen.
+func (b *BaseModel) HandleHelpKey(msg tea.KeyMsg) bool {
+	if b.showHelp {
+		b.showHelp = false
+		return true
+	}
+	if msg.String() == "?" {
+		b.showHelp = true
+		return true
+	}
+	return false
+}
+
+// RenderHelp renders the help overlay over content while help is visible,
+// and returns content unchanged otherwise. The underlying view is not
+// modified, so dismissing the overlay restores it exactly.
+func (b *BaseModel) RenderHelp(content, help string) string {
+	if !b.showHelp || help == "" {
+		return content
+	}
+
+	theme := b.theme
+	if theme == nil {
+		theme = styles.GetTheme()
+	}
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color(theme.Colors.Primary)).
+		Padding(1, 2).
+		Render(theme.Typography.Header.Render("Help") + "\n\n" + help + "\n\n" +
+			theme.Typography.Muted.Render("Press any key to close"))
+
+	width, height := b.width, b.height
+	if width <= 0 {
+		width = lipgloss.Width(content)
+	}
+	if height <= 0 {
+		height = lipgloss.Height(content)
+	}
+
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, box)
+}
+
 // KeyBinding represents a keyboard shortcut.
 type KeyBinding struct {
 	Key         string
@@ -241,10 +304,13 @@ func SelectionKeyBindings() KeyBindings {
 	}
 }
 
-// FocusManager manages focus between multiple focusable elements.
+// FocusManager manages focus between multiple focusable elements. Moving
+// past the last or first element wraps around unless wrapping is turned
+// off with SetWrap.
 type FocusManager struct {
 	elements []string
 	current  int
+	noWrap   bool
 }
 
 // NewFocusManager creates a new focus manager.
@@ -255,6 +321,12 @@ func NewFocusManager(elements ...string) *FocusManager {
 	}
 }
 
+// SetWrap sets whether Next and Previous wrap around at the ends (the
+// default). Without wrapping, focus stays on the last or first element.
+func (fm *FocusManager) SetWrap(wrap bool) {
+	fm.noWrap = !wrap
+}
+
 // Current returns the currently focused element.
 func (fm *FocusManager) Current() string {
 	if len(fm.elements) == 0 {
@@ -273,23 +345,35 @@ func (fm *FocusManager) Next() string {
 	if len(fm.elements) == 0 {
 		return ""
 	}
+	if fm.noWrap && fm.current == len(fm.elements)-1 {
+		return fm.elements[fm.current]
+	}
 	fm.current = (fm.current + 1) % len(fm.elements)
 	return fm.elements[fm.current]
 }
 
-// Prev moves focus to the previous element.
-func (fm *FocusManager) Prev() string {
+// Previous moves focus to the previous element, as for shift+tab.
+func (fm *FocusManager) Previous() string {
 	if len(fm.elements) == 0 {
 		return ""
 	}
+	if fm.noWrap && fm.current == 0 {
+		return fm.elements[fm.current]
+	}
 	fm.current = (fm.current - 1 + len(fm.elements)) % len(fm.elements)
 	return fm.elements[fm.current]
 }
 
-// Set sets focus to a specific element.
-func (fm *FocusManager) Set(element string) bool {
+// Prev moves focus to the previous element. It is the same as Previous.
+func (fm *FocusManager) Prev() string {
+	return fm.Previous()
+}
+
+// Focus moves focus to the element with the given id and reports whether
+// it exists.
+func (fm *FocusManager) Focus(id string) bool {
 	for i, e := range fm.elements {
-		if e == element {
+		if e == id {
 			fm.current = i
 			return true
 		}
@@ -297,6 +381,11 @@ func (fm *FocusManager) Set(element string) bool {
 	return false
 }
 
+// Set sets focus to a specific element. It is the same as Focus.
+func (fm *FocusManager) Set(element string) bool {
+	return fm.Focus(element)
+}
+
 // SetIndex sets focus by index.
 func (fm *FocusManager) SetIndex(index int) {
 	if index >= 0 && index < len(fm.elements) {
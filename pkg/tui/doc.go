@@ -31,6 +31,15 @@
 //	    return m, nil
 //	}
 //
+// BaseModel also provides a "?" help overlay. Models that implement
+// HelpProvider route keys through HandleHelpKey and wrap their view:
+//
+//	if m.HandleHelpKey(keyMsg) {
+//	    return m, nil
+//	}
+//	...
+//	return m.RenderHelp(view, m.HelpText())
+//
 // # Responsive Design
 //
 // The Responsive type handles adaptive layouts:
@@ -58,6 +67,20 @@
 //
 //	spinner := tui.NewSpinner("dots")
 //
+// Transitions interpolate between two rendered screens on FrameMsg. They
+// are disabled automatically when CLAUSE_REDUCE_MOTION is set or the
+// terminal is not interactive:
+//
+//	transition := tui.NewTransition(tui.TransitionSlide)
+//	cmd := transition.Start(oldView, newView) // nil if the swap is immediate
+//
+// GenerationScreen shows live generation progress from GenerationEvents,
+// and quits when their channel is closed:
+//
+//	events := make(chan tui.GenerationEvent)
+//	go generate(events) // sends events, then closes the channel
+//	tea.NewProgram(tui.NewGenerationScreen(events)).Run()
+//
 // # Rendering
 //
 // Use the Renderer for consistent styling:
@@ -67,6 +90,29 @@
 //	content := renderer.Body("Configure your project")
 //	button := renderer.Button("Continue", true, false)
 //
+// FeatureGrid lays out boolean features as a responsive grid of checkmarks:
+//
+//	grid := renderer.FeatureGrid(map[string]bool{"SSR": true, "PWA": false}, width)
+//
+// ErrorPanel shows a failed command's error with a suggested next step;
+// validation errors also show their field path:
+//
+//	fmt.Println(renderer.ErrorPanel(err, "run clause config list to inspect the config"))
+//
+// Accordion renders collapsible sections for dense summaries; a body is
+// shown only while its title is expanded, and the model toggles the map:
+//
+//	view := renderer.Accordion(sections, map[string]bool{"Backend": true}, width)
+//
+// Viewport scrolls long text such as a markdown preview. It clips lines
+// to its width, keeps to its height and shows ScrollIndicator when the text
+// does not fit; Update handles the arrow, page and home/end keys:
+//
+//	vp := tui.NewViewport(width, height)
+//	vp.SetContent(preview)
+//	vp.Update(msg)
+//	view := vp.View()
+//
 // # Key Bindings
 //
 // Use KeyBinding for consistent keyboard handling:
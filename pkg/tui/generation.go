@@ -0,0 +1,221 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// GenerationEventType is the kind of a GenerationEvent.
+type GenerationEventType int
+
+const (
+	// GenerationPhaseStarted is sent when a generation phase begins
+	GenerationPhaseStarted GenerationEventType = iota
+
+	// GenerationFileWritten is sent after a file has been written
+	GenerationFileWritten
+
+	// GenerationPhaseDone is sent when a generation phase completes
+	GenerationPhaseDone
+
+	// GenerationError is sent when a phase fails
+	GenerationError
+)
+
+// GenerationEvent is a progress update shown by the GenerationScreen.
+type GenerationEvent struct {
+	// Type is the kind of event
+	Type GenerationEventType
+
+	// Phase is the phase the event belongs to
+	Phase string
+
+	// Path is the written file (GenerationFileWritten only)
+	Path string
+
+	// Err is the failure (GenerationError only)
+	Err error
+}
+
+// GenerationEventMsg delivers a generation event to the GenerationScreen.
+type GenerationEventMsg struct {
+	Event GenerationEvent
+}
+
+// GenerationDoneMsg is sent when the generator closes its event channel.
+type GenerationDoneMsg struct{}
+
+// waitForGenerationEvent returns a command that reads the next event.
+func waitForGenerationEvent(events <-chan GenerationEvent) tea.Cmd {
+	return func() tea.Msg {
+		event, ok := <-events
+		if !ok {
+			return GenerationDoneMsg{}
+		}
+		return GenerationEventMsg{Event: event}
+	}
+}
+
+// phaseStatus is the state of a generation phase.
+type phaseStatus int
+
+const (
+	phaseRunning phaseStatus = iota
+	phaseDone
+	phaseFailed
+)
+
+// generationPhase is a phase shown in the GenerationScreen.
+type generationPhase struct {
+	name   string
+	status phaseStatus
+	files  int
+}
+
+// GenerationScreen renders live generation progress from events:
+// a list of phases with a spinner on the running one and a file count.
+// It quits once the event channel is closed.
+type GenerationScreen struct {
+	BaseModel
+
+	events  <-chan GenerationEvent
+	phases  []*generationPhase
+	files   int
+	spinner *Animation
+	done    bool
+	err     error
+}
+
+// NewGenerationScreen creates a screen that consumes the given events. The
+// caller closes the channel when generation ends.
+func NewGenerationScreen(events <-chan GenerationEvent) *GenerationScreen {
+	return &GenerationScreen{
+		BaseModel: NewBaseModel(),
+		events:    events,
+		spinner:   NewSpinner("dots"),
+	}
+}
+
+// Init starts reading events and animating the spinner.
+func (s *GenerationScreen) Init() tea.Cmd {
+	return tea.Batch(waitForGenerationEvent(s.events), Tick(80*time.Millisecond))
+}
+
+// Update handles generation events, spinner ticks and resizes.
+func (s *GenerationScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch m := msg.(type) {
+	case tea.WindowSizeMsg:
+		s.HandleResize(m)
+
+	case tea.KeyMsg:
+		if m.Type == tea.KeyCtrlC {
+			return s, tea.Quit
+		}
+
+	case TickMsg:
+		if s.done {
+			return s, nil
+		}
+		s.spinner.Update(m.Time)
+		return s, Tick(80 * time.Millisecond)
+
+	case GenerationEventMsg:
+		s.handleEvent(m.Event)
+		return s, waitForGenerationEvent(s.events)
+
+	case GenerationDoneMsg:
+		s.done = true
+		return s, tea.Quit
+	}
+
+	return s, nil
+}
+
+// handleEvent applies an event to the phase list.
+func (s *GenerationScreen) handleEvent(event GenerationEvent) {
+	switch event.Type {
+	case GenerationPhaseStarted:
+		s.phases = append(s.phases, &generationPhase{name: event.Phase})
+	case GenerationFileWritten:
+		s.files++
+		if phase := s.findPhase(event.Phase); phase != nil {
+			phase.files++
+		}
+	case GenerationPhaseDone:
+		if phase := s.findPhase(event.Phase); phase != nil {
+			phase.status = phaseDone
+		}
+	case GenerationError:
+		s.err = event.Err
+		if phase := s.findPhase(event.Phase); phase != nil {
+			phase.status = phaseFailed
+		}
+	}
+}
+
+// findPhase returns the most recent phase with the given name.
+func (s *GenerationScreen) findPhase(name string) *generationPhase {
+	for i := len(s.phases) - 1; i >= 0; i-- {
+		if s.phases[i].name == name {
+			return s.phases[i]
+		}
+	}
+	return nil
+}
+
+// Done returns true once the event channel has been closed.
+func (s *GenerationScreen) Done() bool {
+	return s.done
+}
+
+// Err returns the generation error, if a phase failed.
+func (s *GenerationScreen) Err() error {
+	return s.err
+}
+
+// FilesWritten returns the number of files written so far.
+func (s *GenerationScreen) FilesWritten() int {
+	return s.files
+}
+
+// View renders the phase list and file count.
+func (s *GenerationScreen) View() string {
+	theme := s.Theme()
+	var b strings.Builder
+
+	b.WriteString(theme.Typography.Title.Render("Generating project"))
+	b.WriteString("\n\n")
+
+	for _, phase := range s.phases {
+		var icon string
+		switch phase.status {
+		case phaseRunning:
+			icon = theme.Component.Spinner.Render(s.spinner.Current())
+		case phaseDone:
+			icon = theme.Typography.Success.Render("✓")
+		case phaseFailed:
+			icon = theme.Typography.Error.Render("✗")
+		}
+
+		line := fmt.Sprintf("  %s %s", icon, phase.name)
+		if phase.files > 0 {
+			line += theme.Typography.Muted.Render(fmt.Sprintf(" (%d files)", phase.files))
+		}
+		b.WriteString(line + "\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(theme.Typography.Muted.Render(fmt.Sprintf("%d files written", s.files)))
+	b.WriteString("\n")
+
+	if s.err != nil {
+		b.WriteString("\n")
+		b.WriteString(theme.Typography.Error.Render("Error: " + s.err.Error()))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
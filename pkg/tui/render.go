@@ -1,10 +1,14 @@
 package tui
 
 import (
+	"errors"
 	"fmt"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/clause-cli/clause/internal/config"
 	"github.com/clause-cli/clause/pkg/styles"
 	"github.com/clause-cli/clause/pkg/utils"
 	"github.com/spf13/cobra"
@@ -213,6 +217,197 @@ func (r *Renderer) CheckmarkList(items []string, checked []bool) string {
 	return strings.Join(lines, "\n")
 }
 
+// FeatureGrid renders features in alphabetical order as a grid that fills
+// the given width. Enabled features get a success checkmark and disabled
+// ones a dimmed crossmark. Features run down each column before moving to
+// the next, and narrow widths fall back to a single column.
+func (r *Renderer) FeatureGrid(features map[string]bool, width int) string {
+	if len(features) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(features))
+	labelWidth := 0
+	for name := range features {
+		names = append(names, name)
+		if w := lipgloss.Width(name); w > labelWidth {
+			labelWidth = w
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return strings.ToLower(names[i]) < strings.ToLower(names[j])
+	})
+
+	// Each cell holds the mark, a space and the label, plus a gap
+	const gap = 3
+	cellWidth := labelWidth + 2 + gap
+	columns := 1
+	if width > 0 {
+		columns = (width + gap) / cellWidth
+	}
+	if columns < 1 {
+		columns = 1
+	}
+	if columns > len(names) {
+		columns = len(names)
+	}
+	rows := (len(names) + columns - 1) / columns
+	columns = (len(names) + rows - 1) / rows
+
+	typo := styles.NewTypography(r.theme)
+	muted := lipgloss.NewStyle().Foreground(lipgloss.Color(r.theme.Colors.TextMuted))
+	cell := lipgloss.NewStyle().Width(cellWidth)
+
+	lines := make([]string, rows)
+	for row := 0; row < rows; row++ {
+		var cells []string
+		for col := 0; col < columns; col++ {
+			i := col*rows + row
+			if i >= len(names) {
+				break
+			}
+
+			var rendered string
+			if features[names[i]] {
+				rendered = typo.Checkmark(names[i])
+			} else {
+				rendered = muted.Render("✗ " + names[i])
+			}
+			if col < columns-1 {
+				rendered = cell.Render(rendered)
+			}
+			cells = append(cells, rendered)
+		}
+		lines[row] = strings.Join(cells, "")
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Section is a titled block of content for Accordion.
+type Section struct {
+	// Title is shown in the section header and keys its expansion state
+	Title string
+
+	// Body is shown below the header while the section is expanded
+	Body string
+
+	// Focused highlights the header, for models that move between sections
+	Focused bool
+}
+
+// Accordion renders sections as collapsible blocks. Each header shows an
+// expand/collapse indicator, and a body is shown only when expanded[Title]
+// is true. Models toggle the map entries in response to key presses.
+func (r *Renderer) Accordion(sections []Section, expanded map[string]bool, width int) string {
+	var parts []string
+
+	for _, section := range sections {
+		indicator := "▸"
+		if expanded[section.Title] {
+			indicator = "▾"
+		}
+
+		headerStyle := r.theme.Component.ListItem.Bold(true)
+		if section.Focused {
+			headerStyle = r.theme.Component.ListItemSelected
+		}
+		if width > 0 {
+			headerStyle = headerStyle.Width(width)
+		}
+		parts = append(parts, headerStyle.Render(indicator+" "+section.Title))
+
+		if expanded[section.Title] && section.Body != "" {
+			bodyStyle := r.theme.Layout.Card
+			if width > 0 {
+				bodyStyle = bodyStyle.Width(width)
+			}
+			parts = append(parts, bodyStyle.Render(section.Body))
+		}
+	}
+
+	return strings.Join(parts, "\n")
+}
+
+// DiffChange is a changed value shown by DiffView. A nil Old is an addition
+// and a nil New a removal.
+type DiffChange struct {
+	Path string
+	Old  interface{}
+	New  interface{}
+}
+
+// DiffView renders changes, showing each path with its old value removed
+// and its new value added.
+func (r *Renderer) DiffView(changes []DiffChange) string {
+	if len(changes) == 0 {
+		return r.Muted("No differences")
+	}
+
+	pathStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(r.theme.Colors.Text))
+	removed := lipgloss.NewStyle().Foreground(lipgloss.Color(r.theme.Colors.Error))
+	added := lipgloss.NewStyle().Foreground(lipgloss.Color(r.theme.Colors.Success))
+
+	var lines []string
+	for _, change := range changes {
+		lines = append(lines, pathStyle.Render(change.Path))
+		if change.Old != nil {
+			lines = append(lines, removed.Render(fmt.Sprintf("  - %v", change.Old)))
+		}
+		if change.New != nil {
+			lines = append(lines, added.Render(fmt.Sprintf("  + %v", change.New)))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ErrorPanel renders an error in a bordered panel with an optional
+// suggestion. Errors with a FieldPath method, such as config validation
+// errors, also show the field path they refer to, and errors with a Line
+// method show the line. The panel is wrapped to the renderer width.
+func (r *Renderer) ErrorPanel(err error, suggestion string) string {
+	if err == nil {
+		return ""
+	}
+
+	width := r.width
+	if width <= 0 || width > 80 {
+		width = 80
+	}
+
+	errorColor := lipgloss.Color(r.theme.Colors.Error)
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(errorColor)
+
+	lines := []string{
+		titleStyle.Render("✗ Error"),
+		"",
+		r.Body(err.Error()),
+	}
+
+	var fieldErr interface{ FieldPath() string }
+	if errors.As(err, &fieldErr) && fieldErr.FieldPath() != "" {
+		lines = append(lines, r.Muted("Field: "+fieldErr.FieldPath()))
+	}
+
+	var lineErr interface{ Line() int }
+	if errors.As(err, &lineErr) && lineErr.Line() > 0 {
+		lines = append(lines, r.Muted(fmt.Sprintf("Line: %d", lineErr.Line())))
+	}
+
+	if suggestion != "" {
+		lines = append(lines, "", r.Info("→ "+suggestion))
+	}
+
+	// The border takes two columns outside the styled width
+	panel := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(errorColor).
+		Padding(0, 1).
+		Width(width - 2)
+
+	return panel.Render(strings.Join(lines, "\n"))
+}
+
 // NumberedList renders a numbered list.
 func (r *Renderer) NumberedList(items []string, startWidth int) string {
 	typo := styles.NewTypography(r.theme)
@@ -253,6 +448,35 @@ func (r *Renderer) PercentText(percent float64) string {
 	)
 }
 
+// Breadcrumb renders a trail of steps with those before current marked as
+// done and current highlighted. When the trail is wider than width, steps
+// other than current are shown by number only.
+func (r *Renderer) Breadcrumb(steps []string, current, width int) string {
+	trail := func(compact bool) string {
+		parts := make([]string, len(steps))
+		for i, step := range steps {
+			if compact && i != current {
+				step = fmt.Sprintf("%d", i+1)
+			}
+			switch {
+			case i < current:
+				parts[i] = r.theme.Typography.Success.Render("✓ " + step)
+			case i == current:
+				parts[i] = r.theme.Typography.Primary.Bold(true).Render("● " + step)
+			default:
+				parts[i] = r.theme.Typography.Muted.Render(step)
+			}
+		}
+		return strings.Join(parts, r.theme.Typography.Muted.Render(" › "))
+	}
+
+	full := trail(false)
+	if width <= 0 || lipgloss.Width(full) <= width {
+		return full
+	}
+	return trail(true)
+}
+
 // InputField renders an input field.
 func (r *Renderer) InputField(value, placeholder string, focused bool, width int) string {
 	var style lipgloss.Style
@@ -565,6 +789,114 @@ func (r *Renderer) WelcomeScreen(cmd *cobra.Command, version string) string {
 	return ui
 }
 
+// NextSteps renders a numbered post-generation checklist tailored to the
+// project's stack: entering the directory, installing dependencies, starting
+// the dev servers, configuring environment variables and reading the
+// governance files.
+func (r *Renderer) NextSteps(cfg *config.ProjectConfig, projectDir string) string {
+	var steps []string
+
+	command := func(title string, commands ...string) string {
+		lines := []string{r.Body(title)}
+		for _, c := range commands {
+			lines = append(lines, "  "+r.theme.Typography.Code.Render(c))
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	steps = append(steps, command("Navigate to your project:", "cd "+projectDir))
+
+	pm := cfg.Frontend.PackageManager
+	if pm == "" {
+		pm = "npm"
+	}
+	frontendDir := cfg.Frontend.Directory
+	backendDir := cfg.Backend.Directory
+
+	if cfg.Frontend.Enabled {
+		steps = append(steps, command("Install frontend dependencies:", inDir(frontendDir, pm+" install")))
+	}
+
+	if cfg.Backend.Enabled {
+		if install := backendInstallCommand(cfg.Backend.Language); install != "" {
+			steps = append(steps, command("Install backend dependencies:", inDir(backendDir, install)))
+		}
+
+		env := filepath.Join(backendDir, ".env")
+		steps = append(steps, command("Configure environment variables:", fmt.Sprintf("cp %s.example %s", env, env)))
+	}
+
+	var run []string
+	if cfg.Frontend.Enabled {
+		run = append(run, inDir(frontendDir, pm+" run dev"))
+	}
+	if cfg.Backend.Enabled {
+		if dev := backendDevCommand(cfg.Backend.Framework, cfg.Backend.Language); dev != "" {
+			run = append(run, inDir(backendDir, dev))
+		}
+	}
+	if len(run) > 0 {
+		steps = append(steps, command("Start the development servers:", run...))
+	}
+
+	if cfg.Governance.Enabled {
+		steps = append(steps, command("Review the AI governance files:",
+			".clause/context.yaml",
+			".clause/prompt-guidelines.md",
+		))
+	}
+
+	return r.NumberedList(steps, 3)
+}
+
+// inDir prefixes a command with a cd into dir when dir is not the project root.
+func inDir(dir, command string) string {
+	if dir == "" || dir == "." {
+		return command
+	}
+	return fmt.Sprintf("cd %s && %s", dir, command)
+}
+
+// backendInstallCommand returns the dependency install command for a backend language.
+func backendInstallCommand(language string) string {
+	switch language {
+	case "python":
+		return "pip install -r requirements.txt"
+	case "node", "typescript":
+		return "npm install"
+	case "go":
+		return "go mod download"
+	case "rust":
+		return "cargo fetch"
+	default:
+		return ""
+	}
+}
+
+// backendDevCommand returns the command that starts the backend in
+// development, using the framework's own server where it has one.
+func backendDevCommand(framework, language string) string {
+	switch framework {
+	case "fastapi":
+		return "uvicorn main:app --reload"
+	case "django":
+		return "python manage.py runserver"
+	}
+
+	switch language {
+	case "python":
+		return "python main.py"
+	case "node", "typescript":
+		return "npm run dev"
+	case "go":
+		return "go run ."
+	case "rust":
+		return "cargo run"
+	default:
+		return ""
+	}
+}
+
 // Center centers content in available space.
 func (r *Renderer) Center(content string, width, height int) string {
 	return lipgloss.NewStyle().
@@ -0,0 +1,271 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/clause-cli/clause/internal/config"
+	"github.com/clause-cli/clause/pkg/styles"
+)
+
+// fieldError is an error that names the config field it refers to.
+type fieldError struct {
+	field, msg string
+}
+
+func (e fieldError) Error() string     { return e.field + ": " + e.msg }
+func (e fieldError) FieldPath() string { return e.field }
+
+// parseError is an error that names the field and line it was found at.
+type parseError struct {
+	field string
+	line  int
+	msg   string
+}
+
+func (e *parseError) Error() string     { return e.msg }
+func (e *parseError) FieldPath() string { return e.field }
+func (e *parseError) Line() int         { return e.line }
+
+func TestErrorPanel(t *testing.T) {
+	long := errors.New(strings.Repeat("the configuration could not be loaded ", 6))
+
+	tests := []struct {
+		name       string
+		err        error
+		suggestion string
+		width      int
+		want       []string
+		notWant    []string
+	}{
+		{"nil", nil, "", 80, nil, []string{"Error"}},
+		{"plain", errors.New("boom"), "", 80, []string{"✗ Error", "boom"}, []string{"Field:", "→"}},
+		{"suggestion", errors.New("boom"), "run clause --help", 80, []string{"boom", "→ run clause --help"}, nil},
+		{"field", fieldError{"frontend.framework", "unknown framework"}, "", 80, []string{"Field: frontend.framework"}, nil},
+		{"wrapped field", fmt.Errorf("load: %w", fieldError{"backend.port", "out of range"}), "", 80, []string{"Field: backend.port"}, nil},
+		{"wrapped to width", long, "check the file", 40, []string{"check the file"}, nil},
+		{
+			"wrapped parse error",
+			fmt.Errorf("load config: %w", &parseError{"backend.port", 12, strings.Repeat("expected an integer but found a string ", 3)}),
+			"quote the value or use a number", 40,
+			[]string{"Field: backend.port", "Line: 12", "quote the value or use a"},
+			nil,
+		},
+		{"parse error without line", &parseError{"frontend", 0, "bad"}, "", 80, []string{"Field: frontend"}, []string{"Line:"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRenderer(nil, tt.width, 24)
+			got := r.ErrorPanel(tt.err, tt.suggestion)
+
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("panel does not contain %q:\n%s", want, got)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(got, notWant) {
+					t.Errorf("panel contains %q:\n%s", notWant, got)
+				}
+			}
+			if got != "" && lipgloss.Width(got) > tt.width {
+				t.Errorf("panel is %d columns wide, want at most %d:\n%s", lipgloss.Width(got), tt.width, got)
+			}
+		})
+	}
+}
+
+func TestFeatureGrid(t *testing.T) {
+	features := map[string]bool{
+		"auth":      true,
+		"Billing":   false,
+		"email":     true,
+		"i18n":      false,
+		"search":    true,
+		"websocket": false,
+	}
+	// Cells are the longest label, a mark and a space, plus a 3 column gap
+	cellWidth := len("websocket") + 2 + 3
+
+	tests := []struct {
+		name        string
+		width       int
+		wantColumns int
+		wantRows    int
+	}{
+		{"unlimited width", 0, 1, 6},
+		{"narrow", 10, 1, 6},
+		{"two columns", 2*cellWidth - 3, 2, 3},
+		{"three columns", 3*cellWidth - 3, 3, 2},
+		{"wide", 200, 6, 1},
+	}
+
+	theme := styles.GetTheme()
+	checkmark := styles.NewTypography(theme).Checkmark("auth")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewRenderer(theme, 80, 24).FeatureGrid(features, tt.width)
+
+			if !strings.Contains(got, checkmark) {
+				t.Errorf("enabled feature not rendered with the success checkmark:\n%s", got)
+			}
+			for name, enabled := range features {
+				if !enabled && !strings.Contains(got, "✗ "+name) {
+					t.Errorf("disabled feature %s not rendered with a crossmark:\n%s", name, got)
+				}
+			}
+
+			lines := strings.Split(got, "\n")
+			if len(lines) != tt.wantRows {
+				t.Errorf("got %d rows, want %d:\n%s", len(lines), tt.wantRows, got)
+			}
+			if columns := strings.Count(lines[0], "✓") + strings.Count(lines[0], "✗"); columns != tt.wantColumns {
+				t.Errorf("got %d columns, want %d:\n%s", columns, tt.wantColumns, got)
+			}
+			if tt.width > 0 && tt.wantColumns > 1 && lipgloss.Width(got) > tt.width {
+				t.Errorf("grid is %d columns wide, want at most %d", lipgloss.Width(got), tt.width)
+			}
+		})
+	}
+
+	t.Run("alphabetical", func(t *testing.T) {
+		got := NewRenderer(theme, 80, 24).FeatureGrid(features, 0)
+		order := []string{"auth", "Billing", "email", "i18n", "search", "websocket"}
+		last := -1
+		for _, name := range order {
+			i := strings.Index(got, name)
+			if i < last {
+				t.Errorf("%s is out of alphabetical order:\n%s", name, got)
+			}
+			last = i
+		}
+	})
+
+	if got := NewRenderer(theme, 80, 24).FeatureGrid(nil, 80); got != "" {
+		t.Errorf("empty feature grid = %q, want empty", got)
+	}
+}
+
+func TestAccordion(t *testing.T) {
+	sections := []Section{
+		{Title: "Frontend", Body: "framework react"},
+		{Title: "Backend", Body: "framework fastapi"},
+		{Title: "Empty"},
+	}
+
+	tests := []struct {
+		name     string
+		expanded map[string]bool
+		want     []string
+		notWant  []string
+	}{
+		{
+			name:    "all collapsed",
+			want:    []string{"▸ Frontend", "▸ Backend", "▸ Empty"},
+			notWant: []string{"framework react", "framework fastapi", "▾"},
+		},
+		{
+			name:     "one expanded",
+			expanded: map[string]bool{"Frontend": true, "Backend": false},
+			want:     []string{"▾ Frontend", "framework react", "▸ Backend"},
+			notWant:  []string{"framework fastapi"},
+		},
+		{
+			name:     "all expanded",
+			expanded: map[string]bool{"Frontend": true, "Backend": true, "Empty": true},
+			want:     []string{"▾ Frontend", "framework react", "▾ Backend", "framework fastapi", "▾ Empty"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewRenderer(styles.GetTheme(), 80, 24).Accordion(sections, tt.expanded, 40)
+
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("accordion does not contain %q:\n%s", want, got)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(got, notWant) {
+					t.Errorf("accordion contains %q:\n%s", notWant, got)
+				}
+			}
+		})
+	}
+}
+
+func TestNextSteps(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(c *config.ProjectConfig)
+		want    []string
+		notWant []string
+	}{
+		{
+			name: "pnpm and fastapi",
+			setup: func(c *config.ProjectConfig) {
+				c.Frontend.PackageManager = "pnpm"
+				c.Backend.Language, c.Backend.Framework = "python", "fastapi"
+			},
+			want: []string{
+				"cd /work/demo",
+				"cd frontend && pnpm install",
+				"cd backend && pip install -r requirements.txt",
+				"cp backend/.env.example backend/.env",
+				"cd frontend && pnpm run dev",
+				"cd backend && uvicorn main:app --reload",
+				".clause/context.yaml",
+			},
+			notWant: []string{" npm install", "python main.py"},
+		},
+		{
+			name: "go backend only",
+			setup: func(c *config.ProjectConfig) {
+				c.Frontend.Enabled = false
+				c.Backend.Language, c.Backend.Framework = "go", "go-gin"
+			},
+			want:    []string{"cd backend && go mod download", "cd backend && go run ."},
+			notWant: []string{"frontend"},
+		},
+		{
+			name: "frontend at the root without governance",
+			setup: func(c *config.ProjectConfig) {
+				c.Frontend.Directory = "."
+				c.Frontend.PackageManager = ""
+				c.Backend.Enabled = false
+				c.Governance.Enabled = false
+			},
+			want:    []string{"npm install", "npm run dev"},
+			notWant: []string{"cd . &&", ".env", ".clause/context.yaml"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadPreset("saas")
+			if err != nil {
+				t.Fatal(err)
+			}
+			cfg.Frontend.Directory, cfg.Backend.Directory = "frontend", "backend"
+			tt.setup(cfg)
+
+			got := NewRenderer(nil, 80, 24).NextSteps(cfg, "/work/demo")
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("next steps do not contain %q:\n%s", want, got)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(got, notWant) {
+					t.Errorf("next steps contain %q:\n%s", notWant, got)
+				}
+			}
+		})
+	}
+}
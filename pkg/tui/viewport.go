@@ -0,0 +1,170 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Viewport shows a scrollable window onto long text, such as a rendered
+// markdown preview. Lines longer than the width are clipped, and a scroll
+// indicator is shown next to the text when it does not fit.
+type Viewport struct {
+	// Width and Height are the size of the visible text area
+	Width, Height int
+
+	lines  []string
+	offset int
+}
+
+// NewViewport creates a viewport of the given size.
+func NewViewport(width, height int) *Viewport {
+	return &Viewport{Width: width, Height: height}
+}
+
+// SetContent replaces the text shown in the viewport, keeping the scroll
+// position where possible.
+func (v *Viewport) SetContent(content string) {
+	v.lines = strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	v.clamp()
+}
+
+// SetSize changes the size of the visible text area.
+func (v *Viewport) SetSize(width, height int) {
+	v.Width, v.Height = width, height
+	v.clamp()
+}
+
+// Offset returns the index of the first visible line.
+func (v *Viewport) Offset() int {
+	return v.offset
+}
+
+// ScrollUp scrolls up by n lines.
+func (v *Viewport) ScrollUp(n int) {
+	v.offset -= n
+	v.clamp()
+}
+
+// ScrollDown scrolls down by n lines.
+func (v *Viewport) ScrollDown(n int) {
+	v.offset += n
+	v.clamp()
+}
+
+// PageUp scrolls up by one viewport height.
+func (v *Viewport) PageUp() {
+	v.ScrollUp(v.Height)
+}
+
+// PageDown scrolls down by one viewport height.
+func (v *Viewport) PageDown() {
+	v.ScrollDown(v.Height)
+}
+
+// GotoTop scrolls to the first line.
+func (v *Viewport) GotoTop() {
+	v.offset = 0
+}
+
+// GotoBottom scrolls so the last line is at the bottom.
+func (v *Viewport) GotoBottom() {
+	v.offset = v.maxOffset()
+}
+
+// AtTop reports whether the first line is visible.
+func (v *Viewport) AtTop() bool {
+	return v.offset == 0
+}
+
+// AtBottom reports whether the last line is visible.
+func (v *Viewport) AtBottom() bool {
+	return v.offset >= v.maxOffset()
+}
+
+// maxOffset returns the largest offset that still fills the viewport.
+func (v *Viewport) maxOffset() int {
+	return max(len(v.lines)-v.Height, 0)
+}
+
+// clamp keeps the offset within the content.
+func (v *Viewport) clamp() {
+	v.offset = min(max(v.offset, 0), v.maxOffset())
+}
+
+// Update scrolls the viewport in response to the navigation keys.
+func (v *Viewport) Update(msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+
+	switch key.String() {
+	case "up", "k":
+		v.ScrollUp(1)
+	case "down", "j":
+		v.ScrollDown(1)
+	case "pgup", "b":
+		v.PageUp()
+	case "pgdown", "f", " ":
+		v.PageDown()
+	case "home", "g":
+		v.GotoTop()
+	case "end", "G":
+		v.GotoBottom()
+	}
+	return nil
+}
+
+// View renders the visible lines, clipped to the width and padded to the
+// height, with ScrollIndicator to their right when the content is longer
+// than the viewport.
+func (v *Viewport) View() string {
+	if v.Height <= 0 {
+		return ""
+	}
+
+	end := min(v.offset+v.Height, len(v.lines))
+	visible := make([]string, 0, v.Height)
+	clip := lipgloss.NewStyle().MaxWidth(v.Width)
+	for _, line := range v.lines[v.offset:end] {
+		visible = append(visible, clip.Render(line))
+	}
+	for len(visible) < v.Height {
+		visible = append(visible, "")
+	}
+
+	text := lipgloss.NewStyle().Width(v.Width).Render(strings.Join(visible, "\n"))
+	indicator := ScrollIndicator(len(v.lines), v.Height, v.offset)
+	if indicator == "" {
+		return text
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, text, " ", fitIndicator(indicator, v.Height))
+}
+
+// fitIndicator cuts a scroll indicator taller than height down to height
+// lines, moving the thumb onto the last line if it was cut off.
+func fitIndicator(indicator string, height int) string {
+	lines := strings.Split(indicator, "\n")
+	if len(lines) <= height {
+		return indicator
+	}
+
+	for _, line := range lines[height:] {
+		if line == "█" {
+			lines[height-1] = line
+		}
+	}
+	return strings.Join(lines[:height], "\n")
+}
+
+// KeyBindings returns the bindings the viewport responds to.
+func (v *Viewport) KeyBindings() KeyBindings {
+	return KeyBindings{
+		KeyUp,
+		KeyDown,
+		{Key: "PgUp/PgDn", Description: "Page"},
+		{Key: "Home/End", Description: "Top/Bottom"},
+	}
+}
@@ -0,0 +1,173 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// numberedContent returns n lines reading "line 1" to "line n".
+func numberedContent(n int) string {
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %d", i+1)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestViewportOffset(t *testing.T) {
+	tests := []struct {
+		name       string
+		lines      int
+		do         func(v *Viewport)
+		wantOffset int
+		wantTop    bool
+		wantBottom bool
+	}{
+		{"new", 20, func(v *Viewport) {}, 0, true, false},
+		{"scroll down", 20, func(v *Viewport) { v.ScrollDown(3) }, 3, false, false},
+		{"scroll past the end", 20, func(v *Viewport) { v.ScrollDown(100) }, 15, false, true},
+		{"scroll above the start", 20, func(v *Viewport) { v.ScrollDown(2); v.ScrollUp(10) }, 0, true, false},
+		{"page down", 20, func(v *Viewport) { v.PageDown() }, 5, false, false},
+		{"page down to the end", 20, func(v *Viewport) { v.PageDown(); v.PageDown(); v.PageDown(); v.PageDown() }, 15, false, true},
+		{"page up", 20, func(v *Viewport) { v.GotoBottom(); v.PageUp() }, 10, false, false},
+		{"bottom", 20, func(v *Viewport) { v.GotoBottom() }, 15, false, true},
+		{"top", 20, func(v *Viewport) { v.GotoBottom(); v.GotoTop() }, 0, true, false},
+		{"content shrinks", 20, func(v *Viewport) { v.ScrollDown(10); v.SetContent(numberedContent(8)) }, 3, false, true},
+		{"viewport grows", 20, func(v *Viewport) { v.GotoBottom(); v.SetSize(40, 10) }, 10, false, true},
+		{"content fits", 3, func(v *Viewport) { v.ScrollDown(2); v.PageDown() }, 0, true, true},
+		{"keys", 20, func(v *Viewport) {
+			v.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+			v.Update(tea.KeyMsg{Type: tea.KeyDown})
+			v.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")})
+			v.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+		}, 6, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewViewport(40, 5)
+			v.SetContent(numberedContent(tt.lines))
+
+			tt.do(v)
+
+			if v.Offset() != tt.wantOffset {
+				t.Errorf("Offset() = %d, want %d", v.Offset(), tt.wantOffset)
+			}
+			if v.AtTop() != tt.wantTop || v.AtBottom() != tt.wantBottom {
+				t.Errorf("AtTop() = %v, AtBottom() = %v, want %v, %v", v.AtTop(), v.AtBottom(), tt.wantTop, tt.wantBottom)
+			}
+		})
+	}
+}
+
+func TestViewportView(t *testing.T) {
+	tests := []struct {
+		name          string
+		width, height int
+		content       string
+		offset        int
+		wantLines     []string // the text of each line, without the indicator
+		wantIndicator bool
+	}{
+		{
+			name:      "fits",
+			width:     10,
+			height:    3,
+			content:   "a\nb",
+			wantLines: []string{"a", "b", ""},
+		},
+		{
+			name:      "clipped to the width",
+			width:     4,
+			height:    2,
+			content:   "abcdefgh\nxy",
+			wantLines: []string{"abcd", "xy"},
+		},
+		{
+			name:          "scrolled",
+			width:         10,
+			height:        3,
+			content:       numberedContent(10),
+			offset:        4,
+			wantLines:     []string{"line 5", "line 6", "line 7"},
+			wantIndicator: true,
+		},
+		{
+			name:          "wide lines with an indicator",
+			width:         3,
+			height:        2,
+			content:       numberedContent(4),
+			wantLines:     []string{"lin", "lin"},
+			wantIndicator: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewViewport(tt.width, tt.height)
+			v.SetContent(tt.content)
+			v.ScrollDown(tt.offset)
+
+			lines := strings.Split(v.View(), "\n")
+			if len(lines) != tt.height {
+				t.Fatalf("view has %d lines, want %d:\n%s", len(lines), tt.height, v.View())
+			}
+
+			maxWidth := tt.width
+			if tt.wantIndicator {
+				// A space and the indicator column follow the text
+				maxWidth += 2
+			}
+			var hasIndicator bool
+			for i, line := range lines {
+				if w := lipgloss.Width(line); w > maxWidth {
+					t.Errorf("line %d is %d wide, want at most %d: %q", i, w, maxWidth, line)
+				}
+				text := line
+				if len(line) > 0 {
+					text = strings.TrimRight(line, " │█")
+					hasIndicator = hasIndicator || text != strings.TrimRight(line, " ")
+				}
+				if text != tt.wantLines[i] {
+					t.Errorf("line %d = %q, want %q", i, text, tt.wantLines[i])
+				}
+			}
+			if hasIndicator != tt.wantIndicator {
+				t.Errorf("indicator shown = %v, want %v", hasIndicator, tt.wantIndicator)
+			}
+		})
+	}
+
+	if got := NewViewport(10, 0).View(); got != "" {
+		t.Errorf("zero height view = %q, want empty", got)
+	}
+}
+
+func TestFitIndicator(t *testing.T) {
+	// ScrollIndicator is five lines tall
+	tests := []struct {
+		name   string
+		offset int
+		height int
+		want   string
+	}{
+		{"taller viewport", 0, 10, "█\n│\n│\n│\n│"},
+		{"same height", 15, 5, "│\n│\n│\n│\n█"},
+		{"thumb kept", 0, 3, "█\n│\n│"},
+		{"thumb moved up", 15, 3, "│\n│\n█"},
+		{"thumb in the middle", 8, 2, "│\n█"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indicator := ScrollIndicator(20, 5, tt.offset)
+			if got := fitIndicator(indicator, tt.height); got != tt.want {
+				t.Errorf("fitIndicator(%q, %d) = %q, want %q", indicator, tt.height, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"os"
+	"strings"
+	"sync"
+)
+
+// Capabilities summarizes what the terminal supports, so TUI models can
+// adapt their features from a single place.
+type Capabilities struct {
+	// Interactive reports whether stdout is a terminal that is not dumb
+	Interactive bool
+
+	// ColorDepth is the usable color depth, ColorDepthNone when color is
+	// disabled or stdout is not a terminal
+	ColorDepth ColorDepth
+
+	// Unicode reports whether box-drawing and other non-ASCII glyphs render
+	Unicode bool
+
+	// Mouse reports whether mouse tracking can be enabled
+	Mouse bool
+
+	// Animations reports whether animations should run
+	Animations bool
+}
+
+// capabilities caches the result of detectCapabilities.
+var capabilities = sync.OnceValue(detectCapabilities)
+
+// DetectCapabilities returns the terminal's capabilities. They are detected
+// once, on first use.
+func DetectCapabilities() Capabilities {
+	return capabilities()
+}
+
+// detectCapabilities reads the capabilities from the terminal and the
+// environment.
+func detectCapabilities() Capabilities {
+	caps := Capabilities{
+		Interactive: IsInteractive(),
+		Unicode:     supportsUnicode(),
+		Animations:  !PrefersReducedMotion(),
+	}
+
+	switch {
+	case IsColorForced():
+		caps.ColorDepth = DetectColorDepth()
+		if caps.ColorDepth == ColorDepthNone {
+			caps.ColorDepth = ColorDepth16
+		}
+	case caps.Interactive && !IsColorDisabled():
+		caps.ColorDepth = DetectColorDepth()
+	}
+
+	// The Linux console has no mouse reporting without gpm
+	caps.Mouse = caps.Interactive && os.Getenv("TERM") != "linux"
+
+	return caps
+}
+
+// supportsUnicode reports whether the locale or terminal can render
+// non-ASCII glyphs. Unlike SupportsUTF8, an explicit non-UTF-8 locale wins
+// over the platform default.
+func supportsUnicode() bool {
+	if IsDumbTerminal() {
+		return false
+	}
+
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if locale := os.Getenv(name); locale != "" {
+			locale = strings.ToLower(locale)
+			return strings.Contains(locale, "utf-8") || strings.Contains(locale, "utf8")
+		}
+	}
+
+	// Without a locale, only the Linux console is known to lack glyphs
+	return os.Getenv("TERM") != "linux"
+}
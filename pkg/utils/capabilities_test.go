@@ -0,0 +1,109 @@
+package utils
+
+import "testing"
+
+// terminalEnv lists the variables capability detection reads.
+var terminalEnv = []string{
+	"TERM", "COLORTERM", "TERM_PROGRAM", "WT_SESSION",
+	"CLICOLOR_FORCE", "FORCE_COLOR", "NO_COLOR", "CLICOLOR",
+	"LC_ALL", "LC_CTYPE", "LANG", "CLAUSE_REDUCE_MOTION",
+}
+
+// setTerminalEnv clears the capability variables and then sets env.
+func setTerminalEnv(t *testing.T, env map[string]string) {
+	t.Helper()
+
+	for _, name := range terminalEnv {
+		t.Setenv(name, "")
+	}
+	for name, value := range env {
+		t.Setenv(name, value)
+	}
+}
+
+func TestDetectCapabilitiesNonTTY(t *testing.T) {
+	if IsTerminal() {
+		t.Skip("stdout is a terminal")
+	}
+
+	tests := []struct {
+		name  string
+		env   map[string]string
+		depth ColorDepth
+	}{
+		{"true-color TERM without a tty", map[string]string{"TERM": "xterm-256color", "COLORTERM": "truecolor"}, ColorDepthNone},
+		{"forced true color", map[string]string{"TERM": "xterm-256color", "COLORTERM": "truecolor", "FORCE_COLOR": "1"}, ColorDepthTrue},
+		{"forced 256 color", map[string]string{"TERM": "xterm-256color", "CLICOLOR_FORCE": "1"}, ColorDepth256},
+		{"forced without a color TERM", map[string]string{"TERM": "dumb", "FORCE_COLOR": "1"}, ColorDepth16},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setTerminalEnv(t, tt.env)
+
+			caps := detectCapabilities()
+			if caps.Interactive {
+				t.Error("Interactive = true, want false")
+			}
+			if caps.ColorDepth != tt.depth {
+				t.Errorf("ColorDepth = %v, want %v", caps.ColorDepth, tt.depth)
+			}
+			if caps.Mouse {
+				t.Error("Mouse = true, want false")
+			}
+			if caps.Animations {
+				t.Error("Animations = true, want false")
+			}
+		})
+	}
+}
+
+func TestDetectColorDepth(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want ColorDepth
+	}{
+		{"COLORTERM truecolor", map[string]string{"TERM": "xterm", "COLORTERM": "truecolor"}, ColorDepthTrue},
+		{"COLORTERM 24bit", map[string]string{"COLORTERM": "24bit"}, ColorDepthTrue},
+		{"256-color TERM", map[string]string{"TERM": "xterm-256color"}, ColorDepth256},
+		{"basic TERM", map[string]string{"TERM": "xterm"}, ColorDepth16},
+		{"true-color TERM_PROGRAM", map[string]string{"TERM": "xterm", "TERM_PROGRAM": "iTerm.app"}, ColorDepthTrue},
+		{"Windows Terminal", map[string]string{"WT_SESSION": "1"}, ColorDepthTrue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setTerminalEnv(t, tt.env)
+
+			if got := DetectColorDepth(); got != tt.want {
+				t.Errorf("DetectColorDepth() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSupportsUnicode(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want bool
+	}{
+		{"UTF-8 LANG", map[string]string{"TERM": "xterm", "LANG": "en_US.UTF-8"}, true},
+		{"utf8 LC_CTYPE", map[string]string{"TERM": "xterm", "LC_CTYPE": "C.utf8"}, true},
+		{"LC_ALL wins over LANG", map[string]string{"TERM": "xterm", "LC_ALL": "C", "LANG": "en_US.UTF-8"}, false},
+		{"dumb terminal", map[string]string{"TERM": "dumb", "LANG": "en_US.UTF-8"}, false},
+		{"no locale", map[string]string{"TERM": "xterm"}, true},
+		{"Linux console without locale", map[string]string{"TERM": "linux"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setTerminalEnv(t, tt.env)
+
+			if got := supportsUnicode(); got != tt.want {
+				t.Errorf("supportsUnicode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
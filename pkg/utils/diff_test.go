@@ -0,0 +1,175 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// numberedLines returns "1\n2\n...n\n" with the given lines replaced.
+func numberedLines(n int, replace map[int]string) string {
+	var sb strings.Builder
+	for i := 1; i <= n; i++ {
+		if line, ok := replace[i]; ok {
+			sb.WriteString(line + "\n")
+			continue
+		}
+		fmt.Fprintf(&sb, "%d\n", i)
+	}
+	return sb.String()
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{
+			name: "equal",
+			a:    "x\ny\n",
+			b:    "x\ny\n",
+			want: "",
+		},
+		{
+			name: "changed line",
+			a:    "1\n2\n3\n",
+			b:    "1\nX\n3\n",
+			want: "--- a\n+++ b\n@@ -1,3 +1,3 @@\n 1\n-2\n+X\n 3\n",
+		},
+		{
+			name: "new file",
+			a:    "",
+			b:    "x\ny\n",
+			want: "--- a\n+++ b\n@@ -0,0 +1,2 @@\n+x\n+y\n",
+		},
+		{
+			name: "deleted file",
+			a:    "x\n",
+			b:    "",
+			want: "--- a\n+++ b\n@@ -1 +0,0 @@\n-x\n",
+		},
+		{
+			name: "newline added at end",
+			a:    "x",
+			b:    "x\n",
+			want: "--- a\n+++ b\n@@ -1 +1 @@\n-x\n\\ No newline at end of file\n+x\n",
+		},
+		{
+			name: "newline removed at end",
+			a:    "x\ny\n",
+			b:    "x\ny",
+			want: "--- a\n+++ b\n@@ -1,2 +1,2 @@\n x\n-y\n+y\n\\ No newline at end of file\n",
+		},
+		{
+			name: "line appended after a missing newline",
+			a:    "x",
+			b:    "x\ny",
+			want: "--- a\n+++ b\n@@ -1 +1,2 @@\n-x\n\\ No newline at end of file\n+x\n+y\n\\ No newline at end of file\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnifiedDiff("a", "b", tt.a, tt.b); got != tt.want {
+				t.Errorf("UnifiedDiff() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnifiedDiffHunks(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    string
+		headers []string
+	}{
+		{
+			name:    "change at the start",
+			a:       numberedLines(12, nil),
+			b:       numberedLines(12, map[int]string{1: "one"}),
+			headers: []string{"@@ -1,4 +1,4 @@"},
+		},
+		{
+			name:    "change at the end",
+			a:       numberedLines(12, nil),
+			b:       numberedLines(12, map[int]string{12: "twelve"}),
+			headers: []string{"@@ -9,4 +9,4 @@"},
+		},
+		{
+			name:    "changes two contexts apart are merged",
+			a:       numberedLines(12, nil),
+			b:       numberedLines(12, map[int]string{2: "two", 9: "nine"}),
+			headers: []string{"@@ -1,12 +1,12 @@"},
+		},
+		{
+			name:    "changes further apart are split",
+			a:       numberedLines(12, nil),
+			b:       numberedLines(12, map[int]string{2: "two", 10: "ten"}),
+			headers: []string{"@@ -1,5 +1,5 @@", "@@ -7,6 +7,6 @@"},
+		},
+		{
+			name:    "inserted lines have an empty old range",
+			a:       "",
+			b:       "x\n",
+			headers: []string{"@@ -0,0 +1 @@"},
+		},
+		{
+			name:    "deleted lines have an empty new range",
+			a:       "x\ny\n",
+			b:       "",
+			headers: []string{"@@ -1,2 +0,0 @@"},
+		},
+		{
+			name:    "insertion in the middle",
+			a:       "1\n2\n3\n4\n5\n6\n7\n8\n",
+			b:       "1\n2\n3\n4\nnew\n5\n6\n7\n8\n",
+			headers: []string{"@@ -2,6 +2,7 @@"},
+		},
+		{
+			name:    "deletion in the middle",
+			a:       "1\n2\n3\n4\n5\n6\n7\n8\n",
+			b:       "1\n2\n3\n5\n6\n7\n8\n",
+			headers: []string{"@@ -1,7 +1,6 @@"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var headers []string
+			for _, line := range strings.Split(UnifiedDiff("a", "b", tt.a, tt.b), "\n") {
+				if strings.HasPrefix(line, "@@") {
+					headers = append(headers, line)
+				}
+			}
+			if strings.Join(headers, "\n") != strings.Join(tt.headers, "\n") {
+				t.Errorf("hunk headers = %q, want %q", headers, tt.headers)
+			}
+		})
+	}
+}
+
+func TestUnifiedDiffLargeInput(t *testing.T) {
+	t.Run("small change in a large file", func(t *testing.T) {
+		a := numberedLines(10000, nil)
+		b := numberedLines(10000, map[int]string{5000: "changed"})
+
+		got := UnifiedDiff("a", "b", a, b)
+		if !strings.Contains(got, "@@ -4997,7 +4997,7 @@\n") || !strings.Contains(got, "-5000\n+changed\n") {
+			t.Errorf("unexpected diff:\n%s", got)
+		}
+	})
+
+	t.Run("large changed region", func(t *testing.T) {
+		var a, b strings.Builder
+		for i := 0; i < 3000; i++ {
+			fmt.Fprintf(&a, "a%d\n", i)
+			fmt.Fprintf(&b, "b%d\n", i)
+		}
+
+		want := "Files a and b differ\n"
+		if got := UnifiedDiff("a", "b", a.String(), b.String()); got != want {
+			t.Errorf("UnifiedDiff() = %q, want %q", got, want)
+		}
+	})
+}
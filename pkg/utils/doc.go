@@ -9,7 +9,9 @@
 //   - EnsureDirectory, CreateFile, WriteFile, ReadFile
 //   - CopyFile, CopyDirectory, MoveFile, DeleteFile
 //   - ListFiles, ListDirectories, WalkFiles
-//   - FileSize, FileHash, AtomicWrite, BackupFile
+//   - Glob, MatchGlob, MatchPatterns (glob.go), with ** and ! negation
+//   - FileSize, DirStats, FileHash, AtomicWrite, BackupFile
+//   - AcquireLock, AcquireLockWithTimeout
 //
 // Example:
 //
@@ -22,6 +24,7 @@
 //
 // Functions for path manipulation and resolution:
 //   - ExpandHome, ContractHome, GetHomeDirectory
+//   - PortablePath, ResolvePortable
 //   - ToAbsPath, IsAbsPath, JoinPath, CleanPath
 //   - FindFileUp, FindGitRoot, IsInDirectory
 //   - IsValidFilename, SanitizeFilename
@@ -42,6 +45,7 @@
 //   - TitleCase, Capitalize, Upper, Lower
 //   - Wrap, Indent, Dedent
 //   - IsEmpty, IsNumeric, IsAlpha, IsAlphaNumeric
+//   - ParseBoolStrict
 //
 // Example:
 //
@@ -51,6 +55,26 @@
 //	// Truncate with ellipsis
 //	short := utils.Truncate("Very long text...", 10) // "Very lo..."
 //
+// # Markdown Utilities (markdown.go)
+//
+// Functions for generated markdown documents:
+//   - GenerateTOC
+//
+// Example:
+//
+//	// Insert a linked table of contents after the title
+//	doc = utils.GenerateTOC(doc)
+//
+// # Diff Utilities (diff.go)
+//
+// Functions for comparing text:
+//   - UnifiedDiff
+//
+// Example:
+//
+//	// Show what rewriting a file would change
+//	fmt.Print(utils.UnifiedDiff(path, path, oldContent, newContent))
+//
 // # Slice Utilities (slice.go)
 //
 // Generic functions for slice operations:
@@ -89,7 +113,10 @@
 //   - SupportsTrueColor, Supports256Colors
 //   - ClearScreen, MoveCursor, HideCursor, ShowCursor
 //   - EnableAlternateScreen, DisableAlternateScreen
-//   - NotifyResize, StartRawMode
+//   - NotifyResize, StartRawMode, PrefersReducedMotion
+//
+// Notification helpers (notify.go):
+//   - Bell, Notify
 //
 // Example:
 //
@@ -99,6 +126,15 @@
 //	    // Use true color
 //	}
 //
+// DetectCapabilities (capabilities.go) combines these checks, along with
+// unicode, mouse and reduced-motion detection, into one cached struct that
+// TUI models consult at startup:
+//
+//	caps := utils.DetectCapabilities()
+//	if caps.Mouse {
+//	    opts = append(opts, tea.WithMouseCellMotion())
+//	}
+//
 // # Version Utilities (version.go)
 //
 // Functions for semantic version parsing and comparison:
@@ -106,6 +142,7 @@
 //   - IsNewer, IsOlder, VersionsEqual
 //   - Version struct with comparison methods
 //   - VersionRange for constraint checking
+//   - CheckForUpdate to pick the newest release above a version
 //
 // Example:
 //
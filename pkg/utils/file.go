@@ -2,8 +2,10 @@
 package utils
 
 import (
+	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
@@ -325,6 +327,34 @@ func FileSize(path string) (int64, error) {
 	return info.Size(), nil
 }
 
+// DirStats walks the tree at path once and returns the number of files and
+// subdirectories below it and the total size of the files. Symbolic links
+// are counted as files but not followed, so a link cycle cannot make the
+// walk loop.
+func DirStats(path string) (fileCount int, dirCount int, totalBytes int64, err error) {
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if p == path {
+			return nil
+		}
+		if d.IsDir() {
+			dirCount++
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		fileCount++
+		totalBytes += info.Size()
+		return nil
+	})
+	return fileCount, dirCount, totalBytes, err
+}
+
 // FileHash returns the SHA256 hash of a file.
 func FileHash(path string) (string, error) {
 	file, err := os.Open(path)
@@ -464,6 +494,100 @@ func BackupFile(path string) (string, error) {
 	return backupPath, nil
 }
 
+// Lock timing defaults used by AcquireLock.
+var (
+	// LockWaitTimeout is how long AcquireLock waits for a held lock.
+	LockWaitTimeout = 5 * time.Second
+
+	// LockStaleAfter is the age after which a lock file is considered
+	// abandoned by a crashed process and may be reclaimed.
+	LockStaleAfter = 30 * time.Second
+
+	// lockPollInterval is how often a held lock is re-checked.
+	lockPollInterval = 50 * time.Millisecond
+)
+
+// ErrLocked is returned when a lock could not be acquired in time.
+var ErrLocked = errors.New("file is locked by another process")
+
+// AcquireLock takes an advisory lock on path by creating path+".lock".
+// It waits up to LockWaitTimeout for a held lock and reclaims locks older
+// than LockStaleAfter. The returned release function removes the lock if
+// it is still the one this call created.
+func AcquireLock(path string) (release func(), err error) {
+	return AcquireLockWithTimeout(path, LockWaitTimeout, LockStaleAfter)
+}
+
+// AcquireLockWithTimeout is like AcquireLock with explicit wait and stale durations.
+func AcquireLockWithTimeout(path string, wait, staleAfter time.Duration) (release func(), err error) {
+	lockPath := path + ".lock"
+	if err := EnsureDirectory(filepath.Dir(lockPath)); err != nil {
+		return nil, fmt.Errorf("failed to create lock directory: %w", err)
+	}
+
+	// The lock file holds a nonce so that only its owner removes it
+	random := make([]byte, 8)
+	if _, err := rand.Read(random); err != nil {
+		return nil, fmt.Errorf("failed to create lock token: %w", err)
+	}
+	nonce := hex.EncodeToString(random)
+	token := fmt.Sprintf("%d\n%s\n%s\n", os.Getpid(), time.Now().Format(time.RFC3339), nonce)
+
+	deadline := time.Now().Add(wait)
+	for {
+		f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			_, err = f.WriteString(token)
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				os.Remove(lockPath)
+				return nil, fmt.Errorf("failed to write lock file: %w", err)
+			}
+			return func() { releaseLock(lockPath, token) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, fmt.Errorf("failed to create lock file: %w", err)
+		}
+
+		// Reclaim locks left behind by crashed processes
+		if info, statErr := os.Stat(lockPath); statErr == nil && time.Since(info.ModTime()) > staleAfter {
+			reclaimStaleLock(lockPath, nonce, staleAfter)
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("%w: %s", ErrLocked, lockPath)
+		}
+		time.Sleep(lockPollInterval)
+	}
+}
+
+// releaseLock removes the lock file if it still holds token, so a lock that
+// was reclaimed as stale and taken by another process is left alone.
+func releaseLock(lockPath, token string) {
+	data, err := os.ReadFile(lockPath)
+	if err == nil && string(data) == token {
+		os.Remove(lockPath)
+	}
+}
+
+// reclaimStaleLock removes a stale lock file. The file is first renamed
+// aside, which only one of several processes reclaiming it can do. If the
+// renamed file turns out to be fresh, because another process replaced the
+// stale lock in the meantime, it is linked back unless a newer lock exists.
+func reclaimStaleLock(lockPath, nonce string, staleAfter time.Duration) {
+	aside := lockPath + ".stale-" + nonce
+	if err := os.Rename(lockPath, aside); err != nil {
+		return
+	}
+	if info, err := os.Stat(aside); err == nil && time.Since(info.ModTime()) <= staleAfter {
+		os.Link(aside, lockPath)
+	}
+	os.Remove(aside)
+}
+
 // FileExtension returns the file extension (without the dot).
 func FileExtension(path string) string {
 	ext := filepath.Ext(path)
@@ -0,0 +1,236 @@
+package utils
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAcquireLockHeld(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+
+	release, err := AcquireLockWithTimeout(path, time.Second, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := AcquireLockWithTimeout(path, 100*time.Millisecond, time.Minute); !errors.Is(err, ErrLocked) {
+		t.Fatalf("second acquisition got %v, want ErrLocked", err)
+	}
+
+	release()
+	if _, err := os.Stat(path + ".lock"); !os.IsNotExist(err) {
+		t.Errorf("release left the lock file behind: %v", err)
+	}
+
+	release2, err := AcquireLockWithTimeout(path, 100*time.Millisecond, time.Minute)
+	if err != nil {
+		t.Fatalf("acquisition after release: %v", err)
+	}
+	release2()
+}
+
+func TestAcquireLockWaitsForRelease(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+
+	release, err := AcquireLockWithTimeout(path, time.Second, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		time.Sleep(150 * time.Millisecond)
+		release()
+	}()
+
+	start := time.Now()
+	release2, err := AcquireLockWithTimeout(path, 5*time.Second, time.Minute)
+	if err != nil {
+		t.Fatalf("waiting acquisition: %v", err)
+	}
+	defer release2()
+	if time.Since(start) < 100*time.Millisecond {
+		t.Error("second acquisition did not wait for the first to be released")
+	}
+}
+
+func TestAcquireLockReclaimsStaleLock(t *testing.T) {
+	tests := []struct {
+		name    string
+		age     time.Duration
+		reclaim bool
+	}{
+		{"stale", time.Hour, true},
+		{"fresh", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			lockPath := path + ".lock"
+			if err := os.WriteFile(lockPath, []byte("12345\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+			old := time.Now().Add(-tt.age)
+			if err := os.Chtimes(lockPath, old, old); err != nil {
+				t.Fatal(err)
+			}
+
+			release, err := AcquireLockWithTimeout(path, 100*time.Millisecond, time.Minute)
+			if tt.reclaim {
+				if err != nil {
+					t.Fatalf("stale lock was not reclaimed: %v", err)
+				}
+				release()
+			} else if !errors.Is(err, ErrLocked) {
+				t.Fatalf("got %v, want ErrLocked for a fresh lock", err)
+			}
+
+			leftovers, _ := filepath.Glob(lockPath + ".stale-*")
+			if len(leftovers) > 0 {
+				t.Errorf("reclaiming left %v behind", leftovers)
+			}
+		})
+	}
+}
+
+func TestReleaseLockKeepsOtherOwner(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+
+	release, err := AcquireLockWithTimeout(path, time.Second, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Another process reclaimed the lock and now holds it
+	if err := os.WriteFile(path+".lock", []byte("other owner\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	release()
+	if _, err := os.Stat(path + ".lock"); err != nil {
+		t.Errorf("release removed a lock it does not own: %v", err)
+	}
+}
+
+func TestAcquireLockSerializes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+
+	var holders, overlaps int32
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := AcquireLockWithTimeout(path, 10*time.Second, time.Minute)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if atomic.AddInt32(&holders, 1) > 1 {
+				atomic.AddInt32(&overlaps, 1)
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&holders, -1)
+			release()
+		}()
+	}
+	wg.Wait()
+
+	if overlaps > 0 {
+		t.Errorf("the lock was held by several goroutines at once %d times", overlaps)
+	}
+}
+
+func TestDirStats(t *testing.T) {
+	tests := []struct {
+		name      string
+		files     map[string]string
+		dirs      []string
+		links     map[string]string
+		wantFiles int
+		wantDirs  int
+	}{
+		{name: "empty"},
+		{
+			name:      "nested files",
+			files:     map[string]string{"a.txt": "hello", "src/main.go": "package main\n", "src/pkg/util.go": "package pkg\n"},
+			dirs:      []string{"empty"},
+			wantFiles: 3,
+			wantDirs:  3,
+		},
+		{
+			name:      "symlink cycle",
+			files:     map[string]string{"src/main.go": "package main\n"},
+			links:     map[string]string{"src/loop": "..", "self": "."},
+			wantFiles: 3,
+			wantDirs:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			var wantBytes int64
+			for name, content := range tt.files {
+				path := filepath.Join(root, filepath.FromSlash(name))
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+				wantBytes += int64(len(content))
+			}
+			for _, dir := range tt.dirs {
+				if err := os.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for name, target := range tt.links {
+				path := filepath.Join(root, filepath.FromSlash(name))
+				if err := os.Symlink(target, path); err != nil {
+					t.Skipf("symlinks not supported: %v", err)
+				}
+				info, err := os.Lstat(path)
+				if err != nil {
+					t.Fatal(err)
+				}
+				wantBytes += info.Size()
+			}
+
+			type result struct {
+				files, dirs int
+				bytes       int64
+				err         error
+			}
+			done := make(chan result, 1)
+			go func() {
+				files, dirs, bytes, err := DirStats(root)
+				done <- result{files, dirs, bytes, err}
+			}()
+
+			select {
+			case got := <-done:
+				if got.err != nil {
+					t.Fatal(got.err)
+				}
+				if got.files != tt.wantFiles || got.dirs != tt.wantDirs || got.bytes != wantBytes {
+					t.Errorf("DirStats() = %d files, %d dirs, %d bytes, want %d, %d, %d",
+						got.files, got.dirs, got.bytes, tt.wantFiles, tt.wantDirs, wantBytes)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("DirStats did not finish")
+			}
+		})
+	}
+
+	t.Run("missing directory", func(t *testing.T) {
+		if _, _, _, err := DirStats(filepath.Join(t.TempDir(), "missing")); err == nil {
+			t.Error("expected an error for a missing directory")
+		}
+	})
+}
@@ -0,0 +1,159 @@
+package utils
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Glob walks root and returns the files whose slash-separated path relative
+// to root matches the patterns, joined with root like WalkFiles. Patterns
+// are checked in order, as in a .gitignore file: a pattern starting with !
+// excludes the files matched so far, and the last matching pattern decides.
+// When the first pattern is a negation, every file starts out included.
+//
+// See MatchGlob for the pattern syntax.
+//
+//	files, err := utils.Glob("src", "**/*.ts", "!**/*.test.ts")
+func Glob(root string, patterns ...string) ([]string, error) {
+	for _, pattern := range patterns {
+		if err := ValidateGlob(strings.TrimPrefix(pattern, "!")); err != nil {
+			return nil, err
+		}
+	}
+
+	var files []string
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		matched, err := MatchPatterns(filepath.ToSlash(rel), patterns...)
+		if err != nil {
+			return err
+		}
+		if matched {
+			files = append(files, p)
+		}
+		return nil
+	})
+
+	return files, err
+}
+
+// MatchPatterns reports whether a slash-separated path is selected by the
+// patterns, with negation and ordering as in Glob.
+func MatchPatterns(name string, patterns ...string) (bool, error) {
+	matched := len(patterns) > 0 && strings.HasPrefix(patterns[0], "!")
+	for _, pattern := range patterns {
+		negate := strings.HasPrefix(pattern, "!")
+		ok, err := MatchGlob(strings.TrimPrefix(pattern, "!"), name)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			matched = !negate
+		}
+	}
+	return matched, nil
+}
+
+// MatchGlob reports whether a slash-separated path matches a pattern.
+// Segments are matched with path.Match, and a ** segment matches any number
+// of segments, including none, so "**/*.ts" matches TypeScript files at any
+// depth. A pattern that matches a directory also matches everything in it,
+// and a trailing slash limits a pattern to directories: "dist/" matches
+// "dist/app.js" and "dist/" but not a file named "dist". Leading "./" and
+// "/" are ignored in both the pattern and the path.
+func MatchGlob(pattern, name string) (bool, error) {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	patternSegs := globSegments(pattern)
+	nameSegs := globSegments(name)
+	if len(patternSegs) == 0 {
+		return false, nil
+	}
+
+	// The pattern may match the path itself or any directory above it. A
+	// path with a trailing slash is a directory itself.
+	last := len(nameSegs)
+	if dirOnly && !strings.HasSuffix(name, "/") {
+		last--
+	}
+	for n := last; n > 0; n-- {
+		ok, err := matchSegments(patternSegs, nameSegs[:n])
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+// ValidateGlob returns an error if a pattern is malformed.
+func ValidateGlob(pattern string) error {
+	for _, seg := range globSegments(pattern) {
+		if seg == "**" {
+			continue
+		}
+		if _, err := path.Match(seg, ""); err != nil {
+			return fmt.Errorf("invalid glob pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// matchSegments matches path segments against pattern segments.
+func matchSegments(pattern, name []string) (bool, error) {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			// Collapse repeated ** and try every split of the rest
+			rest := pattern[1:]
+			for len(rest) > 0 && rest[0] == "**" {
+				rest = rest[1:]
+			}
+			if len(rest) == 0 {
+				return true, nil
+			}
+			for i := 0; i <= len(name); i++ {
+				ok, err := matchSegments(rest, name[i:])
+				if err != nil || ok {
+					return ok, err
+				}
+			}
+			return false, nil
+		}
+
+		if len(name) == 0 {
+			return false, nil
+		}
+		ok, err := path.Match(pattern[0], name[0])
+		if err != nil {
+			return false, fmt.Errorf("invalid glob pattern %q: %w", strings.Join(pattern, "/"), err)
+		}
+		if !ok {
+			return false, nil
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0, nil
+}
+
+// globSegments splits a slash-separated path into its non-empty segments,
+// dropping "." segments.
+func globSegments(p string) []string {
+	var segs []string
+	for _, seg := range strings.Split(filepath.ToSlash(p), "/") {
+		if seg != "" && seg != "." {
+			segs = append(segs, seg)
+		}
+	}
+	return segs
+}
@@ -0,0 +1,160 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestMatchGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		// Plain segments
+		{"*.ts", "app.ts", true},
+		{"*.ts", "src/app.ts", false},
+		{"src/*.ts", "src/app.ts", true},
+		{"src/*.ts", "src/lib/app.ts", false},
+
+		// ** matches any number of segments, including none
+		{"**/*.ts", "app.ts", true},
+		{"**/*.ts", "src/lib/app.ts", true},
+		{"src/**/*.ts", "src/app.ts", true},
+		{"src/**/*.ts", "src/a/b/c/app.ts", true},
+		{"src/**/*.ts", "lib/app.ts", false},
+		{"**/**/*.ts", "src/app.ts", true},
+		{"**", "anything/at/all", true},
+
+		// A pattern matching a directory matches everything in it
+		{"node_modules", "node_modules/react/index.js", true},
+		{"**/node_modules", "web/node_modules/react/index.js", true},
+
+		// Trailing slashes limit a pattern to directories
+		{"dist/", "dist/app.js", true},
+		{"dist/", "dist/", true},
+		{"dist/", "dist", false},
+		{"**/dist/", "web/dist/app.js", true},
+		{"**/dist/", "web/dist", false},
+		{"dist", "dist", true},
+
+		// Leading ./ and / are ignored
+		{"./src/*.go", "src/main.go", true},
+		{"/src/*.go", "./src/main.go", true},
+
+		// Empty patterns match nothing
+		{"", "main.go", false},
+		{"/", "main.go", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.name, func(t *testing.T) {
+			got, err := MatchGlob(tt.pattern, tt.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("MatchGlob(%q, %q) = %v, want %v", tt.pattern, tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchGlobInvalidPattern(t *testing.T) {
+	if _, err := MatchGlob("src/[a", "src/a"); err == nil {
+		t.Error("expected an error for a malformed pattern")
+	}
+	if err := ValidateGlob("**/[a"); err == nil {
+		t.Error("expected ValidateGlob to reject a malformed pattern")
+	}
+	if err := ValidateGlob("**/*.ts"); err != nil {
+		t.Errorf("ValidateGlob rejected a valid pattern: %v", err)
+	}
+}
+
+func TestMatchPatterns(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		patterns []string
+		want     bool
+	}{
+		{"no patterns", "app.ts", nil, false},
+		{"included", "src/app.ts", []string{"**/*.ts"}, true},
+		{"negated", "src/app.test.ts", []string{"**/*.ts", "!**/*.test.ts"}, false},
+		{"reincluded", "src/keep.test.ts", []string{"**/*.ts", "!**/*.test.ts", "src/keep.test.ts"}, true},
+		{"leading negation includes the rest", "src/app.ts", []string{"!dist/"}, true},
+		{"leading negation excludes", "dist/app.js", []string{"!dist/"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MatchPatterns(tt.path, tt.patterns...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("MatchPatterns(%q, %q) = %v, want %v", tt.path, tt.patterns, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGlob(t *testing.T) {
+	root := t.TempDir()
+	for _, name := range []string{
+		"main.ts",
+		"main.test.ts",
+		"src/app.ts",
+		"src/lib/util.ts",
+		"src/lib/util.test.ts",
+		"dist/main.js",
+		"README.md",
+	} {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		patterns []string
+		want     []string
+	}{
+		{"recursive", []string{"**/*.ts"}, []string{"main.test.ts", "main.ts", "src/app.ts", "src/lib/util.test.ts", "src/lib/util.ts"}},
+		{"negation", []string{"**/*.ts", "!**/*.test.ts"}, []string{"main.ts", "src/app.ts", "src/lib/util.ts"}},
+		{"directory", []string{"src/lib/"}, []string{"src/lib/util.test.ts", "src/lib/util.ts"}},
+		{"exclude directory", []string{"!src/", "!**/*.ts"}, []string{"README.md", "dist/main.js"}},
+		{"no match", []string{"**/*.go"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files, err := Glob(root, tt.patterns...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, file := range files {
+				rel, _ := filepath.Rel(root, file)
+				got = append(got, filepath.ToSlash(rel))
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Glob(%q) = %q, want %q", tt.patterns, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := Glob(root, "[bad"); err == nil {
+		t.Error("expected Glob to reject a malformed pattern")
+	}
+}
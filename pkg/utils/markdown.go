@@ -0,0 +1,141 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// tocHeading is a heading collected for a table of contents.
+type tocHeading struct {
+	level int
+	text  string
+	slug  string
+}
+
+// GenerateTOC returns markdown with a linked table of contents built from
+// its "##" and "###" headings. The contents are inserted after the
+// top-level title, or at the start when there is none. Headings inside
+// fenced code blocks are ignored, and markdown without headings or with an
+// existing contents section is returned unchanged.
+func GenerateTOC(markdown string) string {
+	lines := strings.Split(markdown, "\n")
+
+	var headings []tocHeading
+	slugs := make(map[string]int)
+	titleLine := -1
+	inFence := false
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		level, text := parseHeading(trimmed)
+		switch {
+		case level == 1 && titleLine < 0:
+			titleLine = i
+		case level == 2 || level == 3:
+			if isTOCHeading(text) {
+				return markdown
+			}
+
+			slug := uniqueSlug(slugs, headingSlug(text))
+			headings = append(headings, tocHeading{level: level, text: text, slug: slug})
+		}
+	}
+
+	if len(headings) == 0 {
+		return markdown
+	}
+
+	var toc strings.Builder
+	toc.WriteString("## Contents\n\n")
+	for _, h := range headings {
+		indent := strings.Repeat("  ", h.level-2)
+		toc.WriteString(fmt.Sprintf("%s- [%s](#%s)\n", indent, h.text, h.slug))
+	}
+
+	// Insert after the title and the blank lines that follow it
+	insertAt := 0
+	if titleLine >= 0 {
+		insertAt = titleLine + 1
+		for insertAt < len(lines) && strings.TrimSpace(lines[insertAt]) == "" {
+			insertAt++
+		}
+	}
+
+	result := make([]string, 0, len(lines)+len(headings)+4)
+	result = append(result, lines[:insertAt]...)
+	if insertAt > 0 && strings.TrimSpace(lines[insertAt-1]) != "" {
+		result = append(result, "")
+	}
+	result = append(result, strings.Split(toc.String(), "\n")...)
+	result = append(result, lines[insertAt:]...)
+
+	return strings.Join(result, "\n")
+}
+
+// parseHeading returns the level and text of an ATX heading, or 0 if the
+// line is not a heading.
+func parseHeading(line string) (int, string) {
+	level := 0
+	for level < len(line) && line[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 || level == len(line) || line[level] != ' ' {
+		return 0, ""
+	}
+	return level, strings.TrimSpace(strings.TrimRight(line[level:], "#"))
+}
+
+// isTOCHeading reports whether a heading introduces a table of contents.
+func isTOCHeading(text string) bool {
+	switch strings.ToLower(text) {
+	case "contents", "table of contents":
+		return true
+	}
+	return false
+}
+
+// markdownLink matches an inline link, whose text is kept in anchors.
+var markdownLink = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+
+// headingSlug converts heading text to an anchor slug the way GitHub does:
+// the text is lower-cased, punctuation and symbols are dropped, and each
+// space becomes a hyphen. Link targets are dropped along with the markup.
+func headingSlug(text string) string {
+	text = markdownLink.ReplaceAllString(text, "$1")
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case r == ' ':
+			b.WriteRune('-')
+		case r == '-', unicode.IsLetter(r), unicode.IsMark(r), unicode.IsNumber(r), unicode.Is(unicode.Pc, r):
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// uniqueSlug returns slug, or slug with the lowest free "-N" suffix when an
+// earlier heading already took it, and records the result in seen.
+func uniqueSlug(seen map[string]int, slug string) string {
+	result := slug
+	for {
+		if _, taken := seen[result]; !taken {
+			break
+		}
+		seen[slug]++
+		result = fmt.Sprintf("%s-%d", slug, seen[slug])
+	}
+	seen[result] = 0
+	return result
+}
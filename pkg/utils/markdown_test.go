@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHeadingSlug(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"Getting Started", "getting-started"},
+		{"What's new?", "whats-new"},
+		{"C++ & Go", "c--go"},
+		{"API (v2) reference", "api-v2-reference"},
+		{"snake_case names", "snake_case-names"},
+		{"Pre-commit hooks", "pre-commit-hooks"},
+		{"  Padded  ", "--padded--"},
+		{"`clause init`", "clause-init"},
+		{"**Bold** and _emphasis_", "bold-and-_emphasis_"},
+		{"See [the docs](https://example.com/a-b)", "see-the-docs"},
+		{"Émigré café", "émigré-café"},
+		{"1.2.3 release", "123-release"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if got := headingSlug(tt.text); got != tt.want {
+				t.Errorf("headingSlug(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateTOCDuplicateSlugs(t *testing.T) {
+	markdown := "# Title\n\n## Setup\n\n## Setup\n\n## Setup 1\n\n### Setup\n"
+	want := "- [Setup](#setup)\n- [Setup](#setup-1)\n- [Setup 1](#setup-1-1)\n  - [Setup](#setup-2)\n"
+
+	got := GenerateTOC(markdown)
+	if !strings.Contains(got, want) {
+		t.Errorf("GenerateTOC contents:\n%s\nwant them to contain:\n%s", got, want)
+	}
+}
+
+func TestGenerateTOC(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{
+			name:     "three headings",
+			markdown: "# Project\n\nIntro.\n\n## Getting Started\n\n### Install Dependencies\n\n## API Reference\n",
+			want: "# Project\n\n## Contents\n\n" +
+				"- [Getting Started](#getting-started)\n" +
+				"  - [Install Dependencies](#install-dependencies)\n" +
+				"- [API Reference](#api-reference)\n\n" +
+				"Intro.\n\n## Getting Started\n\n### Install Dependencies\n\n## API Reference\n",
+		},
+		{
+			name:     "no title",
+			markdown: "## One\n\ntext\n",
+			want:     "## Contents\n\n- [One](#one)\n\n## One\n\ntext\n",
+		},
+		{
+			name:     "no headings",
+			markdown: "# Project\n\nJust text.\n",
+			want:     "# Project\n\nJust text.\n",
+		},
+		{
+			name:     "headings in code fences",
+			markdown: "# Project\n\n```\n## Not a heading\n```\n",
+			want:     "# Project\n\n```\n## Not a heading\n```\n",
+		},
+		{
+			name:     "existing contents",
+			markdown: "# Project\n\n## Contents\n\n- [A](#a)\n\n## A\n",
+			want:     "# Project\n\n## Contents\n\n- [A](#a)\n\n## A\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GenerateTOC(tt.markdown); got != tt.want {
+				t.Errorf("GenerateTOC =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+)
+
+// Seams for the notification helpers, replaced in tests.
+var (
+	// notifyGOOS is the operating system used to pick a notifier
+	notifyGOOS = runtime.GOOS
+
+	// lookPath locates a notifier executable
+	lookPath = exec.LookPath
+
+	// runCommand runs a notifier executable
+	runCommand = func(name string, args ...string) error {
+		return exec.Command(name, args...).Run()
+	}
+
+	// bellWriter receives the bell character
+	bellWriter io.Writer = os.Stdout
+
+	// bellIsTerminal reports whether bellWriter is a terminal
+	bellIsTerminal = IsTerminal
+)
+
+// Bell rings the terminal bell. Nothing is written when stdout is not a
+// terminal, so piped output stays clean.
+func Bell() {
+	if !bellIsTerminal() {
+		return
+	}
+	bellWriter.Write([]byte("\a"))
+}
+
+// Notify shows a desktop notification using the OS notification mechanism:
+// osascript on macOS and notify-send on Linux. It silently does nothing on
+// other platforms or when the notifier is not installed.
+func Notify(title, message string) {
+	name, args := notifyCommand(notifyGOOS, title, message)
+	if name == "" {
+		return
+	}
+	if _, err := lookPath(name); err != nil {
+		return
+	}
+	_ = runCommand(name, args...)
+}
+
+// notifyCommand returns the notifier command for the given OS, or an empty
+// name if notifications are not supported.
+func notifyCommand(goos, title, message string) (string, []string) {
+	switch goos {
+	case "darwin":
+		script := "display notification " + strconv.Quote(message) + " with title " + strconv.Quote(title)
+		return "osascript", []string{"-e", script}
+	case "linux":
+		return "notify-send", []string{title, message}
+	default:
+		return "", nil
+	}
+}
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// stubNotifier replaces the notification seams for the duration of a test
+// and returns the commands that were run.
+func stubNotifier(t *testing.T, goos string, installed bool) *[][]string {
+	t.Helper()
+
+	oldGOOS, oldLookPath, oldRun := notifyGOOS, lookPath, runCommand
+	t.Cleanup(func() {
+		notifyGOOS, lookPath, runCommand = oldGOOS, oldLookPath, oldRun
+	})
+
+	var runs [][]string
+	notifyGOOS = goos
+	lookPath = func(name string) (string, error) {
+		if !installed {
+			return "", errors.New("not found")
+		}
+		return "/usr/bin/" + name, nil
+	}
+	runCommand = func(name string, args ...string) error {
+		runs = append(runs, append([]string{name}, args...))
+		return errors.New("ignored")
+	}
+	return &runs
+}
+
+func TestNotify(t *testing.T) {
+	tests := []struct {
+		goos      string
+		installed bool
+		want      [][]string
+	}{
+		{"darwin", true, [][]string{{"osascript", "-e", `display notification "Project \"demo\" created" with title "Clause"`}}},
+		{"linux", true, [][]string{{"notify-send", "Clause", `Project "demo" created`}}},
+		{"linux", false, nil},
+		{"windows", true, nil},
+		{"freebsd", true, nil},
+	}
+
+	for _, tt := range tests {
+		name := tt.goos
+		if !tt.installed {
+			name += " without notifier"
+		}
+		t.Run(name, func(t *testing.T) {
+			runs := stubNotifier(t, tt.goos, tt.installed)
+
+			Notify("Clause", `Project "demo" created`)
+			if !reflect.DeepEqual(*runs, tt.want) {
+				t.Errorf("ran %q, want %q", *runs, tt.want)
+			}
+		})
+	}
+}
+
+func TestBell(t *testing.T) {
+	tests := []struct {
+		name     string
+		terminal bool
+		want     string
+	}{
+		{"terminal", true, "\a"},
+		{"not a terminal", false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldWriter, oldIsTerminal := bellWriter, bellIsTerminal
+			t.Cleanup(func() { bellWriter, bellIsTerminal = oldWriter, oldIsTerminal })
+
+			var buf bytes.Buffer
+			bellWriter = &buf
+			bellIsTerminal = func() bool { return tt.terminal }
+
+			Bell()
+			if buf.String() != tt.want {
+				t.Errorf("Bell wrote %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
@@ -59,8 +59,8 @@ func ContractHome(path string) string {
 		return path
 	}
 
-	// Check if path is in home directory
-	if strings.HasPrefix(absPath, absHome) {
+	// Check if path is in home directory, not merely sharing its prefix
+	if absPath == absHome || strings.HasPrefix(absPath, absHome+string(filepath.Separator)) {
 		relative := strings.TrimPrefix(absPath, absHome)
 		if relative == "" {
 			return "~"
@@ -71,6 +71,30 @@ func ContractHome(path string) string {
 	return path
 }
 
+// PortablePath converts an absolute path into a form that can be stored in
+// shared configuration: the home directory is contracted to ~ and separators
+// become forward slashes. Relative paths only have their separators changed.
+func PortablePath(abs string) string {
+	if abs == "" {
+		return ""
+	}
+
+	p := abs
+	if filepath.IsAbs(p) {
+		p = ContractHome(p)
+	}
+	return strings.ReplaceAll(p, "\\", "/")
+}
+
+// ResolvePortable converts a path stored by PortablePath back into a native
+// path, expanding a leading ~ to the home directory.
+func ResolvePortable(p string) string {
+	if p == "" {
+		return ""
+	}
+	return ExpandHome(filepath.FromSlash(p))
+}
+
 // GetHomeDirectory returns the current user's home directory.
 func GetHomeDirectory() string {
 	// Try USERPROFILE first (Windows)
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestPortablePathRoundTrip(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("USERPROFILE", "")
+	t.Setenv("HOME", home)
+
+	tests := []struct {
+		name     string
+		path     string
+		portable string
+		resolved string
+	}{
+		{"home relative", filepath.Join(home, "projects", "rules.yaml"), "~/projects/rules.yaml", filepath.Join(home, "projects", "rules.yaml")},
+		{"home itself", home, "~", home},
+		{"outside home", "/etc/clause/rules.yaml", "/etc/clause/rules.yaml", filepath.FromSlash("/etc/clause/rules.yaml")},
+		{"sibling of home", home + "-other/rules.yaml", filepath.ToSlash(home) + "-other/rules.yaml", filepath.FromSlash(home + "-other/rules.yaml")},
+		{"relative", filepath.Join("rules", "custom.yaml"), "rules/custom.yaml", filepath.Join("rules", "custom.yaml")},
+		{"windows separators", `rules\custom.yaml`, "rules/custom.yaml", filepath.Join("rules", "custom.yaml")},
+		{"empty", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			portable := PortablePath(tt.path)
+			if portable != tt.portable {
+				t.Errorf("PortablePath(%q) = %q, want %q", tt.path, portable, tt.portable)
+			}
+			if got := ResolvePortable(portable); got != tt.resolved {
+				t.Errorf("ResolvePortable(%q) = %q, want %q", portable, got, tt.resolved)
+			}
+		})
+	}
+}
+
+func TestResolvePortableStoredPaths(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("USERPROFILE", "")
+	t.Setenv("HOME", home)
+
+	// Paths as written to a shared config by PortablePath on either OS
+	tests := []struct {
+		name   string
+		stored string
+		want   string
+	}{
+		{"saved on unix", "~/projects/rules.yaml", filepath.Join(home, "projects", "rules.yaml")},
+		{"saved on windows", "~/Documents/clause/rules.yaml", filepath.Join(home, "Documents", "clause", "rules.yaml")},
+		{"relative", ".clause/rules.yaml", filepath.Join(".clause", "rules.yaml")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolvePortable(tt.stored); got != tt.want {
+				t.Errorf("ResolvePortable(%q) = %q, want %q", tt.stored, got, tt.want)
+			}
+		})
+	}
+}
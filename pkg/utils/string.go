@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
@@ -186,6 +187,88 @@ func PathCase(s string) string {
 	return strings.ToLower(strings.Join(words, "/"))
 }
 
+// irregularPlurals maps singular nouns to plurals that do not follow the
+// suffix rules of Pluralize.
+var irregularPlurals = map[string]string{
+	"child":  "children",
+	"person": "people",
+	"man":    "men",
+	"woman":  "women",
+	"mouse":  "mice",
+	"index":  "indices",
+	"datum":  "data",
+}
+
+// Pluralize returns the English plural of a singular noun, such as
+// "users" for "user", "categories" for "category" and "boxes" for "box".
+// Only the last word of a phrase is changed.
+func Pluralize(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	lower := strings.ToLower(s)
+	for singular, plural := range irregularPlurals {
+		if strings.HasSuffix(lower, singular) && (len(lower) == len(singular) || !unicode.IsLetter(rune(lower[len(lower)-len(singular)-1]))) {
+			return s[:len(s)-len(singular)] + matchCase(s[len(s)-len(singular):], plural)
+		}
+	}
+
+	switch {
+	case strings.HasSuffix(lower, "y") && len(lower) > 1 && !strings.ContainsRune("aeiou", rune(lower[len(lower)-2])):
+		return s[:len(s)-1] + matchCase(s[len(s)-1:], "ies")
+	case doublesFinalZ(lower):
+		return s + matchCase(s[len(s)-1:], "zes")
+	case strings.HasSuffix(lower, "s"), strings.HasSuffix(lower, "x"), strings.HasSuffix(lower, "z"),
+		strings.HasSuffix(lower, "ch"), strings.HasSuffix(lower, "sh"):
+		return s + matchCase(s[len(s)-1:], "es")
+	default:
+		return s + matchCase(s[len(s)-1:], "s")
+	}
+}
+
+// doublesFinalZ reports whether the last word of lower is a one-syllable
+// word ending in a single vowel and z, such as "quiz" or "fez", whose z is
+// doubled before "es". Longer words such as "topaz" are not doubled.
+func doublesFinalZ(lower string) bool {
+	n := len(lower)
+	if n < 3 || lower[n-1] != 'z' || !isVowel(lower[n-2]) {
+		return false
+	}
+
+	// The u of "qu" is part of the consonant sound
+	word := lower[strings.LastIndexFunc(lower[:n-2], func(r rune) bool { return !unicode.IsLetter(r) })+1 : n-2]
+	word = strings.Replace(word, "qu", "q", 1)
+	if word == "" {
+		return false
+	}
+	for i := 0; i < len(word); i++ {
+		if isVowel(word[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// isVowel reports whether b is a lower-case vowel.
+func isVowel(b byte) bool {
+	return strings.IndexByte("aeiou", b) >= 0
+}
+
+// matchCase returns s in upper case when ref is all upper case, so "USER"
+// pluralizes to "USERS", and capitalized when ref is, so "Person"
+// pluralizes to "People".
+func matchCase(ref, s string) string {
+	switch {
+	case ref != strings.ToLower(ref) && ref == strings.ToUpper(ref):
+		return strings.ToUpper(s)
+	case unicode.IsUpper([]rune(ref)[0]):
+		return strings.ToUpper(s[:1]) + s[1:]
+	default:
+		return s
+	}
+}
+
 // splitWords splits a string into words based on various delimiters and case changes.
 func splitWords(s string) []string {
 	s = strings.TrimSpace(s)
@@ -622,3 +705,17 @@ func MatchesRegex(s, pattern string) bool {
 	}
 	return re.MatchString(s)
 }
+
+// ParseBoolStrict parses human input such as "yes", "no", "on" or "off" into a boolean.
+// Unlike strconv.ParseBool it accepts common prompt answers, and unlike a loose
+// truthy check it returns an error for anything it does not recognize.
+func ParseBoolStrict(s string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "true", "t", "1", "yes", "y", "on", "enabled", "enable":
+		return true, nil
+	case "false", "f", "0", "no", "n", "off", "disabled", "disable":
+		return false, nil
+	default:
+		return false, fmt.Errorf("invalid boolean value %q: expected yes/no, true/false, on/off or 1/0", s)
+	}
+}
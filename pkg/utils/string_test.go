@@ -0,0 +1,80 @@
+package utils
+
+import "testing"
+
+func TestPluralize(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"", ""},
+		{"user", "users"},
+		{"category", "categories"},
+		{"day", "days"},
+		{"box", "boxes"},
+		{"bus", "buses"},
+		{"match", "matches"},
+		{"dish", "dishes"},
+
+		// A single z after a short vowel is doubled
+		{"quiz", "quizzes"},
+		{"Quiz", "Quizzes"},
+		{"QUIZ", "QUIZZES"},
+		{"fez", "fezzes"},
+		{"whiz", "whizzes"},
+		{"pop quiz", "pop quizzes"},
+		{"buzz", "buzzes"},
+		{"topaz", "topazes"},
+		{"waltz", "waltzes"},
+
+		// Irregular nouns
+		{"person", "people"},
+		{"Person", "People"},
+		{"child", "children"},
+		{"index", "indices"},
+		{"human", "humans"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := Pluralize(tt.in); got != tt.want {
+				t.Errorf("Pluralize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseBoolStrict(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    bool
+		wantErr bool
+	}{
+		{"yes", true, false},
+		{"Y", true, false},
+		{" true ", true, false},
+		{"on", true, false},
+		{"1", true, false},
+		{"enabled", true, false},
+		{"off", false, false},
+		{"No", false, false},
+		{"false", false, false},
+		{"0", false, false},
+		{"disable", false, false},
+		{"maybe", false, true},
+		{"", false, true},
+		{"yess", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseBoolStrict(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseBoolStrict(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseBoolStrict(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -361,6 +361,19 @@ func IsInteractive() bool {
 	return IsTerminal() && !IsDumbTerminal()
 }
 
+// PrefersReducedMotion checks if animations should be skipped, either because
+// the user asked for it via CLAUSE_REDUCE_MOTION or because the terminal is
+// not interactive.
+func PrefersReducedMotion() bool {
+	if value := os.Getenv("CLAUSE_REDUCE_MOTION"); value != "" {
+		if reduce, err := ParseBoolStrict(value); err == nil {
+			return reduce
+		}
+		return true
+	}
+	return !IsInteractive()
+}
+
 // GetShell returns the current shell.
 func GetShell() string {
 	if runtime.GOOS == "windows" {
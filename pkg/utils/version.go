@@ -506,6 +506,39 @@ func FindLatest(versions []string) (string, error) {
 	return latestStr, nil
 }
 
+// CheckForUpdate returns the highest of availableVersions that is newer than
+// current. Pre-releases are only offered when current is itself a
+// pre-release. Unparseable entries in availableVersions are ignored.
+func CheckForUpdate(current string, availableVersions []string) (latest string, available bool, err error) {
+	cur, err := ParseVersion(current)
+	if err != nil {
+		return "", false, fmt.Errorf("invalid current version %q: %w", current, err)
+	}
+
+	for _, v := range availableVersions {
+		ver, err := ParseVersion(v)
+		if err != nil {
+			continue
+		}
+		if ver.IsPrerelease() && !cur.IsPrerelease() {
+			continue
+		}
+
+		if cmp, _ := CompareVersions(v, current); cmp <= 0 {
+			continue
+		}
+		if latest == "" {
+			latest = v
+			continue
+		}
+		if cmp, _ := CompareVersions(v, latest); cmp > 0 {
+			latest = v
+		}
+	}
+
+	return latest, latest != "", nil
+}
+
 // SortVersions sorts a slice of version strings.
 func SortVersions(versions []string) error {
 	parsed := make([]*Version, len(versions))
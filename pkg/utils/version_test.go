@@ -0,0 +1,38 @@
+package utils
+
+import "testing"
+
+func TestCheckForUpdate(t *testing.T) {
+	tests := []struct {
+		name          string
+		current       string
+		available     []string
+		wantLatest    string
+		wantAvailable bool
+		wantErr       bool
+	}{
+		{"stable offered over pre-release", "1.0.0", []string{"1.1.0", "1.2.0-beta.1", "0.9.0"}, "1.1.0", true, false},
+		{"highest stable", "1.0.0", []string{"1.0.1", "1.3.0", "1.2.0"}, "1.3.0", true, false},
+		{"only pre-releases", "1.0.0", []string{"1.1.0-rc.1"}, "", false, false},
+		{"up to date", "1.3.0", []string{"1.0.0", "1.3.0"}, "", false, false},
+		{"pre-release current gets pre-release", "1.1.0-beta.1", []string{"1.1.0-beta.2", "1.0.0"}, "1.1.0-beta.2", true, false},
+		{"pre-release current gets release", "1.1.0-rc.1", []string{"1.1.0-beta.2", "1.1.0"}, "1.1.0", true, false},
+		{"v prefix", "v1.0.0", []string{"v1.0.1"}, "v1.0.1", true, false},
+		{"unparseable entries ignored", "1.0.0", []string{"latest", "", "1.0.2"}, "1.0.2", true, false},
+		{"empty list", "1.0.0", nil, "", false, false},
+		{"invalid current", "dev", []string{"1.0.0"}, "", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			latest, available, err := CheckForUpdate(tt.current, tt.available)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckForUpdate error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if latest != tt.wantLatest || available != tt.wantAvailable {
+				t.Errorf("CheckForUpdate(%q, %v) = %q, %v, want %q, %v",
+					tt.current, tt.available, latest, available, tt.wantLatest, tt.wantAvailable)
+			}
+		})
+	}
+}